@@ -298,3 +298,27 @@ func (suite *AnteTestSuite) TestEthInvalidChainID() {
 	ctx := suite.ctx.WithChainID("bad-chain-id")
 	requireInvalidTx(suite.T(), suite.anteHandler, ctx, tx, false)
 }
+
+func (suite *AnteTestSuite) TestStateOverridesOutOfSimulation() {
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	addr1, priv1 := newTestAddrKey()
+	addr2, _ := newTestAddrKey()
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	_ = acc1.SetCoins(newTestCoins())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc1)
+
+	msg := evmtypes.NewMsgEthermint(0, &addr2, sdk.NewInt(32), 22000, sdk.NewInt(20), nil, addr1)
+	msg.StateOverrides = []byte(`{}`)
+	msgs := []sdk.Msg{msg}
+
+	privKeys := []tmcrypto.PrivKey{priv1}
+	accNums := []uint64{acc1.GetAccountNumber()}
+	accSeqs := []uint64{acc1.GetSequence()}
+
+	// require the state overrides to be rejected by CheckTx and DeliverTx
+	tx := newTestSDKTx(suite.ctx, msgs, privKeys, accNums, accSeqs, newTestStdFee())
+	requireInvalidTx(suite.T(), suite.anteHandler, suite.ctx, tx, false)
+	requireInvalidTx(suite.T(), suite.anteHandler, suite.ctx.WithIsCheckTx(true), tx, false)
+}
@@ -365,6 +365,9 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	if err != nil {
 		return ctx, sdkerrors.Wrap(err, "failed to compute intrinsic gas cost")
 	}
+	if evmtypes.IsTypedTxEnabled(ctx.BlockHeight()) {
+		gas += msgEthTx.Data.Accesses.IntrinsicGas()
+	}
 
 	// intrinsic gas verification during CheckTx
 	if ctx.IsCheckTx() && gasLimit < gas {
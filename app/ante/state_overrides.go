@@ -0,0 +1,30 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// StateOverridesDecorator rejects the MsgEthermint carrying state overrides out of the simulation, so that the
+// overrides of eth_call can never be applied by CheckTx or DeliverTx
+type StateOverridesDecorator struct{}
+
+// NewStateOverridesDecorator creates a new StateOverridesDecorator
+func NewStateOverridesDecorator() StateOverridesDecorator {
+	return StateOverridesDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (sod StateOverridesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !simulate {
+		for _, msg := range tx.GetMsgs() {
+			if ethermintMsg, ok := msg.(evmtypes.MsgEthermint); ok && len(ethermintMsg.StateOverrides) > 0 {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "state overrides are only allowed in simulation")
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
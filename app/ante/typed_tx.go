@@ -0,0 +1,31 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// EthTypedTxDecorator checks the typed transaction values of the MsgEthereumTx at the block height, so that none of
+// them reaches the state machine before the typed transactions are enabled
+type EthTypedTxDecorator struct{}
+
+// NewEthTypedTxDecorator creates a new EthTypedTxDecorator
+func NewEthTypedTxDecorator() EthTypedTxDecorator {
+	return EthTypedTxDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (ettd EthTypedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgEthTx, ok := tx.(evmtypes.MsgEthereumTx)
+	if !ok {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid transaction type: %T", tx)
+	}
+
+	if err := msgEthTx.ValidateTypedTx(ctx.BlockHeight()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -85,6 +85,9 @@ var (
 			dexclient.DelistProposalHandler, farmclient.ManageWhiteListProposalHandler,
 			evmclient.ManageContractDeploymentWhitelistProposalHandler,
 			evmclient.ManageContractBlockedListProposalHandler,
+			evmclient.ManageContractMethodBlockedListProposalHandler,
+			evmclient.ManageNativeTokenMappingProposalHandler,
+			evmclient.ContractPatchProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -119,6 +122,7 @@ var (
 		farm.ModuleName:           nil,
 		farm.YieldFarmingAccount:  nil,
 		farm.MintFarmingAccount:   {supply.Burner},
+		evm.ModuleName:            nil,
 	}
 )
 
@@ -188,7 +192,12 @@ func NewOKExChainApp(
 	cdc := okexchaincodec.MakeCodec(ModuleBasics)
 
 	// NOTE we use custom FileChain transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
-	bApp := bam.NewBaseApp(appName, logger, db, evm.TxDecoder(cdc), baseAppOptions...)
+	// The typed Ethereum transactions are only decoded from the typed tx height on
+	var bApp *bam.BaseApp
+	txDecoder := evm.HeightTxDecoder(cdc, func() int64 {
+		return bApp.LastBlockHeight() + 1
+	})
+	bApp = bam.NewBaseApp(appName, logger, db, txDecoder, baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
@@ -233,9 +242,11 @@ func NewOKExChainApp(
 	app.AccountKeeper = auth.NewAccountKeeper(
 		cdc, keys[auth.StoreKey], app.subspaces[auth.ModuleName], okexchain.ProtoAccount,
 	)
-	app.BankKeeper = bank.NewBaseKeeper(
+	// the sends are checked against the issuer controls of the tokens once the token keeper is set
+	bankKeeper := token.NewBankKeeper(bank.NewBaseKeeper(
 		app.AccountKeeper, app.subspaces[bank.ModuleName], app.ModuleAccountAddrs(),
-	)
+	))
+	app.BankKeeper = bankKeeper
 	app.ParamsKeeper.SetBankKeeper(app.BankKeeper)
 	app.SupplyKeeper = supply.NewKeeper(
 		cdc, keys[supply.StoreKey], app.AccountKeeper, app.BankKeeper, maccPerms,
@@ -265,6 +276,8 @@ func NewOKExChainApp(
 	app.TokenKeeper = token.NewKeeper(app.BankKeeper, app.subspaces[token.ModuleName], auth.FeeCollectorName, app.SupplyKeeper,
 		keys[token.StoreKey], keys[token.KeyLock],
 		app.cdc, appConfig.BackendConfig.EnableBackend, app.AccountKeeper)
+	app.EvmKeeper.SetTokenKeeper(app.TokenKeeper)
+	bankKeeper.SetTokenKeeper(app.TokenKeeper)
 
 	app.DexKeeper = dex.NewKeeper(auth.FeeCollectorName, app.SupplyKeeper, app.subspaces[dex.ModuleName], app.TokenKeeper, &stakingKeeper,
 		app.BankKeeper, app.keys[dex.StoreKey], app.keys[dex.TokenPairStoreKey], app.cdc)
@@ -320,7 +333,7 @@ func NewOKExChainApp(
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
 		staking.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
-	)
+	).SetSharesHooks(app.DistrKeeper.Hooks()).SetProxyHooks(app.DistrKeeper.Hooks())
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -30,7 +30,8 @@ func (app *OKExChainApp) ExportAppStateAndValidators(
 ) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 
 	// Creates context with current height and checks txs for ctx to be usable by start of next block
-	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	// The app hash is carried for the modules recording it along with the exported state, e.g. the evm snapshot
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight(), AppHash: app.LastCommitID().Hash})
 
 	if forZeroHeight {
 		app.prepForZeroHeightGenesis(ctx, jailWhiteList)
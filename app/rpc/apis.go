@@ -12,10 +12,12 @@ import (
 
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
 	"github.com/okex/exchain/app/rpc/backend"
+	"github.com/okex/exchain/app/rpc/namespaces/debug"
 	"github.com/okex/exchain/app/rpc/namespaces/eth"
 	"github.com/okex/exchain/app/rpc/namespaces/eth/filters"
 	"github.com/okex/exchain/app/rpc/namespaces/net"
 	"github.com/okex/exchain/app/rpc/namespaces/personal"
+	"github.com/okex/exchain/app/rpc/namespaces/txpool"
 	"github.com/okex/exchain/app/rpc/namespaces/web3"
 	rpctypes "github.com/okex/exchain/app/rpc/types"
 )
@@ -26,6 +28,8 @@ const (
 	EthNamespace      = "eth"
 	PersonalNamespace = "personal"
 	NetNamespace      = "net"
+	DebugNamespace    = "debug"
+	TxPoolNamespace   = "txpool"
 
 	apiVersion = "1.0"
 )
@@ -70,6 +74,12 @@ func GetAPIs(clientCtx context.CLIContext, log log.Logger, keys ...ethsecp256k1.
 			Service:   net.NewAPI(clientCtx),
 			Public:    true,
 		},
+		{
+			Namespace: TxPoolNamespace,
+			Version:   apiVersion,
+			Service:   txpool.NewAPI(clientCtx, log, ethBackend),
+			Public:    true,
+		},
 	}
 
 	if viper.GetBool(FlagPersonalAPI) {
@@ -80,6 +90,15 @@ func GetAPIs(clientCtx context.CLIContext, log log.Logger, keys ...ethsecp256k1.
 			Public:    false,
 		})
 	}
+
+	if viper.GetBool(FlagDebugAPI) {
+		apis = append(apis, rpc.API{
+			Namespace: DebugNamespace,
+			Version:   apiVersion,
+			Service:   debug.NewAPI(clientCtx, log, ethBackend),
+			Public:    true,
+		})
+	}
 	return apis
 }
 
@@ -3,6 +3,8 @@ package backend
 import (
 	"context"
 	"fmt"
+	"math/big"
+
 	"golang.org/x/time/rate"
 
 	"github.com/okex/exchain/x/evm/watcher"
@@ -48,6 +50,10 @@ type Backend interface {
 	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
 	ServiceFilter(ctx context.Context, session *bloombits.MatcherSession)
+
+	// Used by gas price oracle
+	SuggestGasPrice() (*big.Int, error)
+	FeeHistory(blockCount int, lastBlock rpctypes.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 }
 
 var _ Backend = (*EthermintBackend)(nil)
@@ -62,6 +68,7 @@ type EthermintBackend struct {
 	closeBloomHandler chan struct{}
 	wrappedBackend    *watcher.Querier
 	rateLimiters      map[string]*rate.Limiter
+	gasPriceCache     *gasPriceCache
 }
 
 // New creates a new EthermintBackend instance
@@ -75,6 +82,7 @@ func New(clientCtx clientcontext.CLIContext, log log.Logger, rateLimiters map[st
 		closeBloomHandler: make(chan struct{}),
 		wrappedBackend:    watcher.NewQuerier(),
 		rateLimiters:      rateLimiters,
+		gasPriceCache:     &gasPriceCache{},
 	}
 }
 
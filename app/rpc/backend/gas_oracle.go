@@ -0,0 +1,271 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/viper"
+
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+)
+
+const (
+	// FlagGasPriceBlocks defines the number of recent blocks sampled by the gas price oracle
+	FlagGasPriceBlocks = "rpc.gas-price-blocks"
+	// FlagGasPricePercentile defines the percentile of the sampled gas prices suggested by the gas price oracle
+	FlagGasPricePercentile = "rpc.gas-price-percentile"
+
+	// DefaultGasPriceBlocks is the default number of blocks sampled by the gas price oracle
+	DefaultGasPriceBlocks = 20
+	// DefaultGasPricePercentile is the default percentile suggested by the gas price oracle
+	DefaultGasPricePercentile = 60
+
+	// maxFeeHistory is the maximum number of blocks that can be queried by eth_feeHistory
+	maxFeeHistory = 1024
+)
+
+// gasPriceCache holds the last suggested gas price together with the head it was computed at
+type gasPriceCache struct {
+	mtx   sync.Mutex
+	head  int64
+	price *big.Int
+}
+
+// txGasAndReward is the gas used and the effective gas price of a transaction in a block
+type txGasAndReward struct {
+	gasUsed uint64
+	reward  *big.Int
+}
+
+// blockFees is the gas usage summary of a block
+type blockFees struct {
+	gasUsed  uint64
+	gasLimit uint64
+	// txs are sorted by reward in ascending order
+	txs []txGasAndReward
+}
+
+// SuggestGasPrice returns the configured percentile of the effective gas prices of the transactions included in the
+// recent blocks. It returns nil if none of the sampled blocks contains a transaction. The blocks are fetched out of the
+// lock of the cache, so that a slow fetch doesn't block the other callers.
+func (b *EthermintBackend) SuggestGasPrice() (*big.Int, error) {
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	if price := b.gasPriceCache.get(int64(head)); price != nil {
+		return price, nil
+	}
+
+	blocks := viper.GetInt64(FlagGasPriceBlocks)
+	if blocks <= 0 {
+		blocks = DefaultGasPriceBlocks
+	}
+	percentile := viper.GetFloat64(FlagGasPricePercentile)
+	if percentile <= 0 || percentile > 100 {
+		percentile = DefaultGasPricePercentile
+	}
+
+	var prices []*big.Int
+	for height := int64(head); height > 0 && height > int64(head)-blocks; height-- {
+		fees, err := b.blockFees(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range fees.txs {
+			prices = append(prices, tx.reward)
+		}
+	}
+	if len(prices) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cmp(prices[j]) < 0 })
+	price := prices[int(float64(len(prices)-1)*percentile/100)]
+
+	b.gasPriceCache.set(int64(head), price)
+	return new(big.Int).Set(price), nil
+}
+
+// get returns a copy of the cached gas price if it was computed at the given head, otherwise nil
+func (c *gasPriceCache) get(head int64) *big.Int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.price == nil || c.head != head {
+		return nil
+	}
+	return new(big.Int).Set(c.price)
+}
+
+// set caches the gas price computed at the given head, unless a price of a newer head is already cached
+func (c *gasPriceCache) set(head int64, price *big.Int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.price != nil && c.head > head {
+		return
+	}
+	c.head = head
+	c.price = new(big.Int).Set(price)
+}
+
+// FeeHistory returns the gas usage ratio and the requested percentiles of the effective gas prices of blockCount
+// blocks up to lastBlock. The chain has no base fee, so the base fees are always zero.
+func (b *EthermintBackend) FeeHistory(blockCount int, lastBlock rpctypes.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error) {
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile: %f", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("invalid reward percentile: #%d:%f > #%d:%f", i-1, rewardPercentiles[i-1], i, p)
+		}
+	}
+	if blockCount < 1 {
+		return &rpctypes.FeeHistoryResult{OldestBlock: (*hexutil.Big)(new(big.Int))}, nil
+	}
+	if blockCount > maxFeeHistory {
+		blockCount = maxFeeHistory
+	}
+
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	last := int64(head)
+	if lastBlock > 0 {
+		if lastBlock.Int64() > last {
+			return nil, fmt.Errorf("request beyond head block: requested %d, head %d", lastBlock.Int64(), last)
+		}
+		last = lastBlock.Int64()
+	} else if lastBlock == rpctypes.EarliestBlockNumber {
+		last = 1
+	}
+	if int64(blockCount) > last {
+		blockCount = int(last)
+	}
+	oldest := last - int64(blockCount) + 1
+
+	result := &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(oldest)),
+		BaseFee:      make([]*hexutil.Big, blockCount+1),
+		GasUsedRatio: make([]float64, blockCount),
+	}
+	for i := range result.BaseFee {
+		result.BaseFee[i] = (*hexutil.Big)(new(big.Int))
+	}
+	if len(rewardPercentiles) != 0 {
+		result.Reward = make([][]*hexutil.Big, blockCount)
+	}
+
+	for i := 0; i < blockCount; i++ {
+		fees, err := b.blockFees(oldest + int64(i))
+		if err != nil {
+			return nil, err
+		}
+		if fees.gasLimit != 0 {
+			result.GasUsedRatio[i] = float64(fees.gasUsed) / float64(fees.gasLimit)
+		}
+		if result.Reward != nil {
+			result.Reward[i] = fees.rewards(rewardPercentiles)
+		}
+	}
+
+	return result, nil
+}
+
+// blockFees collects the gas usage and the effective gas prices of the transactions of the block at the given height.
+// The watcher db is used in the fast query mode, otherwise the block is queried from tendermint.
+func (b *EthermintBackend) blockFees(height int64) (*blockFees, error) {
+	if fees, err := b.blockFeesFromWatcher(height); err == nil {
+		return fees, nil
+	}
+
+	block, err := b.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+	blockResults, err := b.clientCtx.Client.BlockResults(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := &blockFees{gasLimit: uint64(b.gasLimit)}
+	for i, tx := range block.Block.Txs {
+		ethTx, err := rpctypes.RawTxToEthTx(b.clientCtx, tx)
+		if err != nil {
+			// skip the transactions which are not a MsgEthereumTx
+			continue
+		}
+		var gasUsed uint64
+		if i < len(blockResults.TxsResults) {
+			gasUsed = uint64(blockResults.TxsResults[i].GasUsed)
+		}
+		fees.gasUsed += gasUsed
+		fees.txs = append(fees.txs, txGasAndReward{gasUsed: gasUsed, reward: new(big.Int).Set(ethTx.Data.Price)})
+	}
+	fees.sort()
+
+	return fees, nil
+}
+
+func (b *EthermintBackend) blockFeesFromWatcher(height int64) (*blockFees, error) {
+	block, err := b.wrappedBackend.GetBlockByNumber(uint64(height), true)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := &blockFees{gasLimit: uint64(block.GasLimit)}
+	if block.GasUsed != nil {
+		fees.gasUsed = block.GasUsed.ToInt().Uint64()
+	}
+	txs, _ := block.Transactions.([]rpctypes.Transaction)
+	for _, tx := range txs {
+		receipt, err := b.wrappedBackend.GetTransactionReceipt(tx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		reward := new(big.Int)
+		if tx.GasPrice != nil {
+			reward.Set(tx.GasPrice.ToInt())
+		}
+		fees.txs = append(fees.txs, txGasAndReward{gasUsed: uint64(receipt.GasUsed), reward: reward})
+	}
+	fees.sort()
+
+	return fees, nil
+}
+
+func (f *blockFees) sort() {
+	sort.SliceStable(f.txs, func(i, j int) bool { return f.txs[i].reward.Cmp(f.txs[j].reward) < 0 })
+}
+
+// rewards returns the effective gas prices at the given percentiles, weighted by the gas used by each transaction
+func (f *blockFees) rewards(percentiles []float64) []*hexutil.Big {
+	rewards := make([]*hexutil.Big, len(percentiles))
+	if len(f.txs) == 0 {
+		for i := range rewards {
+			rewards[i] = (*hexutil.Big)(new(big.Int))
+		}
+		return rewards
+	}
+
+	var totalGasUsed uint64
+	for _, tx := range f.txs {
+		totalGasUsed += tx.gasUsed
+	}
+
+	var txIndex int
+	sumGasUsed := f.txs[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(totalGasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(f.txs)-1 {
+			txIndex++
+			sumGasUsed += f.txs[txIndex].gasUsed
+		}
+		rewards[i] = (*hexutil.Big)(new(big.Int).Set(f.txs[txIndex].reward))
+	}
+	return rewards
+}
@@ -0,0 +1,50 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockFeesRewards(t *testing.T) {
+	// empty block
+	fees := &blockFees{}
+	require.Equal(t, []*hexutil.Big{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}, fees.rewards([]float64{10, 90}))
+
+	fees = &blockFees{
+		txs: []txGasAndReward{
+			{gasUsed: 21000, reward: big.NewInt(3)},
+			{gasUsed: 50000, reward: big.NewInt(1)},
+			{gasUsed: 29000, reward: big.NewInt(2)},
+		},
+	}
+	fees.sort()
+	require.Equal(t, big.NewInt(1), fees.txs[0].reward)
+	require.Equal(t, big.NewInt(3), fees.txs[2].reward)
+
+	// percentiles are weighted by the gas used of each transaction
+	rewards := fees.rewards([]float64{0, 50, 60, 79, 80, 100})
+	expected := []int64{1, 1, 2, 2, 3, 3}
+	require.Equal(t, len(expected), len(rewards))
+	for i, reward := range rewards {
+		require.Equal(t, expected[i], reward.ToInt().Int64(), "percentile #%d", i)
+	}
+}
+
+func TestGasPriceCache(t *testing.T) {
+	cache := &gasPriceCache{}
+	require.Nil(t, cache.get(10))
+
+	cache.set(10, big.NewInt(5))
+	require.Equal(t, big.NewInt(5), cache.get(10))
+	require.Nil(t, cache.get(11))
+
+	// a price computed at an older head doesn't replace the newer one
+	cache.set(9, big.NewInt(4))
+	require.Equal(t, big.NewInt(5), cache.get(10))
+
+	cache.set(11, big.NewInt(6))
+	require.Equal(t, big.NewInt(6), cache.get(11))
+}
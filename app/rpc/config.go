@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
 	"github.com/okex/exchain/app/crypto/hd"
+	"github.com/okex/exchain/app/rpc/middleware"
 	"github.com/okex/exchain/app/rpc/websockets"
 )
 
@@ -25,6 +26,7 @@ const (
 	flagWebsocket = "wsport"
 
 	FlagPersonalAPI    = "personal-api"
+	FlagDebugAPI       = "debug-api"
 	FlagRateLimitApi   = "rpc.rate-limit-api"
 	FlagRateLimitCount = "rpc.rate-limit-count"
 	FlagRateLimitBurst = "rpc.rate-limit-burst"
@@ -64,8 +66,9 @@ func RegisterRoutes(rs *lcd.RestServer) {
 
 	apis := GetAPIs(rs.CliCtx, rs.Logger(), privkeys...)
 
-	// Register all the APIs exposed by the namespace services
-	// TODO: handle allowlist and private APIs
+	// Register all the APIs exposed by the namespace services, while the access to them is controlled by the
+	// middleware
+	// TODO: handle private APIs
 	for _, api := range apis {
 		if err := server.RegisterName(api.Namespace, api.Service); err != nil {
 			panic(err)
@@ -73,11 +76,19 @@ func RegisterRoutes(rs *lcd.RestServer) {
 	}
 
 	// Web3 RPC API route
-	rs.Mux.HandleFunc("/", server.ServeHTTP).Methods("POST", "OPTIONS")
+	mw := middleware.NewMiddleware(func() (int64, error) {
+		// NOTE: using 0 as min and max height returns the blockchain info up to the latest block.
+		info, err := rs.CliCtx.Client.BlockchainInfo(0, 0)
+		if err != nil {
+			return 0, err
+		}
+		return info.LastHeight, nil
+	})
+	rs.Mux.Handle("/", mw.Handler(server)).Methods("POST", "OPTIONS")
 
 	// start websockets server
 	websocketAddr := viper.GetString(flagWebsocket)
-	ws := websockets.NewServer(rs.CliCtx, rs.Logger(), websocketAddr)
+	ws := websockets.NewServer(rs.CliCtx, rs.Logger(), websocketAddr, server, mw)
 	ws.Start()
 }
 
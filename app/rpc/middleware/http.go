@@ -0,0 +1,205 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+)
+
+// maxRequestContentLength is the same limit on the request body as the one of the rpc server
+const maxRequestContentLength = 1024 * 1024 * 5
+
+// message is a JSON-RPC request or response
+type message struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Error   interface{}     `json:"error,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+}
+
+type errorMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func errorResponse(id json.RawMessage, err error) *message {
+	errMsg := &errorMessage{Code: ErrCodeInvalidRequest, Message: err.Error()}
+	if e, ok := err.(*Error); ok {
+		errMsg.Code = e.Code
+	}
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &message{Version: "2.0", ID: id, Error: errMsg}
+}
+
+// Handler wraps the http handler of the rpc server with the checks of the middleware. The requests of a batch rejected
+// by the middleware get an error response each, while the other ones are forwarded to the rpc server.
+func (m *Middleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestContentLength))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		body = bytes.TrimSpace(body)
+		isBatch := len(body) != 0 && body[0] == '['
+		var msgs []*message
+		if isBatch {
+			err = json.Unmarshal(body, &msgs)
+		} else {
+			var msg message
+			err = json.Unmarshal(body, &msg)
+			msgs = []*message{&msg}
+		}
+		if err != nil {
+			// leave the invalid requests to the rpc server
+			m.forward(next, w, r, body)
+			return
+		}
+
+		if isBatch {
+			if err := m.CheckBatch(len(msgs)); err != nil {
+				writeJSON(w, errorResponse(nil, err))
+				return
+			}
+		}
+
+		clientID := m.ClientID(r.Header.Get(APIKeyHeader), r.RemoteAddr)
+		rejected := make([]error, len(msgs))
+		var forwarded []*message
+		for i, msg := range msgs {
+			if msg == nil {
+				// the invalid request is left to the rpc server
+				forwarded = append(forwarded, msg)
+				continue
+			}
+			if rejected[i] = m.CheckRequest(clientID, msg.Method, msg.Params); rejected[i] == nil {
+				forwarded = append(forwarded, msg)
+			}
+		}
+
+		if len(forwarded) == len(msgs) {
+			m.forward(next, w, r, body)
+			return
+		}
+
+		var responses []*message
+		if len(forwarded) != 0 {
+			fwdBody, _ := json.Marshal(forwarded)
+			rec := newRecorder()
+			next.ServeHTTP(rec, cloneRequest(r, fwdBody))
+			if err := json.Unmarshal(rec.body.Bytes(), &responses); err != nil {
+				// the rpc server failed to handle the batch, e.g. the batch is rejected as a whole
+				m.writeResponse(w, rec.Header(), rec.status, rec.body.Bytes())
+				return
+			}
+		}
+
+		// merge the error responses of the rejected requests with the ones of the rpc server in the request order,
+		// while the notifications get no response
+		var merged []*message
+		for i, msg := range msgs {
+			switch {
+			case msg != nil && len(msg.ID) == 0:
+				continue
+			case rejected[i] != nil:
+				merged = append(merged, errorResponse(msg.ID, rejected[i]))
+			case len(responses) != 0:
+				merged = append(merged, responses[0])
+				responses = responses[1:]
+			}
+		}
+		if !isBatch {
+			if len(merged) == 0 {
+				return
+			}
+			m.writeJSON(w, merged[0])
+			return
+		}
+		m.writeJSON(w, merged)
+	})
+}
+
+// forward serves the request by the rpc server, the size of the response being checked
+func (m *Middleware) forward(next http.Handler, w http.ResponseWriter, r *http.Request, body []byte) {
+	rec := newRecorder()
+	next.ServeHTTP(rec, cloneRequest(r, body))
+	m.writeResponse(w, rec.Header(), rec.status, rec.body.Bytes())
+}
+
+func (m *Middleware) writeResponse(w http.ResponseWriter, header http.Header, status int, body []byte) {
+	if err := m.CheckResponseSize(len(body)); err != nil {
+		writeJSON(w, errorResponse(nil, err))
+		return
+	}
+
+	for k, v := range header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+// writeJSON writes the response built by the middleware, the size of the response being checked
+func (m *Middleware) writeJSON(w http.ResponseWriter, v interface{}) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		writeJSON(w, errorResponse(nil, err))
+		return
+	}
+	if err := m.CheckResponseSize(len(bz)); err != nil {
+		writeJSON(w, errorResponse(nil, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func cloneRequest(r *http.Request, body []byte) *http.Request {
+	req := r.Clone(r.Context())
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return req
+}
+
+// recorder buffers the response of the rpc server so that its size can be checked before being sent
+type recorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newRecorder() *recorder {
+	return &recorder{header: make(http.Header), status: http.StatusOK}
+}
+
+// Header implements the http.ResponseWriter interface
+func (rec *recorder) Header() http.Header {
+	return rec.header
+}
+
+// Write implements the http.ResponseWriter interface
+func (rec *recorder) Write(b []byte) (int, error) {
+	return rec.body.Write(b)
+}
+
+// WriteHeader implements the http.ResponseWriter interface
+func (rec *recorder) WriteHeader(status int) {
+	rec.status = status
+}
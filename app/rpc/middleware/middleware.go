@@ -0,0 +1,289 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/okex/exchain/app/rpc/namespaces/eth/filters"
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// FlagAllowList defines the namespaces and methods allowed to be called, e.g. "eth,net,web3_clientVersion". All of
+	// them are allowed if it's empty
+	FlagAllowList = "rpc.allow-list"
+	// FlagDenyList defines the namespaces and methods disabled, which takes precedence over the allow list
+	FlagDenyList = "rpc.deny-list"
+	// FlagClientRateLimit defines the count of requests allowed per second for each client, 0 disables the limit
+	FlagClientRateLimit = "rpc.client-rate-limit"
+	// FlagClientRateBurst defines the burst of requests allowed for each client
+	FlagClientRateBurst = "rpc.client-rate-burst"
+	// FlagAPIKeys defines the api keys identifying the clients instead of their ip addresses
+	FlagAPIKeys = "rpc.api-keys"
+	// FlagMaxBatchSize defines the max count of requests in a batch, 0 disables the limit
+	FlagMaxBatchSize = "rpc.max-batch-size"
+	// FlagMaxResponseSize defines the max size in bytes of a response, 0 disables the limit
+	FlagMaxResponseSize = "rpc.max-response-size"
+
+	// APIKeyHeader is the http header carrying the api key of the client
+	APIKeyHeader = "X-API-Key"
+
+	// the idle client limiters are evicted after this duration
+	limiterIdleTimeout = 10 * time.Minute
+)
+
+// JSON-RPC error codes returned by the middleware
+const (
+	ErrCodeInvalidRequest   = -32600
+	ErrCodeMethodNotFound   = -32601
+	ErrCodeInvalidParams    = -32602
+	ErrCodeLimitExceeded    = -32005
+	ErrCodeResponseTooLarge = -32003
+)
+
+// Error is a JSON-RPC error of a request rejected by the middleware
+type Error struct {
+	Code    int
+	Message string
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// ErrorCode implements the rpc.Error interface
+func (e *Error) ErrorCode() int {
+	return e.Code
+}
+
+// Middleware enforces the access policies of the RPC server. It's shared by the http handler and the websocket server
+// so that the same policies apply to both of them.
+type Middleware struct {
+	allowList map[string]struct{}
+	denyList  map[string]struct{}
+	apiKeys   map[string]struct{}
+
+	rateLimit rate.Limit
+	rateBurst int
+	limiters  *clientLimiters
+
+	maxBatchSize      int
+	maxResponseSize   int
+	maxLogsBlockRange int64
+	latestHeight      func() (int64, error)
+}
+
+// NewMiddleware creates a new instance of Middleware from the configuration. The latest height is used to resolve the
+// block tags of eth_getLogs, whose block range is limited by the logs height span of the filters.
+func NewMiddleware(latestHeight func() (int64, error)) *Middleware {
+	rateBurst := viper.GetInt(FlagClientRateBurst)
+	if rateBurst < 1 {
+		rateBurst = 1
+	}
+
+	return &Middleware{
+		allowList:         parseList(viper.GetString(FlagAllowList)),
+		denyList:          parseList(viper.GetString(FlagDenyList)),
+		apiKeys:           parseList(viper.GetString(FlagAPIKeys)),
+		rateLimit:         rate.Limit(viper.GetFloat64(FlagClientRateLimit)),
+		rateBurst:         rateBurst,
+		limiters:          newClientLimiters(),
+		maxBatchSize:      viper.GetInt(FlagMaxBatchSize),
+		maxResponseSize:   viper.GetInt(FlagMaxResponseSize),
+		maxLogsBlockRange: viper.GetInt64(filters.FlagGetLogsHeightSpan),
+		latestHeight:      latestHeight,
+	}
+}
+
+func parseList(s string) map[string]struct{} {
+	list := make(map[string]struct{})
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); len(item) != 0 {
+			list[item] = struct{}{}
+		}
+	}
+	return list
+}
+
+// ClientID identifies the client by its api key if it's a configured one, otherwise by its ip address
+func (m *Middleware) ClientID(apiKey, remoteAddr string) string {
+	if _, ok := m.apiKeys[apiKey]; ok && len(apiKey) != 0 {
+		return "key:" + apiKey
+	}
+
+	host := remoteAddr
+	if i := strings.LastIndex(remoteAddr, ":"); i > 0 {
+		host = remoteAddr[:i]
+	}
+	return "ip:" + strings.Trim(host, "[]")
+}
+
+// CheckBatch checks the count of requests in a batch
+func (m *Middleware) CheckBatch(size int) error {
+	if m.maxBatchSize > 0 && size > m.maxBatchSize {
+		return &Error{
+			Code:    ErrCodeInvalidRequest,
+			Message: fmt.Sprintf("batch size %d exceeds the limit %d", size, m.maxBatchSize),
+		}
+	}
+	return nil
+}
+
+// CheckRequest checks whether the client is allowed to call the method with the params
+func (m *Middleware) CheckRequest(clientID, method string, params json.RawMessage) error {
+	if !m.isMethodAllowed(method) {
+		return &Error{
+			Code:    ErrCodeMethodNotFound,
+			Message: fmt.Sprintf("the method %s does not exist/is not available", method),
+		}
+	}
+
+	if m.rateLimit > 0 && !m.limiters.get(clientID, m.rateLimit, m.rateBurst).Allow() {
+		return &Error{Code: ErrCodeLimitExceeded, Message: "request rate limit exceeded"}
+	}
+
+	if method == "eth_getLogs" {
+		return m.checkLogsBlockRange(params)
+	}
+	return nil
+}
+
+// CheckResponseSize checks the size of the response in bytes
+func (m *Middleware) CheckResponseSize(size int) error {
+	if m.maxResponseSize > 0 && size > m.maxResponseSize {
+		return &Error{
+			Code:    ErrCodeResponseTooLarge,
+			Message: fmt.Sprintf("response size %d exceeds the limit %d", size, m.maxResponseSize),
+		}
+	}
+	return nil
+}
+
+// isMethodAllowed checks the method and its namespace against the allow list and the deny list
+func (m *Middleware) isMethodAllowed(method string) bool {
+	namespace := method
+	if i := strings.Index(method, "_"); i >= 0 {
+		namespace = method[:i]
+	}
+
+	if _, ok := m.denyList[method]; ok {
+		return false
+	}
+	if _, ok := m.denyList[namespace]; ok {
+		return false
+	}
+	if len(m.allowList) == 0 {
+		return true
+	}
+	if _, ok := m.allowList[method]; ok {
+		return true
+	}
+	_, ok := m.allowList[namespace]
+	return ok
+}
+
+// checkLogsBlockRange checks the block range of eth_getLogs against the logs height span before the filter runs, the
+// block tags being resolved the same way as the filter does
+func (m *Middleware) checkLogsBlockRange(params json.RawMessage) error {
+	if m.maxLogsBlockRange <= 0 {
+		return nil
+	}
+
+	var crit []struct {
+		BlockHash *string `json:"blockHash"`
+		FromBlock string  `json:"fromBlock"`
+		ToBlock   string  `json:"toBlock"`
+	}
+	if err := json.Unmarshal(params, &crit); err != nil || len(crit) == 0 || crit[0].BlockHash != nil {
+		// leave the invalid params and the single block filter to the service
+		return nil
+	}
+
+	var latest *big.Int
+	resolve := func(block string) (*big.Int, error) {
+		switch block {
+		case "earliest":
+			return big.NewInt(0), nil
+		case "", "latest", "pending":
+			if latest == nil {
+				if m.latestHeight == nil {
+					return nil, fmt.Errorf("latest height unavailable")
+				}
+				height, err := m.latestHeight()
+				if err != nil {
+					return nil, err
+				}
+				latest = big.NewInt(height)
+			}
+			return latest, nil
+		default:
+			return hexutil.DecodeBig(block)
+		}
+	}
+
+	from, err := resolve(crit[0].FromBlock)
+	if err != nil {
+		return nil
+	}
+	to, err := resolve(crit[0].ToBlock)
+	if err != nil {
+		return nil
+	}
+	if new(big.Int).Sub(to, from).Cmp(big.NewInt(m.maxLogsBlockRange)) > 0 {
+		return &Error{
+			Code:    ErrCodeInvalidParams,
+			Message: fmt.Sprintf("block range of eth_getLogs exceeds the limit %d", m.maxLogsBlockRange),
+		}
+	}
+	return nil
+}
+
+// clientLimiters holds the rate limiters of the clients
+type clientLimiters struct {
+	mtx       sync.Mutex
+	limiters  map[string]*clientLimiter
+	lastEvict time.Time
+}
+
+type clientLimiter struct {
+	*rate.Limiter
+	lastSeen time.Time
+}
+
+func newClientLimiters() *clientLimiters {
+	return &clientLimiters{
+		limiters:  make(map[string]*clientLimiter),
+		lastEvict: time.Now(),
+	}
+}
+
+// get returns the rate limiter of the client, the idle ones being evicted periodically
+func (cl *clientLimiters) get(clientID string, limit rate.Limit, burst int) *rate.Limiter {
+	cl.mtx.Lock()
+	defer cl.mtx.Unlock()
+
+	now := time.Now()
+	if now.Sub(cl.lastEvict) > limiterIdleTimeout {
+		for id, limiter := range cl.limiters {
+			if now.Sub(limiter.lastSeen) > limiterIdleTimeout {
+				delete(cl.limiters, id)
+			}
+		}
+		cl.lastEvict = now
+	}
+
+	limiter, ok := cl.limiters[clientID]
+	if !ok {
+		limiter = &clientLimiter{Limiter: rate.NewLimiter(limit, burst)}
+		cl.limiters[clientID] = limiter
+	}
+	limiter.lastSeen = now
+	return limiter.Limiter
+}
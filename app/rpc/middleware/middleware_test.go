@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/okex/exchain/app/rpc/namespaces/eth/filters"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMiddleware(t *testing.T, settings map[string]interface{}) *Middleware {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	for k, v := range settings {
+		viper.Set(k, v)
+	}
+	return NewMiddleware(func() (int64, error) {
+		return 0x100, nil
+	})
+}
+
+func requireErrCode(t *testing.T, code int, err error) {
+	require.Error(t, err)
+	e, ok := err.(*Error)
+	require.True(t, ok)
+	require.Equal(t, code, e.Code)
+}
+
+func TestCheckRequest_MethodList(t *testing.T) {
+	mw := newTestMiddleware(t, nil)
+	require.NoError(t, mw.CheckRequest("ip:127.0.0.1", "debug_traceTransaction", nil))
+
+	mw = newTestMiddleware(t, map[string]interface{}{
+		FlagAllowList: "eth, web3_clientVersion",
+		FlagDenyList:  "eth_sendRawTransaction",
+	})
+	require.NoError(t, mw.CheckRequest("ip:127.0.0.1", "eth_blockNumber", nil))
+	require.NoError(t, mw.CheckRequest("ip:127.0.0.1", "web3_clientVersion", nil))
+	requireErrCode(t, ErrCodeMethodNotFound, mw.CheckRequest("ip:127.0.0.1", "web3_sha3", nil))
+	requireErrCode(t, ErrCodeMethodNotFound, mw.CheckRequest("ip:127.0.0.1", "debug_traceTransaction", nil))
+	// the deny list takes precedence over the allow list
+	requireErrCode(t, ErrCodeMethodNotFound, mw.CheckRequest("ip:127.0.0.1", "eth_sendRawTransaction", nil))
+}
+
+func TestCheckRequest_ClientRateLimit(t *testing.T) {
+	mw := newTestMiddleware(t, map[string]interface{}{
+		FlagClientRateLimit: 0.001,
+		FlagClientRateBurst: 2,
+		FlagAPIKeys:         "key1",
+	})
+
+	client1 := mw.ClientID("", "10.0.0.1:1234")
+	require.Equal(t, "ip:10.0.0.1", client1)
+	require.NoError(t, mw.CheckRequest(client1, "eth_blockNumber", nil))
+	require.NoError(t, mw.CheckRequest(client1, "eth_blockNumber", nil))
+	requireErrCode(t, ErrCodeLimitExceeded, mw.CheckRequest(client1, "eth_blockNumber", nil))
+
+	// the clients are limited separately, the ones with a configured api key being identified by the key
+	client2 := mw.ClientID("key1", "10.0.0.1:1234")
+	require.Equal(t, "key:key1", client2)
+	require.NoError(t, mw.CheckRequest(client2, "eth_blockNumber", nil))
+	require.Equal(t, "ip:10.0.0.1", mw.ClientID("unknown", "10.0.0.1:5678"))
+}
+
+func TestCheckRequest_LogsBlockRange(t *testing.T) {
+	mw := newTestMiddleware(t, map[string]interface{}{filters.FlagGetLogsHeightSpan: 100})
+
+	require.NoError(t, mw.CheckRequest("", "eth_getLogs", json.RawMessage(`[{"fromBlock":"0x1","toBlock":"0x65"}]`)))
+	requireErrCode(t, ErrCodeInvalidParams,
+		mw.CheckRequest("", "eth_getLogs", json.RawMessage(`[{"fromBlock":"0x1","toBlock":"0x66"}]`)))
+	// the block tags are resolved before the check, the latest height being 0x100
+	require.NoError(t, mw.CheckRequest("", "eth_getLogs", json.RawMessage(`[{"fromBlock":"0x9c","toBlock":"latest"}]`)))
+	require.NoError(t, mw.CheckRequest("", "eth_getLogs", json.RawMessage(`[{"fromBlock":"0x9c"}]`)))
+	requireErrCode(t, ErrCodeInvalidParams,
+		mw.CheckRequest("", "eth_getLogs", json.RawMessage(`[{"fromBlock":"0x1","toBlock":"latest"}]`)))
+	requireErrCode(t, ErrCodeInvalidParams,
+		mw.CheckRequest("", "eth_getLogs", json.RawMessage(`[{"fromBlock":"earliest","toBlock":"0x65"}]`)))
+	// the single block filter and the invalid params are left to the filter
+	require.NoError(t, mw.CheckRequest("", "eth_getLogs", json.RawMessage(`[{"blockHash":"0x01"}]`)))
+	require.NoError(t, mw.CheckRequest("", "eth_getLogs", json.RawMessage(`invalid`)))
+
+	// the range is not limited by the default span
+	mw = newTestMiddleware(t, map[string]interface{}{filters.FlagGetLogsHeightSpan: -1})
+	require.NoError(t, mw.CheckRequest("", "eth_getLogs", json.RawMessage(`[{"fromBlock":"earliest"}]`)))
+}
+
+func TestCheckBatchAndResponseSize(t *testing.T) {
+	mw := newTestMiddleware(t, nil)
+	require.NoError(t, mw.CheckBatch(1000))
+	require.NoError(t, mw.CheckResponseSize(1<<30))
+
+	mw = newTestMiddleware(t, map[string]interface{}{FlagMaxBatchSize: 2, FlagMaxResponseSize: 10})
+	require.NoError(t, mw.CheckBatch(2))
+	requireErrCode(t, ErrCodeInvalidRequest, mw.CheckBatch(3))
+	require.NoError(t, mw.CheckResponseSize(10))
+	requireErrCode(t, ErrCodeResponseTooLarge, mw.CheckResponseSize(11))
+}
+
+func TestHandler(t *testing.T) {
+	mw := newTestMiddleware(t, map[string]interface{}{
+		FlagDenyList:     "eth_sendRawTransaction",
+		FlagMaxBatchSize: 3,
+	})
+
+	// echo the methods of the requests as results
+	var forwarded []string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var reqs []*message
+		require.NoError(t, json.Unmarshal(body, &reqs))
+		var res []*message
+		for _, req := range reqs {
+			forwarded = append(forwarded, req.Method)
+			if len(req.ID) != 0 {
+				res = append(res, &message{Version: "2.0", ID: req.ID, Result: json.RawMessage(`"` + req.Method + `"`)})
+			}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	})
+
+	serve := func(body string) []*message {
+		rec := httptest.NewRecorder()
+		mw.Handler(next).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		var res []*message
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res
+	}
+
+	// the allowed requests are forwarded as a whole
+	res := serve(`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`)
+	require.Equal(t, []string{"eth_blockNumber", "eth_chainId"}, forwarded)
+	require.Len(t, res, 2)
+
+	// the responses of the rejected requests are merged in the request order
+	forwarded = nil
+	res = serve(`[{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction"},` +
+		`{"jsonrpc":"2.0","method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber"}]`)
+	require.Equal(t, []string{"eth_chainId", "eth_blockNumber"}, forwarded)
+	require.Len(t, res, 2)
+	require.Equal(t, json.RawMessage("1"), res[0].ID)
+	require.NotNil(t, res[0].Error)
+	require.Equal(t, json.RawMessage("3"), res[1].ID)
+	require.Equal(t, json.RawMessage(`"eth_blockNumber"`), res[1].Result)
+
+	// the batch exceeding the limit is rejected as a whole
+	forwarded = nil
+	rec := httptest.NewRecorder()
+	mw.Handler(next).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(
+		`[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_chainId"},{"id":4,"method":"eth_chainId"}]`)))
+	require.Empty(t, forwarded)
+	var errRes message
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errRes))
+	require.NotNil(t, errRes.Error)
+}
+
+func TestHandler_ResponseSize(t *testing.T) {
+	mw := newTestMiddleware(t, map[string]interface{}{
+		FlagDenyList:        "eth_sendRawTransaction",
+		FlagMaxResponseSize: 100,
+	})
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"jsonrpc":"2.0","id":2,"result":"` + strings.Repeat("0", 100) + `"}]`))
+	})
+
+	// the merged responses of a batch are checked as well as the forwarded ones
+	rec := httptest.NewRecorder()
+	mw.Handler(next).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(
+		`[{"jsonrpc":"2.0","id":1,"method":"eth_sendRawTransaction"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`)))
+	var errRes message
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errRes))
+	require.NotNil(t, errRes.Error)
+	require.Equal(t, float64(ErrCodeResponseTooLarge), errRes.Error.(map[string]interface{})["code"])
+}
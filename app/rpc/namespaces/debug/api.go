@@ -0,0 +1,144 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/exchain/app/rpc/backend"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	ethermint "github.com/okex/exchain/app/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// PublicDebugAPI is the debug_ prefixed set of APIs in the geth JSON-RPC spec.
+type PublicDebugAPI struct {
+	clientCtx clientcontext.CLIContext
+	logger    log.Logger
+	backend   backend.Backend
+}
+
+// NewAPI creates an instance of the public debug API.
+func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend) *PublicDebugAPI {
+	return &PublicDebugAPI{
+		clientCtx: clientCtx,
+		logger:    log.With("module", "json-rpc", "namespace", "debug"),
+		backend:   backend,
+	}
+}
+
+// TraceTransaction re-executes the transaction identified by hash on top of the state it was executed in,
+// and returns the structured logs created during the execution or the result of the given tracer.
+func (api *PublicDebugAPI) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	api.logger.Debug("debug_traceTransaction", "hash", hash)
+
+	tx, err := api.clientCtx.Client.Tx(hash.Bytes(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := api.clientCtx.Client.Block(&tx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the txs executed before the traced one in the same block are replayed first
+	txs := make([][]byte, tx.Index+1)
+	for i := range txs {
+		txs[i] = block.Block.Txs[i]
+	}
+
+	params := evmtypes.NewQueryTraceParams(txs, nil, common.BytesToHash(block.Block.Hash()), tx.Height,
+		block.Block.Time, block.Block.ProposerAddress, traceConfigOrDefault(config))
+	return api.queryTrace(tx.Height-1, params)
+}
+
+// TraceCall executes the call on top of the state of the given block, and returns the structured logs
+// created during the execution or the result of the given tracer.
+func (api *PublicDebugAPI) TraceCall(args rpctypes.CallArgs, blockNum rpctypes.BlockNumber, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	api.logger.Debug("debug_traceCall", "args", args, "block number", blockNum)
+
+	height := blockNum.Int64()
+	if blockNum == rpctypes.PendingBlockNumber || blockNum == rpctypes.LatestBlockNumber {
+		latest, err := api.backend.LatestBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+
+	block, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	call := newCallMsg(args)
+	params := evmtypes.NewQueryTraceParams(nil, &call, common.BytesToHash(block.Block.Hash()), height,
+		block.Block.Time, block.Block.ProposerAddress, traceConfigOrDefault(config))
+	return api.queryTrace(height, params)
+}
+
+// queryTrace sends the trace query to the node at the given height
+func (api *PublicDebugAPI) queryTrace(height int64, params evmtypes.QueryTraceParams) (json.RawMessage, error) {
+	bz, err := api.clientCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := api.clientCtx.WithHeight(height).
+		QueryWithData(fmt.Sprintf("custom/%s/%s", evmtypes.ModuleName, evmtypes.QueryTraceTx), bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func traceConfigOrDefault(config *evmtypes.TraceConfig) evmtypes.TraceConfig {
+	if config == nil {
+		return evmtypes.TraceConfig{}
+	}
+	return *config
+}
+
+// newCallMsg converts the call args into an ethermint message with the same defaults as eth_call
+func newCallMsg(args rpctypes.CallArgs) evmtypes.MsgEthermint {
+	var from common.Address
+	if args.From != nil {
+		from = *args.From
+	}
+
+	gas := uint64(ethermint.DefaultRPCGasLimit)
+	if args.Gas != nil && uint64(*args.Gas) < gas {
+		gas = uint64(*args.Gas)
+	}
+
+	gasPrice := new(big.Int).SetUint64(ethermint.DefaultGasPrice)
+	if args.GasPrice != nil {
+		gasPrice = args.GasPrice.ToInt()
+	}
+
+	value := new(big.Int)
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+
+	var data []byte
+	if args.Data != nil {
+		data = *args.Data
+	}
+
+	var toAddr *sdk.AccAddress
+	if args.To != nil {
+		to := sdk.AccAddress(args.To.Bytes())
+		toAddr = &to
+	}
+
+	return evmtypes.NewMsgEthermint(0, toAddr, sdk.NewIntFromBigInt(value), gas,
+		sdk.NewIntFromBigInt(gasPrice), data, sdk.AccAddress(from.Bytes()))
+}
@@ -3,6 +3,7 @@ package eth
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -14,6 +15,7 @@ import (
 	cmserver "github.com/cosmos/cosmos-sdk/server"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/spf13/viper"
 
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
@@ -25,15 +27,14 @@ import (
 	evmtypes "github.com/okex/exchain/x/evm/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcore "github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/rlp"
 
 	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -195,7 +196,30 @@ func (api *PublicEthereumAPI) Hashrate() hexutil.Uint64 {
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (api *PublicEthereumAPI) GasPrice() *hexutil.Big {
 	api.logger.Debug("eth_gasPrice")
-	return api.gasPrice
+	return api.suggestGasPrice()
+}
+
+// MaxPriorityFeePerGas returns a suggestion for a gas tip cap of dynamic fee transactions. There is no base fee on the
+// chain, so it is the same as the suggested gas price.
+func (api *PublicEthereumAPI) MaxPriorityFeePerGas() *hexutil.Big {
+	api.logger.Debug("eth_maxPriorityFeePerGas")
+	return api.suggestGasPrice()
+}
+
+// FeeHistory returns the gas usage ratio and the effective gas price percentiles of the requested block range.
+func (api *PublicEthereumAPI) FeeHistory(blockCount hexutil.Uint64, lastBlock rpctypes.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error) {
+	api.logger.Debug("eth_feeHistory", "block count", blockCount, "last block", lastBlock, "reward percentiles", rewardPercentiles)
+	return api.backend.FeeHistory(int(blockCount), lastBlock, rewardPercentiles)
+}
+
+// suggestGasPrice returns the gas price suggested by the gas price oracle of the backend, which is never lower than
+// the minimum gas price of the node
+func (api *PublicEthereumAPI) suggestGasPrice() *hexutil.Big {
+	price, err := api.backend.SuggestGasPrice()
+	if err != nil || price == nil || price.Cmp(api.gasPrice.ToInt()) < 0 {
+		return api.gasPrice
+	}
+	return (*hexutil.Big)(price)
 }
 
 // Accounts returns the list of accounts available to this node.
@@ -496,8 +520,8 @@ func (api *PublicEthereumAPI) SendRawTransaction(data hexutil.Bytes) (common.Has
 	api.logger.Debug("eth_sendRawTransaction", "data", data)
 	tx := new(evmtypes.MsgEthereumTx)
 
-	// RLP decode raw transaction bytes
-	if err := rlp.DecodeBytes(data, tx); err != nil {
+	// decode raw transaction bytes, either a legacy RLP transaction or an EIP-2718 typed one
+	if err := tx.UnmarshalBinary(data); err != nil {
 		// Return nil is for when gasLimit overflows uint64
 		return common.Hash{}, nil
 	}
@@ -523,10 +547,11 @@ func (api *PublicEthereumAPI) SendRawTransaction(data hexutil.Bytes) (common.Has
 	return common.HexToHash(res.TxHash), nil
 }
 
-// Call performs a raw contract call.
-func (api *PublicEthereumAPI) Call(args rpctypes.CallArgs, blockNr rpctypes.BlockNumber, _ *map[common.Address]rpctypes.Account) (hexutil.Bytes, error) {
-	api.logger.Debug("eth_call", "args", args, "block number", blockNr)
-	simRes, err := api.doCall(args, blockNr, big.NewInt(ethermint.DefaultRPCGasLimit), false)
+// Call performs a raw contract call. The state overrides are applied to the state
+// of the accounts before the call is simulated.
+func (api *PublicEthereumAPI) Call(args rpctypes.CallArgs, blockNr rpctypes.BlockNumber, overrides *evmtypes.StateOverride) (hexutil.Bytes, error) {
+	api.logger.Debug("eth_call", "args", args, "block number", blockNr, "overrides", overrides)
+	simRes, err := api.doCall(args, blockNr, big.NewInt(ethermint.DefaultRPCGasLimit), false, overrides)
 	if err != nil {
 		return []byte{}, TransformDataError(err, "eth_call")
 	}
@@ -543,6 +568,7 @@ func (api *PublicEthereumAPI) Call(args rpctypes.CallArgs, blockNr rpctypes.Bloc
 // estimated gas used on the operation or an error if fails.
 func (api *PublicEthereumAPI) doCall(
 	args rpctypes.CallArgs, blockNum rpctypes.BlockNumber, globalGasCap *big.Int, isEstimate bool,
+	overrides *evmtypes.StateOverride,
 ) (*sdk.SimulationResponse, error) {
 
 	clientCtx := api.clientCtx
@@ -608,6 +634,14 @@ func (api *PublicEthereumAPI) doCall(
 	// Create new call message
 	msg := evmtypes.NewMsgEthermint(nonce, &toAddr, sdk.NewIntFromBigInt(value), gas,
 		sdk.NewIntFromBigInt(gasPrice), data, sdk.AccAddress(addr.Bytes()))
+	if overrides != nil {
+		// the overrides are applied to the state db during the simulation of the call
+		bz, err := json.Marshal(overrides)
+		if err != nil {
+			return nil, err
+		}
+		msg.StateOverrides = bz
+	}
 	msgs = append(msgs, msg)
 
 	// convert the pending transactions into ethermint msgs
@@ -648,21 +682,108 @@ func (api *PublicEthereumAPI) doCall(
 	return &simResponse, nil
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
-// It adds 1,000 gas to the returned value instead of using the gas adjustment
-// param from the SDK.
-func (api *PublicEthereumAPI) EstimateGas(args rpctypes.CallArgs) (hexutil.Uint64, error) {
-	api.logger.Debug("eth_estimateGas", "args", args)
-	simResponse, err := api.doCall(args, 0, big.NewInt(ethermint.DefaultRPCGasLimit), true)
+// EstimateGas returns the lowest gas limit that allows the given smart contract
+// call to execute successfully at the given block (latest by default). The gas
+// limit is found by a binary search between the intrinsic gas and the gas cap
+// as geth does, which takes the 63/64 rule of the gas passed to the sub calls
+// into account.
+func (api *PublicEthereumAPI) EstimateGas(args rpctypes.CallArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	api.logger.Debug("eth_estimateGas", "args", args, "block number", blockNrOptional)
+	blockNr := rpctypes.LatestBlockNumber
+	if blockNrOptional != nil {
+		blockNr = *blockNrOptional
+	}
+
+	intrinsicGas, err := api.intrinsicGas(args, blockNr)
+	if err != nil {
+		return 0, TransformDataError(err, RPCEthEstimateGas)
+	}
+
+	// determine the highest gas limit that can be used during the estimation
+	gasCap := uint64(ethermint.DefaultRPCGasLimit)
+	hi := gasCap
+	if args.Gas != nil && uint64(*args.Gas) >= intrinsicGas && uint64(*args.Gas) < gasCap {
+		hi = uint64(*args.Gas)
+	}
+
+	// executable tries the call with the gas limit, and returns the gas used if it succeeds
+	executable := func(gas uint64) (uint64, error) {
+		callArgs := args
+		gasLimit := hexutil.Uint64(gas)
+		callArgs.Gas = &gasLimit
+		simResponse, err := api.doCall(callArgs, blockNr, new(big.Int).SetUint64(gasCap), true, nil)
+		if err != nil {
+			return 0, err
+		}
+		return simResponse.GasInfo.GasUsed, nil
+	}
+
+	// the call failing with the highest gas limit fails with any gas limit, report the reason directly
+	gasUsed, err := executable(hi)
+	if err != nil {
+		return 0, TransformDataError(err, RPCEthEstimateGas)
+	}
+
+	// the call can't succeed with less gas than it used
+	lo := intrinsicGas - 1
+	if gasUsed > lo+1 {
+		lo = gasUsed - 1
+	}
+
+	// the sub calls get at most 63/64 of the remaining gas, so the gas used with the stipend and the share kept by the
+	// caller is very likely enough, which saves most of the iterations
+	optimisticGas := (gasUsed + params.CallStipend) * 64 / 63
+	if optimisticGas < hi {
+		if _, err := executable(optimisticGas); err == nil {
+			hi = optimisticGas
+		} else {
+			lo = optimisticGas
+		}
+	}
+
+	for lo+1 < hi {
+		mid := lo + (hi-lo)/2
+		if _, err := executable(mid); err != nil {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return hexutil.Uint64(hi), nil
+}
+
+// intrinsicGas returns the intrinsic gas of the call with the chain config at the given block, as the state transition
+// charges it
+func (api *PublicEthereumAPI) intrinsicGas(args rpctypes.CallArgs, blockNr rpctypes.BlockNumber) (uint64, error) {
+	clientCtx := api.clientCtx
+	if !(blockNr == rpctypes.PendingBlockNumber || blockNr == rpctypes.LatestBlockNumber) {
+		clientCtx = api.clientCtx.WithHeight(blockNr.Int64())
+	}
+
+	res, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s", evmtypes.ModuleName, evmtypes.QueryChainConfig))
 	if err != nil {
-		return 0, TransformDataError(err, "eth_estimateGas")
+		return 0, err
 	}
 
-	// TODO: change 1000 buffer for more accurate buffer (eg: SDK's gasAdjusted)
-	estimatedGas := simResponse.GasInfo.GasUsed
-	gas := estimatedGas + 1000
+	var config evmtypes.ChainConfig
+	if err := api.clientCtx.Codec.UnmarshalJSON(res, &config); err != nil {
+		return 0, err
+	}
 
-	return hexutil.Uint64(gas), nil
+	var data []byte
+	if args.Data != nil {
+		data = *args.Data
+	}
+	gas, err := ethcore.IntrinsicGas(data, args.To == nil, config.IsHomestead(), config.IsIstanbul())
+	if err != nil {
+		return 0, err
+	}
+
+	if args.Accesses != nil {
+		gas += args.Accesses.IntrinsicGas()
+	}
+	return gas, nil
 }
 
 // GetBlockByHash returns the block identified by hash.
@@ -879,25 +1000,128 @@ func (api *PublicEthereumAPI) GetTransactionReceipt(hash common.Hash) (interface
 		return nil, err
 	}
 
-	from, err := ethTx.VerifySig(ethTx.ChainID())
+	cumulativeGasUsed := uint64(tx.TxResult.GasUsed)
+	if tx.Index != 0 {
+		cumulativeGasUsed += rpctypes.GetBlockCumulativeGas(api.clientCtx.Codec, block.Block, int(tx.Index))
+	}
+
+	return formatReceipt(ethTx, &tx.TxResult, hash, blockHash, tx.Height, uint64(tx.Index), cumulativeGasUsed)
+}
+
+// GetBlockReceipts returns the receipts of all the transactions in the block identified by number or hash.
+func (api *PublicEthereumAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]interface{}, error) {
+	api.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	if receipts, err := api.getBlockReceiptsFromWatcher(blockNrOrHash); err == nil {
+		return receipts, nil
+	}
+
+	var height int64
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		res, _, err := api.clientCtx.Query(fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryHashToHeight, hash.Hex()))
+		if err != nil {
+			// Return nil for block when not found
+			return nil, nil
+		}
+
+		var out evmtypes.QueryResBlockNumber
+		if err := api.clientCtx.Codec.UnmarshalJSON(res, &out); err != nil {
+			return nil, err
+		}
+		height = out.Number
+	} else {
+		blockNum, _ := blockNrOrHash.Number()
+		switch blockNum {
+		case rpctypes.PendingBlockNumber:
+			// the receipts of the pending transactions are not available
+			return nil, nil
+		case rpctypes.LatestBlockNumber:
+			latest, err := api.backend.LatestBlockNumber()
+			if err != nil {
+				return nil, err
+			}
+			height = latest
+		default:
+			height = blockNum.Int64()
+		}
+	}
+
+	block, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, nil
+	}
+	blockResults, err := api.clientCtx.Client.BlockResults(&height)
 	if err != nil {
 		return nil, err
 	}
 
-	cumulativeGasUsed := uint64(tx.TxResult.GasUsed)
-	if tx.Index != 0 {
-		cumulativeGasUsed += rpctypes.GetBlockCumulativeGas(api.clientCtx.Codec, block.Block, int(tx.Index))
+	blockHash := common.BytesToHash(block.Block.Hash())
+	receipts := []interface{}{}
+	for i, tx := range block.Block.Txs {
+		if i >= len(blockResults.TxsResults) {
+			break
+		}
+		txResult := blockResults.TxsResults[i]
+
+		ethTx, err := rpctypes.RawTxToEthTx(api.clientCtx, tx)
+		if err != nil {
+			// skip the transactions which are not a MsgEthereumTx
+			continue
+		}
+
+		// the cumulative gas is counted the same way as eth_getTransactionReceipt does
+		cumulativeGasUsed := uint64(txResult.GasUsed) + rpctypes.GetBlockCumulativeGas(api.clientCtx.Codec, block.Block, i)
+		receipt, err := formatReceipt(ethTx, txResult, common.BytesToHash(tx.Hash()), blockHash, height, uint64(i), cumulativeGasUsed)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// getBlockReceiptsFromWatcher gets the receipts of the block from the watcher db in the fast query mode
+func (api *PublicEthereumAPI) getBlockReceiptsFromWatcher(blockNrOrHash rpctypes.BlockNumberOrHash) ([]interface{}, error) {
+	var (
+		receipts []*watcher.TransactionReceipt
+		err      error
+	)
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		receipts, err = api.wrappedBackend.GetBlockReceipts(hash)
+	} else {
+		blockNum, _ := blockNrOrHash.Number()
+		if blockNum == rpctypes.PendingBlockNumber {
+			return nil, errors.New("pending block is not stored in the watcher db")
+		}
+		receipts, err = api.wrappedBackend.GetBlockReceiptsByNumber(uint64(blockNum))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(receipts))
+	for i, receipt := range receipts {
+		res[i] = receipt
+	}
+	return res, nil
+}
+
+// formatReceipt builds the receipt of the eth transaction from its execution result in the block
+func formatReceipt(ethTx *evmtypes.MsgEthereumTx, txResult *abci.ResponseDeliverTx, hash, blockHash common.Hash,
+	height int64, index, cumulativeGasUsed uint64) (map[string]interface{}, error) {
+	from, err := ethTx.VerifySig(ethTx.ChainID())
+	if err != nil {
+		return nil, err
 	}
 
 	// Set status codes based on tx result
 	var status hexutil.Uint
-	if tx.TxResult.IsOK() {
+	if txResult.IsOK() {
 		status = hexutil.Uint(1)
 	} else {
 		status = hexutil.Uint(0)
 	}
 
-	txData := tx.TxResult.GetData()
+	txData := txResult.GetData()
 
 	data, err := evmtypes.DecodeResultData(txData)
 	if err != nil {
@@ -923,17 +1147,21 @@ func (api *PublicEthereumAPI) GetTransactionReceipt(hash common.Hash) (interface
 		// They are stored in the chain database.
 		"transactionHash": hash,
 		"contractAddress": contractAddr,
-		"gasUsed":         hexutil.Uint64(tx.TxResult.GasUsed),
+		"gasUsed":         hexutil.Uint64(txResult.GasUsed),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
 		"blockHash":        blockHash,
-		"blockNumber":      hexutil.Uint64(tx.Height),
-		"transactionIndex": hexutil.Uint64(tx.Index),
+		"blockNumber":      hexutil.Uint64(height),
+		"transactionIndex": hexutil.Uint64(index),
 
 		// sender and receiver (contract or EOA) addresses
 		"from": from,
 		"to":   ethTx.To(),
+
+		// EIP-2718 transaction type and the gas price actually paid
+		"type":              hexutil.Uint64(ethTx.Data.Type),
+		"effectiveGasPrice": (*hexutil.Big)(ethTx.Data.Price),
 	}
 	return receipt, nil
 }
@@ -995,17 +1223,10 @@ func (api *PublicEthereumAPI) GetProof(address common.Address, storageKeys []str
 		var value evmtypes.QueryResStorage
 		value.Value = vRes.GetValue()
 
-		// check for proof
-		proof := vRes.GetProof()
-		proofStr := new(merkle.Proof).String()
-		if proof != nil {
-			proofStr = proof.String()
-		}
-
 		storageProofs[i] = rpctypes.StorageResult{
 			Key:   k,
 			Value: (*hexutil.Big)(common.BytesToHash(value.Value).Big()),
-			Proof: []string{proofStr},
+			Proof: rpctypes.NewProofOps(vRes.GetProof()),
 		}
 	}
 
@@ -1021,20 +1242,22 @@ func (api *PublicEthereumAPI) GetProof(address common.Address, storageKeys []str
 		return nil, err
 	}
 
-	// check for proof
-	accountProof := res.GetProof()
-	accProofStr := new(merkle.Proof).String()
-	if accountProof != nil {
-		accProofStr = accountProof.String()
+	accountProof := rpctypes.NewProofOps(res.GetProof())
+	// the root hash of the evm store is committed in the multistore proof of the account proof, which is left empty
+	// if the proof comes without the multistore proof op
+	storageHash, err := rpctypes.GetStoreHash(accountProof, evmtypes.StoreKey)
+	if err != nil {
+		api.logger.Debug("failed to get the storage hash from the account proof", "address", address, "error", err)
 	}
 
 	return &rpctypes.AccountResult{
 		Address:      address,
-		AccountProof: []string{accProofStr},
+		AccountProof: accountProof,
+		AccountData:  res.GetValue(),
 		Balance:      (*hexutil.Big)(utils.MustUnmarshalBigInt(account.Balance)),
 		CodeHash:     common.BytesToHash(account.CodeHash),
 		Nonce:        hexutil.Uint64(account.Nonce),
-		StorageHash:  common.Hash{}, // Ethermint doesn't have a storage hash
+		StorageHash:  common.BytesToHash(storageHash),
 		StorageProof: storageProofs,
 	}, nil
 }
@@ -1093,7 +1316,7 @@ func (api *PublicEthereumAPI) generateFromArgs(args rpctypes.SendTxArgs) (*evmty
 			Value:    args.Value,
 			Data:     &input,
 		}
-		gl, err := api.EstimateGas(callArgs)
+		gl, err := api.EstimateGas(callArgs, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,181 @@
+package txpool
+
+import (
+	"fmt"
+	"sort"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/exchain/app/rpc/backend"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+)
+
+const (
+	pendingKey = "pending"
+	queuedKey  = "queued"
+)
+
+// PublicTxPoolAPI is the txpool_ prefixed set of APIs in the geth JSON-RPC spec. The pool is the tendermint mempool.
+type PublicTxPoolAPI struct {
+	clientCtx clientcontext.CLIContext
+	logger    log.Logger
+	backend   backend.Backend
+}
+
+// NewAPI creates an instance of the public txpool API.
+func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend) *PublicTxPoolAPI {
+	return &PublicTxPoolAPI{
+		clientCtx: clientCtx,
+		logger:    log.With("module", "json-rpc", "namespace", "txpool"),
+		backend:   backend,
+	}
+}
+
+// Content returns the transactions contained within the mempool, grouped by sender and nonce.
+func (api *PublicTxPoolAPI) Content() (map[string]map[string]map[string]*rpctypes.Transaction, error) {
+	api.logger.Debug("txpool_content")
+	pending, queued, err := api.poolTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[string]map[string]*rpctypes.Transaction{
+		pendingKey: make(map[string]map[string]*rpctypes.Transaction),
+		queuedKey:  make(map[string]map[string]*rpctypes.Transaction),
+	}
+	for account, txs := range pending {
+		dump := make(map[string]*rpctypes.Transaction)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = tx
+		}
+		content[pendingKey][account.Hex()] = dump
+	}
+	for account, txs := range queued {
+		dump := make(map[string]*rpctypes.Transaction)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = tx
+		}
+		content[queuedKey][account.Hex()] = dump
+	}
+	return content, nil
+}
+
+// Status returns the number of pending and queued transactions in the mempool.
+func (api *PublicTxPoolAPI) Status() (map[string]hexutil.Uint, error) {
+	api.logger.Debug("txpool_status")
+	pending, queued, err := api.poolTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingCnt, queuedCnt int
+	for _, txs := range pending {
+		pendingCnt += len(txs)
+	}
+	for _, txs := range queued {
+		queuedCnt += len(txs)
+	}
+	return map[string]hexutil.Uint{
+		pendingKey: hexutil.Uint(pendingCnt),
+		queuedKey:  hexutil.Uint(queuedCnt),
+	}, nil
+}
+
+// Inspect returns a textual summary of the transactions in the mempool, grouped by sender and nonce.
+func (api *PublicTxPoolAPI) Inspect() (map[string]map[string]map[string]string, error) {
+	api.logger.Debug("txpool_inspect")
+	pending, queued, err := api.poolTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	content := map[string]map[string]map[string]string{
+		pendingKey: make(map[string]map[string]string),
+		queuedKey:  make(map[string]map[string]string),
+	}
+	for account, txs := range pending {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = format(tx)
+		}
+		content[pendingKey][account.Hex()] = dump
+	}
+	for account, txs := range queued {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = format(tx)
+		}
+		content[queuedKey][account.Hex()] = dump
+	}
+	return content, nil
+}
+
+// poolTxs returns the ethereum transactions of the mempool, split into the executable ones and the ones gapped by a
+// missing nonce
+func (api *PublicTxPoolAPI) poolTxs() (pending, queued map[common.Address][]*rpctypes.Transaction, err error) {
+	txs, err := api.backend.PendingTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+	return splitTxs(txs, api.accountNonce)
+}
+
+// accountNonce returns the committed nonce of the account
+func (api *PublicTxPoolAPI) accountNonce(address common.Address) (uint64, error) {
+	from := sdk.AccAddress(address.Bytes())
+	accRet := authtypes.NewAccountRetriever(api.clientCtx)
+	if err := accRet.EnsureExists(from); err != nil {
+		// account doesn't exist yet, return 0
+		return 0, nil
+	}
+
+	_, nonce, err := accRet.GetAccountNumberSequence(from)
+	return nonce, err
+}
+
+// splitTxs groups the transactions by sender. The transactions with consecutive nonces starting from the committed
+// nonce of the sender are pending, the ones behind a nonce gap are queued. The stale transactions with a nonce below the
+// committed nonce, which can never be executed, are dropped.
+func splitTxs(txs []*rpctypes.Transaction, nonceFn func(common.Address) (uint64, error)) (pending, queued map[common.Address][]*rpctypes.Transaction, err error) {
+	bySender := make(map[common.Address][]*rpctypes.Transaction)
+	for _, tx := range txs {
+		bySender[tx.From] = append(bySender[tx.From], tx)
+	}
+
+	pending = make(map[common.Address][]*rpctypes.Transaction)
+	queued = make(map[common.Address][]*rpctypes.Transaction)
+	for sender, senderTxs := range bySender {
+		sort.SliceStable(senderTxs, func(i, j int) bool { return senderTxs[i].Nonce < senderTxs[j].Nonce })
+
+		nonce, err := nonceFn(sender)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i, tx := range senderTxs {
+			if uint64(tx.Nonce) < nonce {
+				continue
+			}
+			if uint64(tx.Nonce) > nonce {
+				queued[sender] = senderTxs[i:]
+				break
+			}
+			nonce++
+			pending[sender] = append(pending[sender], tx)
+		}
+	}
+	return pending, queued, nil
+}
+
+// format returns the summary of the transaction in the same format as geth
+func format(tx *rpctypes.Transaction) string {
+	if tx.To == nil {
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
@@ -0,0 +1,64 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+)
+
+func newTestTx(from common.Address, nonce uint64) *rpctypes.Transaction {
+	to := common.BytesToAddress([]byte("recipient"))
+	return &rpctypes.Transaction{
+		From:     from,
+		Nonce:    hexutil.Uint64(nonce),
+		To:       &to,
+		Gas:      21000,
+		GasPrice: (*hexutil.Big)(big.NewInt(1)),
+		Value:    (*hexutil.Big)(big.NewInt(10)),
+	}
+}
+
+func TestSplitTxs(t *testing.T) {
+	addr1 := common.BytesToAddress([]byte("addr1"))
+	addr2 := common.BytesToAddress([]byte("addr2"))
+	nonces := map[common.Address]uint64{addr1: 3, addr2: 0}
+
+	txs := []*rpctypes.Transaction{
+		newTestTx(addr1, 4),
+		newTestTx(addr1, 3),
+		newTestTx(addr1, 6),
+		newTestTx(addr1, 7),
+		newTestTx(addr1, 2),
+		newTestTx(addr2, 1),
+	}
+
+	pending, queued, err := splitTxs(txs, func(addr common.Address) (uint64, error) { return nonces[addr], nil })
+	require.NoError(t, err)
+
+	require.Len(t, pending[addr1], 2)
+	require.Equal(t, hexutil.Uint64(3), pending[addr1][0].Nonce)
+	require.Equal(t, hexutil.Uint64(4), pending[addr1][1].Nonce)
+	require.Len(t, queued[addr1], 2)
+	require.Equal(t, hexutil.Uint64(6), queued[addr1][0].Nonce)
+	// the stale tx with a nonce below the committed nonce is dropped
+	for _, tx := range append(pending[addr1], queued[addr1]...) {
+		require.NotEqual(t, hexutil.Uint64(2), tx.Nonce)
+	}
+
+	// the first nonce of addr2 is missing
+	require.Empty(t, pending[addr2])
+	require.Len(t, queued[addr2], 1)
+}
+
+func TestFormat(t *testing.T) {
+	tx := newTestTx(common.Address{}, 0)
+	require.Equal(t, tx.To.Hex()+": 10 wei + 21000 gas × 1 wei", format(tx))
+
+	tx.To = nil
+	require.Equal(t, "contract creation: 10 wei + 21000 gas × 1 wei", format(tx))
+}
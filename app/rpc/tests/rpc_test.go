@@ -668,7 +668,7 @@ func TestEth_EstimateGas_Transfer(t *testing.T) {
 	err := json.Unmarshal(rpcRes.Result, &gas)
 	require.NoError(t, err, string(rpcRes.Result))
 
-	require.Equal(t, "0x100bb", gas)
+	require.Equal(t, "0xfcd3", gas)
 }
 
 func TestEth_EstimateGas_ContractDeployment(t *testing.T) {
@@ -687,7 +687,7 @@ func TestEth_EstimateGas_ContractDeployment(t *testing.T) {
 	err := json.Unmarshal(rpcRes.Result, &gas)
 	require.NoError(t, err, string(rpcRes.Result))
 
-	require.Equal(t, "0x1b243", gas.String())
+	require.Equal(t, "0x1ae5b", gas.String())
 }
 
 func TestEth_GetBlockByHash(t *testing.T) {
@@ -820,6 +820,53 @@ func TestEth_GetTransactionReceipt(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestEth_GetBlockReceipts(t *testing.T) {
+	hash := sendTestTransaction(t, hexAddr1, receiverAddr, 1024)
+
+	// sleep for a while
+	time.Sleep(3 * time.Second)
+	rpcRes := Call(t, "eth_getTransactionReceipt", []interface{}{hash})
+	var receipt map[string]interface{}
+	require.NoError(t, json.Unmarshal(rpcRes.Result, &receipt))
+
+	containsTx := func(receipts []map[string]interface{}) bool {
+		for _, r := range receipts {
+			if strings.EqualFold(hash.Hex(), r["transactionHash"].(string)) {
+				require.Equal(t, receipt["blockHash"], r["blockHash"])
+				require.Equal(t, receipt["gasUsed"], r["gasUsed"])
+				require.Equal(t, receipt["cumulativeGasUsed"], r["cumulativeGasUsed"])
+				return true
+			}
+		}
+		return false
+	}
+
+	// query by block number
+	var receipts []map[string]interface{}
+	rpcRes = Call(t, "eth_getBlockReceipts", []interface{}{receipt["blockNumber"]})
+	require.NoError(t, json.Unmarshal(rpcRes.Result, &receipts))
+	require.True(t, containsTx(receipts))
+
+	// query by block hash
+	rpcRes = Call(t, "eth_getBlockReceipts", []interface{}{receipt["blockHash"]})
+	require.NoError(t, json.Unmarshal(rpcRes.Result, &receipts))
+	require.True(t, containsTx(receipts))
+
+	rpcRes = Call(t, "eth_getBlockReceipts", []interface{}{map[string]interface{}{"blockHash": receipt["blockHash"]}})
+	require.NoError(t, json.Unmarshal(rpcRes.Result, &receipts))
+	require.True(t, containsTx(receipts))
+
+	// inexistent block hash -> nil without error
+	rpcRes, err := CallWithError("eth_getBlockReceipts", []interface{}{inexistentHash})
+	require.NoError(t, err)
+	assertNullFromJSONResponse(t, rpcRes.Result)
+
+	// error check
+	// miss argument
+	_, err = CallWithError("eth_getBlockReceipts", nil)
+	require.Error(t, err)
+}
+
 func TestEth_PendingTransactions(t *testing.T) {
 	// there will be no pending tx in mempool because of the quick grab of block building
 	rpcRes := Call(t, "eth_pendingTransactions", nil)
@@ -910,6 +957,8 @@ func TestEth_GetProof(t *testing.T) {
 	require.True(t, accRes.Address == hexAddr2)
 	require.True(t, initialBalance.Amount.Int.Cmp(accRes.Balance.ToInt()) == 0)
 	require.NotEmpty(t, accRes.AccountProof)
+	require.NotEmpty(t, accRes.AccountData)
+	require.NotEqual(t, ethcmn.Hash{}, accRes.StorageHash)
 	require.NotEmpty(t, accRes.StorageProof)
 
 	// inexistentAddr -> zero value account result
@@ -1306,7 +1355,60 @@ func excuteInvalidMessage(t *testing.T, ws *websocket.Conn, message []byte) {
 	var res Response
 	require.NoError(t, json.Unmarshal(msg[:n], &res))
 	require.Equal(t, -32600, res.Error.Code)
-	require.Equal(t, 1, res.ID)
+	// the id of the request is echoed
+	require.Equal(t, 2, res.ID)
+}
+
+func TestWebsocket_BatchRequest(t *testing.T) {
+	// create websocket
+	origin, url := "http://127.0.0.1:8546/", "ws://127.0.0.1:8546"
+	ws, err := websocket.Dial(url, "", origin)
+	require.NoError(t, err)
+	defer func() {
+		// close websocket
+		err = ws.Close()
+		require.NoError(t, err)
+	}()
+
+	msg := make([]byte, 10240)
+	// a single request is dispatched in-process
+	_, err = ws.Write([]byte(`{"jsonrpc": "2.0", "id": 7, "method": "eth_chainId", "params": []}`))
+	require.NoError(t, err)
+	n, err := ws.Read(msg)
+	require.NoError(t, err)
+	var res Response
+	require.NoError(t, json.Unmarshal(msg[:n], &res))
+	require.Nil(t, res.Error)
+	require.Equal(t, 7, res.ID)
+
+	// a batch gets the responses in order with the ids echoed, while the notification gets none
+	_, err = ws.Write([]byte(`[
+		{"jsonrpc": "2.0", "id": 1, "method": "eth_chainId", "params": []},
+		{"jsonrpc": "2.0", "id": 2, "method": "eth_nonexistentMethod", "params": []},
+		{"jsonrpc": "2.0", "method": "eth_blockNumber", "params": []},
+		{"jsonrpc": "2.0", "id": 3, "method": "eth_blockNumber", "params": []}
+	]`))
+	require.NoError(t, err)
+	n, err = ws.Read(msg)
+	require.NoError(t, err)
+	var batchRes []Response
+	require.NoError(t, json.Unmarshal(msg[:n], &batchRes))
+	require.Len(t, batchRes, 3)
+	require.Equal(t, 1, batchRes[0].ID)
+	require.Nil(t, batchRes[0].Error)
+	require.Equal(t, 2, batchRes[1].ID)
+	require.Equal(t, -32601, batchRes[1].Error.Code)
+	require.Equal(t, 3, batchRes[2].ID)
+	require.Nil(t, batchRes[2].Error)
+
+	// the id of eth_unsubscribe is echoed as well
+	_, err = ws.Write([]byte(`{"jsonrpc": "2.0", "id": 9, "method": "eth_unsubscribe", "params": ["0x0"]}`))
+	require.NoError(t, err)
+	n, err = ws.Read(msg)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(msg[:n], &res))
+	require.Equal(t, 9, res.ID)
+	require.Equal(t, "false", string(res.Result))
 }
 
 func TestWebsocket_PendingTransaction(t *testing.T) {
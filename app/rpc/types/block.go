@@ -1,11 +1,13 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -79,3 +81,64 @@ func (bn BlockNumber) TmHeight() *int64 {
 	height := bn.Int64()
 	return &height
 }
+
+// BlockNumberOrHash represents a block identified either by its number or by its hash
+type BlockNumberOrHash struct {
+	BlockNumber *BlockNumber `json:"blockNumber,omitempty"`
+	BlockHash   *common.Hash `json:"blockHash,omitempty"`
+}
+
+// UnmarshalJSON parses the given JSON fragment into a BlockNumberOrHash. It supports:
+// - an object with either the blockNumber or the blockHash field (EIP-1898)
+// - a block hash as a 32 bytes hex string
+// - the arguments supported by BlockNumber
+func (bnh *BlockNumberOrHash) UnmarshalJSON(data []byte) error {
+	var obj struct {
+		BlockNumber *BlockNumber `json:"blockNumber"`
+		BlockHash   *common.Hash `json:"blockHash"`
+	}
+	if err := json.Unmarshal(data, &obj); err == nil {
+		if (obj.BlockNumber == nil) == (obj.BlockHash == nil) {
+			return fmt.Errorf("exactly one of blockNumber and blockHash must be specified")
+		}
+		bnh.BlockNumber, bnh.BlockHash = obj.BlockNumber, obj.BlockHash
+		return nil
+	}
+
+	var input string
+	if err := json.Unmarshal(data, &input); err != nil {
+		return err
+	}
+	if len(input) == 2+2*common.HashLength {
+		hash, err := hexutil.Decode(input)
+		if err != nil {
+			return err
+		}
+		blockHash := common.BytesToHash(hash)
+		bnh.BlockHash = &blockHash
+		return nil
+	}
+
+	var bn BlockNumber
+	if err := bn.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	bnh.BlockNumber = &bn
+	return nil
+}
+
+// Number returns the block number if the block is identified by its number
+func (bnh BlockNumberOrHash) Number() (BlockNumber, bool) {
+	if bnh.BlockNumber != nil {
+		return *bnh.BlockNumber, true
+	}
+	return BlockNumber(0), false
+}
+
+// Hash returns the block hash if the block is identified by its hash
+func (bnh BlockNumberOrHash) Hash() (common.Hash, bool) {
+	if bnh.BlockHash != nil {
+		return *bnh.BlockHash, true
+	}
+	return common.Hash{}, false
+}
@@ -1,12 +1,16 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -14,11 +18,14 @@ import (
 
 // AccountResult struct for account proof
 type AccountResult struct {
-	Address      common.Address  `json:"address"`
-	AccountProof []string        `json:"accountProof"`
-	Balance      *hexutil.Big    `json:"balance"`
-	CodeHash     common.Hash     `json:"codeHash"`
-	Nonce        hexutil.Uint64  `json:"nonce"`
+	Address      common.Address `json:"address"`
+	AccountProof []ProofOp      `json:"accountProof"`
+	// AccountData is the amino encoded account proven by the account proof, which is empty if the account doesn't exist
+	AccountData hexutil.Bytes  `json:"accountData"`
+	Balance     *hexutil.Big   `json:"balance"`
+	CodeHash    common.Hash    `json:"codeHash"`
+	Nonce       hexutil.Uint64 `json:"nonce"`
+	// StorageHash is the root hash of the evm store, which is committed in the multistore proof of the account proof
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
 }
@@ -27,7 +34,72 @@ type AccountResult struct {
 type StorageResult struct {
 	Key   string       `json:"key"`
 	Value *hexutil.Big `json:"value"`
-	Proof []string     `json:"proof"`
+	Proof []ProofOp    `json:"proof"`
+}
+
+// ProofOp is the encodable form of a merkle proof operation. The ops of a proof are the iavl existence or absence
+// proof of the key in its store followed by the multistore proof of the store root, whose Data are amino encoded.
+type ProofOp struct {
+	Type string        `json:"type"`
+	Key  hexutil.Bytes `json:"key"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// NewProofOps returns the proof ops of the merkle proof, which are empty if the proof is nil
+func NewProofOps(proof *merkle.Proof) []ProofOp {
+	if proof == nil {
+		return []ProofOp{}
+	}
+
+	ops := make([]ProofOp, len(proof.Ops))
+	for i, op := range proof.Ops {
+		ops[i] = ProofOp{Type: op.Type, Key: op.Key, Data: op.Data}
+	}
+	return ops
+}
+
+// ToMerkleProof converts the proof ops to the merkle proof
+func ToMerkleProof(ops []ProofOp) *merkle.Proof {
+	proof := &merkle.Proof{Ops: make([]merkle.ProofOp, len(ops))}
+	for i, op := range ops {
+		proof.Ops[i] = merkle.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data}
+	}
+	return proof
+}
+
+// GetMultiStoreProof returns the multistore proof decoded from the multistore proof op of the proof ops
+func GetMultiStoreProof(ops []ProofOp) (*rootmulti.MultiStoreProof, error) {
+	for _, op := range ops {
+		if op.Type != rootmulti.ProofOpMultiStore {
+			continue
+		}
+
+		operator, err := rootmulti.DecodeMultiStoreProofOp(merkle.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data})
+		if err != nil {
+			return nil, err
+		}
+		msOp, ok := operator.(rootmulti.MultiStoreProofOp)
+		if !ok || msOp.Proof == nil {
+			return nil, errors.New("invalid multistore proof op")
+		}
+		return msOp.Proof, nil
+	}
+	return nil, errors.New("multistore proof op not found")
+}
+
+// GetStoreHash returns the root hash of the store committed in the multistore proof op of the proof ops
+func GetStoreHash(ops []ProofOp, storeName string) ([]byte, error) {
+	msProof, err := GetMultiStoreProof(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, si := range msProof.StoreInfos {
+		if si.Name == storeName {
+			return si.Core.CommitID.Hash, nil
+		}
+	}
+	return nil, fmt.Errorf("store %s not found in the multistore proof", storeName)
 }
 
 // Transaction represents a transaction returned to RPC clients.
@@ -46,6 +118,13 @@ type Transaction struct {
 	V                *hexutil.Big    `json:"v"`
 	R                *hexutil.Big    `json:"r"`
 	S                *hexutil.Big    `json:"s"`
+
+	// EIP-2718 typed transaction fields
+	Type      hexutil.Uint64       `json:"type"`
+	Accesses  *evmtypes.AccessList `json:"accessList,omitempty"`
+	ChainID   *hexutil.Big         `json:"chainId,omitempty"`
+	GasFeeCap *hexutil.Big         `json:"maxFeePerGas,omitempty"`
+	GasTipCap *hexutil.Big         `json:"maxPriorityFeePerGas,omitempty"`
 }
 
 // SendTxArgs represents the arguments to submit a new transaction into the transaction pool.
@@ -132,13 +211,7 @@ func (ca CallArgs) String() string {
 // set, message execution will only use the data in the given state. Otherwise
 // if statDiff is set, all diff will be applied first and then execute the call
 // message.
-type Account struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
+type Account = evmtypes.OverrideAccount
 
 // EthHeaderWithBlockHash represents a block header in the Ethereum blockchain with block hash generated from Tendermint Block
 type EthHeaderWithBlockHash struct {
@@ -159,3 +232,11 @@ type EthHeaderWithBlockHash struct {
 	Nonce       ethtypes.BlockNonce `json:"nonce"`
 	Hash        common.Hash         `json:"hash"`
 }
+
+// FeeHistoryResult is the result of eth_feeHistory
+type FeeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
@@ -63,6 +63,18 @@ func NewTransaction(tx *evmtypes.MsgEthereumTx, txHash, blockHash common.Hash, b
 		V:        (*hexutil.Big)(tx.Data.V),
 		R:        (*hexutil.Big)(tx.Data.R),
 		S:        (*hexutil.Big)(tx.Data.S),
+		Type:     hexutil.Uint64(tx.Data.Type),
+	}
+
+	if tx.Data.Type != evmtypes.LegacyTxType {
+		accesses := tx.Data.Accesses
+		rpcTx.Accesses = &accesses
+		rpcTx.ChainID = (*hexutil.Big)(tx.ChainID())
+	}
+
+	if tx.Data.Type == evmtypes.DynamicFeeTxType {
+		rpcTx.GasFeeCap = (*hexutil.Big)(tx.Data.GasFeeCap)
+		rpcTx.GasTipCap = (*hexutil.Big)(tx.Data.GasTipCap)
 	}
 
 	if blockHash != (common.Hash{}) {
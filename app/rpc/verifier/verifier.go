@@ -0,0 +1,141 @@
+// Package verifier verifies the results of eth_getProof against the AppHash of a block, so that the light clients and
+// the bridges are able to trust the state proven without trusting the rpc node.
+//
+// NOTE: the AppHash of a block commits the state after its previous block, so the result queried at the height h
+// is verified against the AppHash of the block at the height h+1.
+package verifier
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	ethermint "github.com/okex/exchain/app/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+var proofRuntime = rootmulti.DefaultProofRuntime()
+
+// VerifyAccountResult verifies the account proof, the storage hash and the storage proofs of the result against the
+// AppHash. The codec is used to decode the account proven, so the account types of the chain must be registered in it.
+func VerifyAccountResult(cdc *codec.Codec, res *rpctypes.AccountResult, appHash []byte) error {
+	if res == nil {
+		return errors.New("empty account result")
+	}
+
+	if err := verifyAccount(cdc, res, appHash); err != nil {
+		return err
+	}
+
+	if err := verifyStorageHash(res, appHash); err != nil {
+		return err
+	}
+
+	for _, storage := range res.StorageProof {
+		if err := verifyStorage(res.Address, storage, appHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyAccount(cdc *codec.Codec, res *rpctypes.AccountResult, appHash []byte) error {
+	proof := rpctypes.ToMerkleProof(res.AccountProof)
+	kp := keyPath(auth.StoreKey, auth.AddressStoreKey(res.Address.Bytes()))
+
+	if len(res.AccountData) == 0 {
+		if err := proofRuntime.VerifyAbsence(proof, appHash, kp); err != nil {
+			return fmt.Errorf("failed to verify the absence of account %s: %s", res.Address.Hex(), err)
+		}
+		// the inexistent account is returned as an empty one
+		if (res.Balance != nil && res.Balance.ToInt().Sign() != 0) || res.Nonce != 0 {
+			return fmt.Errorf("inexistent account %s with balance or nonce", res.Address.Hex())
+		}
+		return nil
+	}
+
+	if err := proofRuntime.VerifyValue(proof, appHash, kp, res.AccountData); err != nil {
+		return fmt.Errorf("failed to verify account %s: %s", res.Address.Hex(), err)
+	}
+
+	var acc exported.Account
+	if err := cdc.UnmarshalBinaryBare(res.AccountData, &acc); err != nil {
+		return fmt.Errorf("failed to decode account %s: %s", res.Address.Hex(), err)
+	}
+
+	if !bytes.Equal(acc.GetAddress(), res.Address.Bytes()) {
+		return fmt.Errorf("account address mismatch: %s vs %s", common.BytesToAddress(acc.GetAddress()).Hex(),
+			res.Address.Hex())
+	}
+	if acc.GetSequence() != uint64(res.Nonce) {
+		return fmt.Errorf("nonce mismatch of account %s: %d vs %d", res.Address.Hex(), acc.GetSequence(), res.Nonce)
+	}
+	balance := acc.GetCoins().AmountOf(sdk.DefaultBondDenom).BigInt()
+	if res.Balance == nil || balance.Cmp(res.Balance.ToInt()) != 0 {
+		return fmt.Errorf("balance mismatch of account %s: %s vs %s", res.Address.Hex(), balance, res.Balance)
+	}
+	if ethAcc, ok := acc.(*ethermint.EthAccount); ok && !bytes.Equal(ethAcc.CodeHash, res.CodeHash.Bytes()) {
+		return fmt.Errorf("code hash mismatch of account %s: %x vs %s", res.Address.Hex(), ethAcc.CodeHash,
+			res.CodeHash.Hex())
+	}
+	return nil
+}
+
+// verifyStorageHash verifies that the storage hash is the root hash of the evm store committed in the AppHash
+func verifyStorageHash(res *rpctypes.AccountResult, appHash []byte) error {
+	msProof, err := rpctypes.GetMultiStoreProof(res.AccountProof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(msProof.ComputeRootHash(), appHash) {
+		return errors.New("the multistore proof is not committed in the app hash")
+	}
+
+	storeHash, err := rpctypes.GetStoreHash(res.AccountProof, evmtypes.StoreKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(storeHash, res.StorageHash.Bytes()) {
+		return fmt.Errorf("storage hash mismatch: %x vs %s", storeHash, res.StorageHash.Hex())
+	}
+	return nil
+}
+
+func verifyStorage(address common.Address, storage rpctypes.StorageResult, appHash []byte) error {
+	proof := rpctypes.ToMerkleProof(storage.Proof)
+	// the storage key is prefixed with the address in the same way as the state objects do
+	key := append(evmtypes.AddressStoragePrefix(address),
+		ethcrypto.Keccak256Hash(address.Bytes(), common.HexToHash(storage.Key).Bytes()).Bytes()...)
+	kp := keyPath(evmtypes.StoreKey, key)
+
+	// the empty values are deleted from the store
+	if storage.Value == nil || storage.Value.ToInt().Sign() == 0 {
+		if err := proofRuntime.VerifyAbsence(proof, appHash, kp); err != nil {
+			return fmt.Errorf("failed to verify the absence of storage %s: %s", storage.Key, err)
+		}
+		return nil
+	}
+
+	if err := proofRuntime.VerifyValue(proof, appHash, kp, common.BigToHash(storage.Value.ToInt()).Bytes()); err != nil {
+		return fmt.Errorf("failed to verify storage %s: %s", storage.Key, err)
+	}
+	return nil
+}
+
+// keyPath builds the key path of the key in the store in the same way as the cosmos-sdk does when verifying the
+// proofs of the queries
+func keyPath(storeName string, key []byte) string {
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+	return kp.String()
+}
@@ -0,0 +1,142 @@
+package verifier
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	ethermint "github.com/okex/exchain/app/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func makeCodec() *codec.Codec {
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ethermint.RegisterCodec(cdc)
+	return cdc
+}
+
+func storageKey(address common.Address, key common.Hash) []byte {
+	return append(evmtypes.AddressStoragePrefix(address), ethcrypto.Keccak256Hash(address.Bytes(), key.Bytes()).Bytes()...)
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	cdc := makeCodec()
+	accKey := sdk.NewKVStoreKey(auth.StoreKey)
+	evmKey := sdk.NewKVStoreKey(evmtypes.StoreKey)
+	ms := rootmulti.NewStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(accKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(evmKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	address := common.BytesToAddress([]byte("contract"))
+	balance := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	acc := &ethermint.EthAccount{
+		BaseAccount: auth.NewBaseAccount(address.Bytes(), balance, nil, 1, 5),
+		CodeHash:    ethcrypto.Keccak256([]byte("code")),
+	}
+	ms.GetKVStore(accKey).Set(auth.AddressStoreKey(address.Bytes()), cdc.MustMarshalBinaryBare(acc))
+	slot, value := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(0xff))
+	ms.GetKVStore(evmKey).Set(storageKey(address, slot), value.Bytes())
+	commitID := ms.Commit()
+	// change the state at the next height
+	ms.GetKVStore(evmKey).Set(storageKey(address, slot), common.BigToHash(big.NewInt(1)).Bytes())
+	ms.Commit()
+
+	query := func(storeName string, key []byte) abci.ResponseQuery {
+		res := ms.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", storeName),
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.Equal(t, uint32(0), res.Code, res.Log)
+		return res
+	}
+
+	// build the result in the same way as eth_getProof does
+	getProof := func(address common.Address, slots ...common.Hash) *rpctypes.AccountResult {
+		res := query(auth.StoreKey, auth.AddressStoreKey(address.Bytes()))
+		accountProof := rpctypes.NewProofOps(res.GetProof())
+		storageHash, err := rpctypes.GetStoreHash(accountProof, evmtypes.StoreKey)
+		require.NoError(t, err)
+
+		result := &rpctypes.AccountResult{
+			Address:      address,
+			AccountProof: accountProof,
+			AccountData:  res.GetValue(),
+			Balance:      (*hexutil.Big)(big.NewInt(0)),
+			CodeHash:     common.BytesToHash(ethcrypto.Keccak256(nil)),
+			StorageHash:  common.BytesToHash(storageHash),
+		}
+		if len(res.GetValue()) != 0 {
+			result.Balance = (*hexutil.Big)(acc.Balance(sdk.DefaultBondDenom).BigInt())
+			result.CodeHash = common.BytesToHash(acc.CodeHash)
+			result.Nonce = hexutil.Uint64(acc.GetSequence())
+		}
+		for _, slot := range slots {
+			res := query(evmtypes.StoreKey, storageKey(address, slot))
+			result.StorageProof = append(result.StorageProof, rpctypes.StorageResult{
+				Key:   slot.Hex(),
+				Value: (*hexutil.Big)(common.BytesToHash(res.GetValue()).Big()),
+				Proof: rpctypes.NewProofOps(res.GetProof()),
+			})
+		}
+		return result
+	}
+
+	// existing account with both the existing and the inexistent storage
+	result := getProof(address, slot, common.BigToHash(big.NewInt(2)))
+	require.Equal(t, common.BytesToHash(value.Bytes()).Big(), result.StorageProof[0].Value.ToInt())
+	require.Zero(t, result.StorageProof[1].Value.ToInt().Sign())
+	require.NoError(t, VerifyAccountResult(cdc, result, commitID.Hash))
+
+	// inexistent account
+	require.NoError(t, VerifyAccountResult(cdc, getProof(common.BytesToAddress([]byte("inexistent")), slot),
+		commitID.Hash))
+
+	// the storage hash is unavailable without the multistore proof op, e.g. the proof of a store query
+	_, err := rpctypes.GetStoreHash(result.StorageProof[0].Proof[:1], evmtypes.StoreKey)
+	require.Error(t, err)
+	_, err = rpctypes.GetStoreHash(nil, evmtypes.StoreKey)
+	require.Error(t, err)
+
+	// the result fails to be verified against the app hash of the other heights
+	require.Error(t, VerifyAccountResult(cdc, result, ms.LastCommitID().Hash))
+
+	testCases := []struct {
+		name   string
+		tamper func(res *rpctypes.AccountResult)
+	}{
+		{"balance", func(res *rpctypes.AccountResult) { res.Balance = (*hexutil.Big)(big.NewInt(101)) }},
+		{"nonce", func(res *rpctypes.AccountResult) { res.Nonce++ }},
+		{"code hash", func(res *rpctypes.AccountResult) { res.CodeHash = common.Hash{} }},
+		{"storage hash", func(res *rpctypes.AccountResult) { res.StorageHash = common.Hash{} }},
+		{"account data", func(res *rpctypes.AccountResult) { res.AccountData = nil }},
+		{"storage value", func(res *rpctypes.AccountResult) {
+			res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(1))
+		}},
+		{"absent storage", func(res *rpctypes.AccountResult) {
+			res.StorageProof[1].Value = (*hexutil.Big)(big.NewInt(1))
+		}},
+		{"storage key", func(res *rpctypes.AccountResult) { res.StorageProof[0].Key = "0x2" }},
+		{"account proof", func(res *rpctypes.AccountResult) { res.AccountProof = res.AccountProof[1:] }},
+	}
+	for _, tc := range testCases {
+		res := getProof(address, slot, common.BigToHash(big.NewInt(2)))
+		tc.tamper(res)
+		require.Error(t, VerifyAccountResult(cdc, res, commitID.Hash), tc.name)
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/gorilla/websocket"
-
 	"github.com/tendermint/tendermint/libs/log"
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -40,7 +38,7 @@ func NewAPI(clientCtx context.CLIContext, log log.Logger) *PubSubAPI {
 	}
 }
 
-func (api *PubSubAPI) subscribe(conn *websocket.Conn, params []interface{}) (rpc.ID, error) {
+func (api *PubSubAPI) subscribe(conn *safeConn, params []interface{}) (rpc.ID, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return "0", fmt.Errorf("invalid parameters")
@@ -82,7 +80,7 @@ func (api *PubSubAPI) unsubscribe(id rpc.ID) bool {
 	return true
 }
 
-func (api *PubSubAPI) subscribeNewHeads(conn *websocket.Conn) (rpc.ID, error) {
+func (api *PubSubAPI) subscribeNewHeads(conn *safeConn) (rpc.ID, error) {
 	sub, _, err := api.events.SubscribeNewHeads()
 	if err != nil {
 		return "", fmt.Errorf("error creating block filter: %s", err.Error())
@@ -153,7 +151,7 @@ func (api *PubSubAPI) subscribeNewHeads(conn *websocket.Conn) (rpc.ID, error) {
 	return sub.ID(), nil
 }
 
-func (api *PubSubAPI) subscribeLogs(conn *websocket.Conn, extra interface{}) (rpc.ID, error) {
+func (api *PubSubAPI) subscribeLogs(conn *safeConn, extra interface{}) (rpc.ID, error) {
 	crit := filters.FilterCriteria{}
 
 	if extra != nil {
@@ -356,7 +354,7 @@ func isHex(str string) bool {
 	return true
 }
 
-func (api *PubSubAPI) subscribePendingTransactions(conn *websocket.Conn) (rpc.ID, error) {
+func (api *PubSubAPI) subscribePendingTransactions(conn *safeConn) (rpc.ID, error) {
 	sub, _, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return "", fmt.Errorf("error creating block filter: %s", err.Error())
@@ -423,7 +421,7 @@ func (api *PubSubAPI) subscribePendingTransactions(conn *websocket.Conn) (rpc.ID
 	return sub.ID(), nil
 }
 
-func (api *PubSubAPI) subscribeSyncing(conn *websocket.Conn) (rpc.ID, error) {
+func (api *PubSubAPI) subscribeSyncing(conn *safeConn) (rpc.ID, error) {
 	sub, _, err := api.events.SubscribeNewHeads()
 	if err != nil {
 		return "", fmt.Errorf("error creating block filter: %s", err.Error())
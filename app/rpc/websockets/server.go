@@ -1,13 +1,9 @@
 package websockets
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"math/big"
 	"net/http"
-	"strings"
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -17,6 +13,7 @@ import (
 	"github.com/go-kit/kit/metrics/prometheus"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
+	"github.com/okex/exchain/app/rpc/middleware"
 	"github.com/okex/exchain/x/common/monitor"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/viper"
@@ -25,10 +22,11 @@ import (
 
 // Server defines a server that handles Ethereum websockets.
 type Server struct {
-	rpcAddr string // listen address of rest-server
-	wsAddr  string // listen address of ws server
-	api     *PubSubAPI
-	logger  log.Logger
+	wsAddr     string      // listen address of ws server
+	rpcClient  *rpc.Client // in-process client of the rpc server
+	middleware *middleware.Middleware
+	api        *PubSubAPI
+	logger     log.Logger
 
 	connPool       chan struct{}
 	connPoolLock   *sync.Mutex
@@ -36,23 +34,14 @@ type Server struct {
 	maxConnNum     metrics.Gauge
 }
 
-// NewServer creates a new websocket server instance.
-func NewServer(clientCtx context.CLIContext, log log.Logger, wsAddr string) *Server {
-	restServerAddr := viper.GetString(server.FlagListenAddr)
-	parts := strings.SplitN(restServerAddr, "://", 2)
-	if len(parts) != 2 {
-		panic(fmt.Errorf("invalid listening address %s (use fully formed addresses, including the tcp:// or unix:// prefix)", restServerAddr))
-	}
-	url := parts[1]
-	urlParts := strings.SplitN(url, ":", 2)
-	if len(urlParts) != 2 {
-		panic(fmt.Errorf("invalid listening address %s (use ip:port as an url)", url))
-	}
-	port := urlParts[1]
-
+// NewServer creates a new websocket server instance. The requests other than the subscriptions are dispatched to the
+// services registered on the rpc server in-process, the same access policies as the http ones being applied.
+func NewServer(clientCtx context.CLIContext, log log.Logger, wsAddr string, rpcServer *rpc.Server,
+	mw *middleware.Middleware) *Server {
 	return &Server{
-		rpcAddr:      "http://localhost:" + port,
 		wsAddr:       wsAddr,
+		rpcClient:    rpc.DialInProc(rpcServer),
+		middleware:   mw,
 		api:          NewAPI(clientCtx, log),
 		logger:       log.With("module", "websocket-server"),
 		connPool:     make(chan struct{}, viper.GetInt(server.FlagWsMaxConnections)),
@@ -109,25 +98,17 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	s.connPool <- struct{}{}
 	s.currentConnNum.Set(float64(len(s.connPool)))
-	go s.readLoop(wsConn)
+	go s.readLoop(newSafeConn(wsConn), s.middleware.ClientID(r.Header.Get(middleware.APIKeyHeader), r.RemoteAddr))
 }
 
-func (s *Server) sendErrResponse(conn *websocket.Conn, msg string) {
-	res := &ErrorResponseJSON{
-		Jsonrpc: "2.0",
-		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
-			Message: msg,
-		},
-		ID: big.NewInt(1),
-	}
-	err := conn.WriteJSON(res)
+func (s *Server) sendErrResponse(conn *safeConn, msg string) {
+	err := conn.WriteJSON(newErrResponse(nil, errcodeInvalidRequest, msg))
 	if err != nil {
 		s.logger.Error("websocket failed write message", "error", err)
 	}
 }
 
-func (s *Server) readLoop(wsConn *websocket.Conn) {
+func (s *Server) readLoop(wsConn *safeConn, clientID string) {
 	subIds := make(map[rpc.ID]struct{})
 	for {
 		_, mb, err := wsConn.ReadMessage()
@@ -138,110 +119,140 @@ func (s *Server) readLoop(wsConn *websocket.Conn) {
 			return
 		}
 
-		var msg map[string]interface{}
-		err = json.Unmarshal(mb, &msg)
+		msgs, isBatch, err := parseMessage(mb)
 		if err != nil {
 			s.sendErrResponse(wsConn, "invalid request")
 			continue
 		}
 
-		// check if method == eth_subscribe or eth_unsubscribe
-		method := msg["method"]
-		if method.(string) == "eth_subscribe" {
-			params := msg["params"].([]interface{})
-			if len(params) == 0 {
-				s.sendErrResponse(wsConn, "invalid parameters")
+		if isBatch {
+			if err := s.middleware.CheckBatch(len(msgs)); err != nil {
+				s.writeResponse(wsConn, newErrResponseFromError(nil, err))
 				continue
 			}
+		}
 
-			id, err := s.api.subscribe(wsConn, params)
-			if err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-				continue
-			}
+		responses := s.handleMessages(wsConn, clientID, msgs, subIds)
+		if len(responses) == 0 {
+			// only notifications without any response
+			continue
+		}
+
+		if isBatch {
+			s.writeResponse(wsConn, responses)
+		} else {
+			s.writeResponse(wsConn, responses[0])
+		}
+	}
+}
+
+// writeResponse writes the response to the client if its size is within the limit
+func (s *Server) writeResponse(wsConn *safeConn, res interface{}) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		s.logger.Error("failed to marshal json response", "error", err)
+		return
+	}
+	if err := s.middleware.CheckResponseSize(len(bz)); err != nil {
+		bz, _ = json.Marshal(newErrResponseFromError(nil, err))
+	}
+
+	if err := wsConn.WriteMessage(websocket.TextMessage, bz); err != nil {
+		s.logger.Error("failed to write json response", "error", err)
+	}
+}
 
-			reqId, ok := msg["id"].(float64)
-			if !ok {
-				s.sendErrResponse(wsConn, "invaild id in request message")
+// handleMessages handles the subscriptions itself and dispatches the other requests to the rpc server in one batch.
+// The responses are returned in the order of the requests, while the notifications get no response.
+func (s *Server) handleMessages(wsConn *safeConn, clientID string, msgs []*jsonrpcMessage,
+	subIds map[rpc.ID]struct{}) []interface{} {
+	responses := make([]interface{}, len(msgs))
+	var (
+		elems     []rpc.BatchElem
+		positions []int
+	)
+	for i, msg := range msgs {
+		if msg != nil && len(msg.Method) != 0 {
+			// the subscriptions are subject to the access policies as well
+			if err := s.middleware.CheckRequest(clientID, msg.Method, msg.Params); err != nil {
+				responses[i] = newErrResponseFromError(msg.ID, err)
 				continue
 			}
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      reqId,
-				Result:  id,
-			}
+		}
 
-			err = wsConn.WriteJSON(res)
+		switch {
+		case msg == nil:
+			responses[i] = newErrResponse(nil, errcodeInvalidRequest, "invalid request")
+		case len(msg.Method) == 0:
+			responses[i] = newErrResponse(msg.ID, errcodeInvalidRequest, "invalid request")
+		case msg.Method == "eth_subscribe":
+			responses[i] = s.subscribe(wsConn, msg, subIds)
+		case msg.Method == "eth_unsubscribe":
+			responses[i] = s.unsubscribe(msg, subIds)
+		default:
+			args, err := msg.args()
 			if err != nil {
-				s.logger.Error("failed to write json response", "ID", id, "error", err)
-				continue
-			}
-			s.logger.Debug("successfully subscribe", "ID", id)
-			subIds[id] = struct{}{}
-			continue
-		} else if method.(string) == "eth_unsubscribe" {
-			ids, ok := msg["params"].([]interface{})
-			if len(ids) == 0 {
-				s.sendErrResponse(wsConn, "invalid parameters")
-				continue
-			}
-			id, idok := ids[0].(string)
-			if !ok || !idok {
-				s.sendErrResponse(wsConn, "invalid parameters")
+				responses[i] = newErrResponse(msg.ID, errcodeInvalidParams, err.Error())
 				continue
 			}
+			elems = append(elems, rpc.BatchElem{Method: msg.Method, Args: args, Result: new(json.RawMessage)})
+			positions = append(positions, i)
+		}
+	}
 
-			ok = s.api.unsubscribe(rpc.ID(id))
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      1,
-				Result:  ok,
+	if len(elems) != 0 {
+		// otherwise, call the registered rpc services in-process to respond
+		err := s.rpcClient.BatchCall(elems)
+		for j, elem := range elems {
+			msg := msgs[positions[j]]
+			switch {
+			case err != nil:
+				responses[positions[j]] = newErrResponse(msg.ID, errcodeInternal, err.Error())
+			case elem.Error != nil:
+				responses[positions[j]] = newErrResponseFromError(msg.ID, elem.Error)
+			default:
+				responses[positions[j]] = &ResponseJSON{Jsonrpc: vsn, ID: msg.ID, Result: elem.Result}
 			}
-
-			err = wsConn.WriteJSON(res)
-			if err != nil {
-				s.logger.Error("failed to write json response", "ID", id, "error", err)
-				continue
-			}
-			s.logger.Debug("successfully unsubscribe", "ID", id)
-			delete(subIds, rpc.ID(id))
-			continue
 		}
+	}
 
-		// otherwise, call the usual rpc server to respond
-		err = s.tcpGetAndSendResponse(wsConn, mb)
-		if err != nil {
-			s.sendErrResponse(wsConn, err.Error())
+	var res []interface{}
+	for i, msg := range msgs {
+		if msg != nil && msg.isNotification() {
+			continue
 		}
+		res = append(res, responses[i])
 	}
+	return res
 }
 
-// tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
-// to the client over websockets
-func (s *Server) tcpGetAndSendResponse(conn *websocket.Conn, mb []byte) error {
-	req, err := http.NewRequest(http.MethodPost, s.rpcAddr, bytes.NewReader(mb))
-	if err != nil {
-		return fmt.Errorf("failed to request; %s", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to write to rest-server; %s", err)
+func (s *Server) subscribe(wsConn *safeConn, msg *jsonrpcMessage, subIds map[rpc.ID]struct{}) interface{} {
+	var params []interface{}
+	if err := json.Unmarshal(msg.Params, &params); err != nil || len(params) == 0 {
+		return newErrResponse(msg.ID, errcodeInvalidParams, "invalid parameters")
 	}
 
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+	id, err := s.api.subscribe(wsConn, params)
 	if err != nil {
-		return fmt.Errorf("could not read body from response; %s", err)
+		return newErrResponse(msg.ID, errcodeInvalidRequest, err.Error())
 	}
 
-	var wsSend interface{}
-	err = json.Unmarshal(body, &wsSend)
-	if err != nil {
-		return fmt.Errorf("failed to unmarshal rest-server response; %s", err)
+	s.logger.Debug("successfully subscribe", "ID", id)
+	subIds[id] = struct{}{}
+	return &ResponseJSON{Jsonrpc: vsn, ID: msg.ID, Result: id}
+}
+
+func (s *Server) unsubscribe(msg *jsonrpcMessage, subIds map[rpc.ID]struct{}) interface{} {
+	var ids []string
+	if err := json.Unmarshal(msg.Params, &ids); err != nil || len(ids) == 0 {
+		return newErrResponse(msg.ID, errcodeInvalidParams, "invalid parameters")
 	}
 
-	return conn.WriteJSON(wsSend)
+	id := rpc.ID(ids[0])
+	ok := s.api.unsubscribe(id)
+	s.logger.Debug("successfully unsubscribe", "ID", id)
+	delete(subIds, id)
+	return &ResponseJSON{Jsonrpc: vsn, ID: msg.ID, Result: ok}
 }
 
 func (s *Server) closeWsConnection(subIds map[rpc.ID]struct{}) {
@@ -1,7 +1,11 @@
 package websockets
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"math/big"
+	"sync"
 
 	"github.com/gorilla/websocket"
 
@@ -10,10 +14,19 @@ import (
 	rpcfilters "github.com/okex/exchain/app/rpc/namespaces/eth/filters"
 )
 
-type SubscriptionResponseJSON struct {
-	Jsonrpc string      `json:"jsonrpc"`
-	Result  interface{} `json:"result"`
-	ID      float64     `json:"id"`
+const (
+	vsn = "2.0"
+
+	errcodeInvalidRequest = -32600
+	errcodeInvalidParams  = -32602
+	errcodeInternal       = -32603
+)
+
+// ResponseJSON is the JSON-RPC response echoing the id of the request
+type ResponseJSON struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result"`
+	ID      json.RawMessage `json:"id"`
 }
 
 type SubscriptionNotification struct {
@@ -30,16 +43,128 @@ type SubscriptionResult struct {
 type ErrorResponseJSON struct {
 	Jsonrpc string            `json:"jsonrpc"`
 	Error   *ErrorMessageJSON `json:"error"`
-	ID      *big.Int          `json:"id"`
+	ID      json.RawMessage   `json:"id"`
 }
 
 type ErrorMessageJSON struct {
-	Code    *big.Int `json:"code"`
-	Message string   `json:"message"`
+	Code    *big.Int    `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func newErrResponse(id json.RawMessage, code int64, msg string) *ErrorResponseJSON {
+	return &ErrorResponseJSON{
+		Jsonrpc: vsn,
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(code),
+			Message: msg,
+		},
+		ID: id,
+	}
+}
+
+// newErrResponseFromError builds the error response with the code and data of the error returned by the rpc services
+func newErrResponseFromError(id json.RawMessage, err error) *ErrorResponseJSON {
+	res := newErrResponse(id, errcodeInternal, err.Error())
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		res.Error.Code = big.NewInt(int64(rpcErr.ErrorCode()))
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		res.Error.Data = dataErr.ErrorData()
+	}
+	return res
+}
+
+// jsonrpcMessage is a JSON-RPC request sent by the websocket clients
+type jsonrpcMessage struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isNotification checks whether the request is a notification, which gets no response
+func (msg *jsonrpcMessage) isNotification() bool {
+	return len(msg.ID) == 0 && len(msg.Method) != 0
+}
+
+// args returns the positional parameters of the request
+func (msg *jsonrpcMessage) args() ([]interface{}, error) {
+	if len(msg.Params) == 0 || bytes.Equal(msg.Params, []byte("null")) {
+		return nil, nil
+	}
+
+	var params []json.RawMessage
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return nil, errors.New("non-array args")
+	}
+	args := make([]interface{}, len(params))
+	for i, param := range params {
+		args[i] = param
+	}
+	return args, nil
+}
+
+// parseMessage parses the websocket frame into a single JSON-RPC request or a batch of them. The invalid requests of a
+// batch are left as nil
+func parseMessage(raw []byte) ([]*jsonrpcMessage, bool, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || raw[0] != '[' {
+		var msg jsonrpcMessage
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			return nil, false, err
+		}
+		return []*jsonrpcMessage{&msg}, false, nil
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(raw, &rawMsgs); err != nil {
+		return nil, true, err
+	}
+	if len(rawMsgs) == 0 {
+		return nil, true, errors.New("empty batch")
+	}
+
+	msgs := make([]*jsonrpcMessage, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		var msg jsonrpcMessage
+		if err := json.Unmarshal(rawMsg, &msg); err == nil {
+			msgs[i] = &msg
+		}
+	}
+	return msgs, true, nil
 }
 
 type wsSubscription struct {
 	sub          *rpcfilters.Subscription
 	unsubscribed chan struct{} // closed when unsubscribing
-	conn         *websocket.Conn
+	conn         *safeConn
+}
+
+// safeConn is the websocket connection of a client. The responses of the read loop and the notifications of the
+// subscriptions are written by different goroutines, so the writes are serialized as the connection supports one
+// concurrent writer at most.
+type safeConn struct {
+	*websocket.Conn
+	writeMu sync.Mutex
+}
+
+func newSafeConn(conn *websocket.Conn) *safeConn {
+	return &safeConn{Conn: conn}
+}
+
+// WriteJSON writes the JSON encoding of v as a message under the write lock
+func (c *safeConn) WriteJSON(v interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.Conn.WriteJSON(v)
+}
+
+// WriteMessage writes a message with the given type and payload under the write lock
+func (c *safeConn) WriteMessage(messageType int, data []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.Conn.WriteMessage(messageType, data)
 }
@@ -2,7 +2,10 @@ package client
 
 import (
 	"github.com/okex/exchain/app/rpc"
+	"github.com/okex/exchain/app/rpc/backend"
+	"github.com/okex/exchain/app/rpc/middleware"
 	"github.com/okex/exchain/app/rpc/namespaces/eth/filters"
+	"github.com/okex/exchain/x/evm"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/evm/watcher"
 	"github.com/okex/exchain/x/stream"
@@ -12,9 +15,13 @@ import (
 
 func RegisterAppFlag(cmd *cobra.Command) {
 	cmd.Flags().Bool(watcher.FlagFastQuery, false, "Enable the fast query mode for rpc queries")
+	cmd.Flags().Uint64(watcher.FlagRetainBlocks, 0, "Set the number of recent blocks kept in the watch db of the fast query mode, 0 keeps all the blocks")
 	cmd.Flags().Bool(rpc.FlagPersonalAPI, true, "Enable the personal_ prefixed set of APIs in the Web3 JSON-RPC spec")
+	cmd.Flags().Bool(rpc.FlagDebugAPI, false, "Enable the debug_ prefixed set of APIs to trace transactions and calls")
 	cmd.Flags().Bool(evmtypes.FlagEnableBloomFilter, false, "Enable bloom filter for event logs")
 	cmd.Flags().Int64(filters.FlagGetLogsHeightSpan, -1, "config the block height span for get logs")
+	cmd.Flags().Int64(evm.FlagSnapshotHeight, 0, "Set the height of the evm snapshot expected to be imported in the snapshot import mode")
+	cmd.Flags().String(evm.FlagSnapshotAppHash, "", "Set the hex encoded app hash of the evm snapshot expected to be imported in the snapshot import mode")
 	cmd.Flags().String(stream.NacosTmrpcUrls, "", "Stream plugin`s nacos server urls for discovery service of tendermint rpc")
 	cmd.Flags().String(stream.NacosTmrpcNamespaceID, "", "Stream plugin`s nacos namepace id for discovery service of tendermint rpc")
 	cmd.Flags().String(stream.NacosTmrpcAppName, "", "Stream plugin`s tendermint rpc name in eureka or nacos")
@@ -23,6 +30,15 @@ func RegisterAppFlag(cmd *cobra.Command) {
 	cmd.Flags().String(rpc.FlagRateLimitApi, "", "Set the RPC API to be controlled by the rate limit policy, such as \"eth_getLogs,eth_newFilter,eth_newBlockFilter,eth_newPendingTransactionFilter,eth_getFilterChanges\"")
 	cmd.Flags().Int(rpc.FlagRateLimitCount, 0, "Set the count of requests allowed per second of rpc rate limiter")
 	cmd.Flags().Int(rpc.FlagRateLimitBurst, 1, "Set the concurrent count of requests allowed of rpc rate limiter")
+	cmd.Flags().Int(backend.FlagGasPriceBlocks, backend.DefaultGasPriceBlocks, "Set the number of recent blocks sampled by the gas price oracle")
+	cmd.Flags().Int(backend.FlagGasPricePercentile, backend.DefaultGasPricePercentile, "Set the percentile of the sampled gas prices suggested by the gas price oracle")
+	cmd.Flags().String(middleware.FlagAllowList, "", "Set the RPC namespaces and methods allowed to be called, such as \"eth,net,web3_clientVersion\", all of them are allowed if it's empty")
+	cmd.Flags().String(middleware.FlagDenyList, "", "Set the RPC namespaces and methods disabled, which takes precedence over the allow list")
+	cmd.Flags().Float64(middleware.FlagClientRateLimit, 0, "Set the count of RPC requests allowed per second for each client, 0 disables the limit")
+	cmd.Flags().Int(middleware.FlagClientRateBurst, 10, "Set the burst of RPC requests allowed for each client")
+	cmd.Flags().String(middleware.FlagAPIKeys, "", "Set the api keys identifying the RPC clients by the \"X-API-Key\" header instead of their ip addresses")
+	cmd.Flags().Int(middleware.FlagMaxBatchSize, 0, "Set the max count of requests in a RPC batch, 0 disables the limit")
+	cmd.Flags().Int(middleware.FlagMaxResponseSize, 0, "Set the max size in bytes of a RPC response, 0 disables the limit")
 
 	cmd.Flags().Bool(token.FlagOSSEnable, false, "Enable the function of exporting account data and uploading to oss")
 	cmd.Flags().String(token.FlagOSSEndpoint, "", "The OSS datacenter endpoint such as http://oss-cn-hangzhou.aliyuncs.com")
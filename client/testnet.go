@@ -259,6 +259,7 @@ func InitTestnet(
 			sdk.ValAddress(addr),
 			valPubKeys[i],
 			stakingtypes.NewDescription(nodeDirName, "", "", ""),
+			stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
 			sdk.NewDecCoinFromDec(common.NativeToken, stakingtypes.DefaultMinSelfDelegation),
 		)
 
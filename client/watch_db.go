@@ -0,0 +1,229 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/exchain/app"
+	"github.com/okex/exchain/x/evm"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/evm/watcher"
+)
+
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+)
+
+// WatchDBCommand returns the maintenance commands of the watch db used by the fast query mode. The node must be
+// stopped before running them.
+func WatchDBCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch-db",
+		Short: "Maintain the watch db of the fast query mode",
+	}
+
+	cmd.AddCommand(
+		watchDBCompactCommand(),
+		watchDBPruneCommand(),
+		watchDBTruncateCommand(),
+		watchDBRebuildCommand(),
+	)
+	return cmd
+}
+
+func watchDBCompactCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "compact",
+		Short: "Compact the watch db to reclaim the space of the pruned data",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withWatchStore(func(store *watcher.WatchStore) error {
+				return store.Compact()
+			})
+		},
+	}
+}
+
+func watchDBPruneCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "prune [retain-blocks]",
+		Short: "Prune the watch db, keeping only the given number of recent blocks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			retain, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid retain blocks %s: %w", args[0], err)
+			}
+
+			return withWatchStore(func(store *watcher.WatchStore) error {
+				latest, err := store.GetLatestHeight()
+				if err != nil {
+					return err
+				}
+				if latest <= retain {
+					return nil
+				}
+
+				if err := store.PruneTo(latest - retain); err != nil {
+					return err
+				}
+				fmt.Printf("pruned the watch db up to height %d\n", latest-retain)
+				return store.Compact()
+			})
+		},
+	}
+}
+
+func watchDBTruncateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "truncate [start-height] [end-height]",
+		Short: "Rebuild the watch db with only the blocks within the given height range",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			start, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height %s: %w", args[0], err)
+			}
+			end, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height %s: %w", args[1], err)
+			}
+			if start > end {
+				return fmt.Errorf("start height %d is greater than end height %d", start, end)
+			}
+
+			return withWatchStore(func(store *watcher.WatchStore) error {
+				if err := store.Truncate(start, end); err != nil {
+					return err
+				}
+				fmt.Printf("truncated the watch db to heights [%d, %d]\n", start, end)
+				return store.Compact()
+			})
+		},
+	}
+}
+
+func watchDBRebuildCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Backfill the watch db by replaying the historical blocks and tx results of the node",
+		Long: `Backfill the watch db with the blocks, transactions, receipts, logs and contract codes replayed from the
+tendermint block store and the saved ABCI results. The rebuild resumes from the last replayed height unless
+--start-height is given.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home := viper.GetString(flags.FlagHome)
+			cfg := tmcfg.DefaultConfig()
+			cfg.SetRoot(home)
+			backend := dbm.BackendType(cfg.DBBackend)
+
+			blockDB, err := dbm.NewDB("blockstore", backend, cfg.DBDir())
+			if err != nil {
+				return err
+			}
+			defer blockDB.Close()
+			stateDB, err := dbm.NewDB("state", backend, cfg.DBDir())
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+			appDB, err := dbm.NewDB("application", backend, cfg.DBDir())
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			// the watch db is opened by the rebuild only, not by the keeper of the app
+			viper.Set(watcher.FlagFastQuery, false)
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+			exApp := app.NewOKExChainApp(logger, appDB, nil, true, map[int64]bool{}, 0)
+			latestCtx := exApp.NewContext(true, abci.Header{Height: exApp.LastBlockHeight()})
+			txDecoder := evm.TxDecoder(exApp.Codec())
+
+			blockStore := tmstore.NewBlockStore(blockDB)
+			return withWatchStore(func(watchStore *watcher.WatchStore) error {
+				start, _ := cmd.Flags().GetInt64(flagStartHeight)
+				if start <= 0 {
+					checkpoint, err := watchStore.GetRebuildHeight()
+					if err != nil {
+						return err
+					}
+					start = int64(checkpoint) + 1
+				}
+				end, _ := cmd.Flags().GetInt64(flagEndHeight)
+				if end <= 0 || end > blockStore.Height() {
+					end = blockStore.Height()
+				}
+
+				for height := start; height <= end; height++ {
+					block := blockStore.LoadBlock(height)
+					if block == nil {
+						return fmt.Errorf("block %d not found in the block store", height)
+					}
+					results, err := sm.LoadABCIResponses(stateDB, height)
+					if err != nil {
+						return err
+					}
+					getCode := func(addr ethcmn.Address) []byte {
+						return codeAtHeight(exApp, latestCtx, addr, height, logger)
+					}
+					if err := watcher.RebuildBlock(watchStore, block, results.DeliverTxs, txDecoder, getCode); err != nil {
+						return fmt.Errorf("failed to rebuild block %d: %w", height, err)
+					}
+					if height%1000 == 0 {
+						fmt.Printf("rebuilt the watch db up to height %d\n", height)
+					}
+				}
+				fmt.Printf("rebuilt the watch db from height %d to %d\n", start, end)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().Int64(flagStartHeight, 0, "The height to start the rebuild from, the last replayed height plus one if not set")
+	cmd.Flags().Int64(flagEndHeight, 0, "The height to stop the rebuild at, the latest height of the block store if not set")
+	return cmd
+}
+
+// codeAtHeight returns the code of the contract in the state committed by the block at the given height, or in the
+// latest state if the state of the block was pruned
+func codeAtHeight(exApp *app.OKExChainApp, latestCtx sdk.Context, addr ethcmn.Address, height int64, logger log.Logger) []byte {
+	res := exApp.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryCode, addr.Hex()),
+		Height: height,
+	})
+	if res.IsOK() {
+		var out evmtypes.QueryResCode
+		if err := exApp.Codec().UnmarshalJSON(res.Value, &out); err == nil {
+			return out.Code
+		}
+	}
+
+	logger.Error("the state of the block is not available, the code is read from the latest state",
+		"height", height, "address", addr.Hex(), "log", res.Log)
+	return exApp.EvmKeeper.GetCode(latestCtx, addr)
+}
+
+func withWatchStore(fn func(store *watcher.WatchStore) error) error {
+	store, err := watcher.OpenWatchStore(viper.GetString(flags.FlagHome))
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	return fn(store)
+}
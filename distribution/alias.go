@@ -13,15 +13,20 @@ import (
 )
 
 const (
-	ModuleName                  = types.ModuleName
-	StoreKey                    = types.StoreKey
-	RouterKey                   = types.RouterKey
-	QuerierRoute                = types.QuerierRoute
-	QueryParams                 = types.QueryParams
-	QueryValidatorCommission    = types.QueryValidatorCommission
-	QueryWithdrawAddr           = types.QueryWithdrawAddr
-	ParamWithdrawAddrEnabled    = types.ParamWithdrawAddrEnabled
-	DefaultParamspace           = types.DefaultParamspace
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	RouterKey                = types.RouterKey
+	QuerierRoute             = types.QuerierRoute
+	QueryParams              = types.QueryParams
+	QueryValidatorCommission = types.QueryValidatorCommission
+	QueryWithdrawAddr        = types.QueryWithdrawAddr
+	QueryDelegationRewards   = types.QueryDelegationRewards
+	QueryDelegatorRewards    = types.QueryDelegatorRewards
+	QueryDelegatorValidators = types.QueryDelegatorValidators
+	QueryProxyRewards        = types.QueryProxyRewards
+	QueryProxyDelegator      = types.QueryProxyDelegator
+	ParamWithdrawAddrEnabled = types.ParamWithdrawAddrEnabled
+	DefaultParamspace        = types.DefaultParamspace
 )
 
 var (
@@ -43,6 +48,12 @@ var (
 	ValidateGenesis                          = types.ValidateGenesis
 	NewMsgSetWithdrawAddress                 = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawValidatorCommission        = types.NewMsgWithdrawValidatorCommission
+	NewMsgWithdrawDelegatorReward            = types.NewMsgWithdrawDelegatorReward
+	NewMsgWithdrawDelegatorAllRewards        = types.NewMsgWithdrawDelegatorAllRewards
+	NewQueryDelegationRewardsParams          = types.NewQueryDelegationRewardsParams
+	NewQueryDelegatorParams                  = types.NewQueryDelegatorParams
+	NewQueryProxyParams                      = types.NewQueryProxyParams
+	NewDelegatorStartingInfo                 = types.NewDelegatorStartingInfo
 	NewQueryValidatorCommissionParams        = types.NewQueryValidatorCommissionParams
 	NewQueryDelegatorWithdrawAddrParams      = types.NewQueryDelegatorWithdrawAddrParams
 	InitialValidatorAccumulatedCommission    = types.InitialValidatorAccumulatedCommission
@@ -52,11 +63,16 @@ var (
 	ProposerKey                          = types.ProposerKey
 	DelegatorWithdrawAddrPrefix          = types.DelegatorWithdrawAddrPrefix
 	ValidatorAccumulatedCommissionPrefix = types.ValidatorAccumulatedCommissionPrefix
+	ValidatorOutstandingRewardsPrefix    = types.ValidatorOutstandingRewardsPrefix
+	DelegatorStartingInfoPrefix          = types.DelegatorStartingInfoPrefix
+	ValidatorCumulativeRewardRatioPrefix = types.ValidatorCumulativeRewardRatioPrefix
 	ModuleCdc                            = types.ModuleCdc
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
 	EventTypeCommission                  = types.EventTypeCommission
 	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
 	EventTypeProposerReward              = types.EventTypeProposerReward
+	EventTypeRewards                     = types.EventTypeRewards
+	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
 	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
 	AttributeKeyValidator                = types.AttributeKeyValidator
 	AttributeValueCategory               = types.AttributeValueCategory
@@ -71,6 +87,11 @@ type (
 	GenesisState                         = types.GenesisState
 	MsgSetWithdrawAddress                = types.MsgSetWithdrawAddress
 	MsgWithdrawValidatorCommission       = types.MsgWithdrawValidatorCommission
+	MsgWithdrawDelegatorReward           = types.MsgWithdrawDelegatorReward
+	MsgWithdrawDelegatorAllRewards       = types.MsgWithdrawDelegatorAllRewards
+	DelegatorStartingInfo                = types.DelegatorStartingInfo
+	DelegationRewards                    = types.DelegationRewards
+	ProxyDelegatorRewards                = types.ProxyDelegatorRewards
 	QueryValidatorCommissionParams       = types.QueryValidatorCommissionParams
 	QueryDelegatorWithdrawAddrParams     = types.QueryDelegatorWithdrawAddrParams
 	ValidatorAccumulatedCommission       = types.ValidatorAccumulatedCommission
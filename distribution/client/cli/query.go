@@ -32,6 +32,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryDelegatorRewards(queryRoute, cdc),
+		GetCmdQueryProxyRewards(queryRoute, cdc),
+		GetCmdQueryProxyDelegatorRewards(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -120,3 +123,136 @@ $ %s query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryDelegatorRewards implements the query delegator rewards command.
+func GetCmdQueryDelegatorRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rewards [delegator-addr] [<validator-addr>]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query all distribution delegator rewards or rewards from a particular validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all rewards earned by a delegator, optionally restrict to rewards from a single validator.
+
+Example:
+$ %s query distr rewards ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+$ %s query distr rewards ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02 exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// query for rewards from a particular validator
+			if len(args) == 2 {
+				valAddr, err := sdk.ValAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+
+				res, _, err := common.QueryDelegationRewards(cliCtx, queryRoute, delAddr, valAddr)
+				if err != nil {
+					return err
+				}
+
+				var result sdk.SysCoins
+				if err := cdc.UnmarshalJSON(res, &result); err != nil {
+					return fmt.Errorf("failed to unmarshal response: %w", err)
+				}
+				return cliCtx.PrintOutput(result)
+			}
+
+			res, _, err := common.QueryDelegatorTotalRewards(cliCtx, queryRoute, delAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDelegatorTotalRewardsResponse
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryProxyRewards implements the query proxy rewards command.
+func GetCmdQueryProxyRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proxy-rewards [proxy-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards that the delegators bound to a proxy are owed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accrued and pending rewards of every delegator bound to a proxy, and the pending rewards
+that the proxy takes with its commission.
+
+Example:
+$ %s query distr proxy-rewards ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proxyAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := common.QueryProxyRewards(cliCtx, queryRoute, proxyAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryProxyRewardsResponse
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryProxyDelegatorRewards implements the query proxy delegator rewards command.
+func GetCmdQueryProxyDelegatorRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proxy-delegator-rewards [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards that a delegator is owed by its proxies",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards that a delegator accrued from its proxies, and its pending part of the rewards of
+the proxy bound.
+
+Example:
+$ %s query distr proxy-delegator-rewards ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := common.QueryProxyDelegatorRewards(cliCtx, queryRoute, delAddr)
+			if err != nil {
+				return err
+			}
+
+			var result types.ProxyDelegatorRewards
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
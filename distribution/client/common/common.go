@@ -50,3 +50,47 @@ func WithdrawValidatorRewardsAndCommission(validatorAddr sdk.ValAddress) ([]sdk.
 
 	return []sdk.Msg{commissionMsg}, nil
 }
+
+// QueryDelegationRewards queries the rewards of a delegator on a validator
+func QueryDelegationRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress,
+	validatorAddr sdk.ValAddress) ([]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegationRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegationRewardsParams(delegatorAddr, validatorAddr)),
+	)
+}
+
+// QueryDelegatorTotalRewards queries the rewards of a delegator on all the validators
+func QueryDelegatorTotalRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) (
+	[]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+}
+
+// QueryDelegatorValidators queries the validators that a delegator is earning rewards from
+func QueryDelegatorValidators(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) (
+	[]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorValidators),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+}
+
+// QueryProxyRewards queries the rewards that the delegators bound to a proxy are owed
+func QueryProxyRewards(cliCtx context.CLIContext, queryRoute string, proxyAddr sdk.AccAddress) ([]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProxyRewards),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryProxyParams(proxyAddr)),
+	)
+}
+
+// QueryProxyDelegatorRewards queries the rewards that a delegator is owed by its proxies
+func QueryProxyDelegatorRewards(cliCtx context.CLIContext, queryRoute string, delegatorAddr sdk.AccAddress) (
+	[]byte, int64, error) {
+	return cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProxyDelegator),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr)),
+	)
+}
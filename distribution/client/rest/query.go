@@ -16,6 +16,36 @@ import (
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
+	// Get the total rewards balance from all delegations
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards",
+		delegatorRewardsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Query a delegation reward
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards/{validatorAddr}",
+		delegationRewardsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the validators that a delegator is earning rewards from
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/validators",
+		delegatorValidatorsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the rewards that a delegator is owed by its proxies
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/proxy_rewards",
+		proxyDelegatorRewardsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the rewards that the delegators bound to a proxy are owed
+	r.HandleFunc(
+		"/distribution/proxies/{delegatorAddr}/rewards",
+		proxyRewardsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Get the rewards withdrawal address
 	r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/withdraw_address",
@@ -136,3 +166,133 @@ func accumulatedCommissionHandlerFn(cliCtx context.CLIContext, queryRoute string
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the total rewards balance from all delegations
+func delegatorRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryDelegatorTotalRewards(cliCtx, queryRoute, delegatorAddr)
+		if err != nil {
+			sdkErr := comm.ParseSDKError(err.Error())
+			comm.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a delegation rewards
+func delegationRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryDelegationRewards(cliCtx, queryRoute, delegatorAddr, validatorAddr)
+		if err != nil {
+			sdkErr := comm.ParseSDKError(err.Error())
+			comm.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the validators that a delegator is earning rewards from
+func delegatorValidatorsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryDelegatorValidators(cliCtx, queryRoute, delegatorAddr)
+		if err != nil {
+			sdkErr := comm.ParseSDKError(err.Error())
+			comm.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the rewards that a delegator is owed by its proxies
+func proxyDelegatorRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryProxyDelegatorRewards(cliCtx, queryRoute, delegatorAddr)
+		if err != nil {
+			sdkErr := comm.ParseSDKError(err.Error())
+			comm.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the rewards that the delegators bound to a proxy are owed
+func proxyRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proxyAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := common.QueryProxyRewards(cliCtx, queryRoute, proxyAddr)
+		if err != nil {
+			sdkErr := comm.ParseSDKError(err.Error())
+			comm.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
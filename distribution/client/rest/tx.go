@@ -18,6 +18,18 @@ import (
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, _ string) {
+	// Withdraw all delegator rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards",
+		withdrawDelegatorRewardsHandlerFn(cliCtx),
+	).Methods("POST")
+
+	// Withdraw delegation rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards/{validatorAddr}",
+		withdrawDelegationRewardsHandlerFn(cliCtx),
+	).Methods("POST")
+
 	// Replace the rewards withdrawal address
 	r.HandleFunc(
 		"/distribution/delegators/{delegatorAddr}/withdraw_address",
@@ -73,6 +85,71 @@ func setDelegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext) http.Handler
 	}
 }
 
+// Withdraw all delegator rewards
+func withdrawDelegatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawRewardsReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgWithdrawDelegatorAllRewards(delAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// Withdraw delegation rewards
+func withdrawDelegationRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req withdrawRewardsReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgWithdrawDelegatorReward(delAddr, valAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Withdraw validator rewards and commission
 func withdrawValidatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -24,6 +24,20 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		keeper.SetValidatorAccumulatedCommission(ctx, acc.ValidatorAddress, acc.Accumulated)
 		moduleHoldings = moduleHoldings.Add(acc.Accumulated...)
 	}
+	for _, rew := range data.OutstandingRewards {
+		keeper.SetValidatorOutstandingRewards(ctx, rew.ValidatorAddress, rew.OutstandingRewards)
+		moduleHoldings = moduleHoldings.Add(rew.OutstandingRewards...)
+	}
+	for _, ratio := range data.ValidatorCumulativeRewardRatios {
+		keeper.SetValidatorCumulativeRewardRatio(ctx, ratio.ValidatorAddress, ratio.RewardRatio)
+	}
+	for _, info := range data.DelegatorStartingInfos {
+		keeper.SetDelegatorStartingInfo(ctx, info.DelegatorAddress, info.ValidatorAddress, info.StartingInfo)
+	}
+	for _, rew := range data.ProxyDelegatorRewards {
+		keeper.SetProxyDelegatorRewards(ctx, rew.DelegatorAddress, rew.Rewards)
+		moduleHoldings = moduleHoldings.Add(rew.Rewards...)
+	}
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 
 	// check if the module account exists
@@ -64,5 +78,53 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, acc, communityAddress)
+	outstanding := make([]types.ValidatorOutstandingRewardsRecord, 0)
+	keeper.IterateValidatorOutstandingRewards(ctx,
+		func(addr sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+			outstanding = append(outstanding, types.ValidatorOutstandingRewardsRecord{
+				ValidatorAddress:   addr,
+				OutstandingRewards: rewards,
+			})
+			return false
+		},
+	)
+	ratios := make([]types.ValidatorCumulativeRewardRatioRecord, 0)
+	keeper.IterateValidatorCumulativeRewardRatios(ctx,
+		func(addr sdk.ValAddress, ratio types.ValidatorCumulativeRewardRatio) (stop bool) {
+			ratios = append(ratios, types.ValidatorCumulativeRewardRatioRecord{
+				ValidatorAddress: addr,
+				RewardRatio:      ratio,
+			})
+			return false
+		},
+	)
+	infos := make([]types.DelegatorStartingInfoRecord, 0)
+	keeper.IterateDelegatorStartingInfos(ctx,
+		func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, info types.DelegatorStartingInfo) (stop bool) {
+			infos = append(infos, types.DelegatorStartingInfoRecord{
+				DelegatorAddress: delAddr,
+				ValidatorAddress: valAddr,
+				StartingInfo:     info,
+			})
+			return false
+		},
+	)
+
+	proxyRewards := make([]types.ProxyDelegatorRewardsRecord, 0)
+	keeper.IterateProxyDelegatorRewards(ctx,
+		func(delAddr sdk.AccAddress, rewards sdk.SysCoins) (stop bool) {
+			proxyRewards = append(proxyRewards, types.ProxyDelegatorRewardsRecord{
+				DelegatorAddress: delAddr,
+				Rewards:          rewards,
+			})
+			return false
+		},
+	)
+
+	genesisState := types.NewGenesisState(params, feePool, dwi, pp, acc, communityAddress)
+	genesisState.OutstandingRewards = outstanding
+	genesisState.ValidatorCumulativeRewardRatios = ratios
+	genesisState.DelegatorStartingInfos = infos
+	genesisState.ProxyDelegatorRewards = proxyRewards
+	return genesisState
 }
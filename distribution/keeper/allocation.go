@@ -9,6 +9,7 @@ import (
 	"github.com/okex/exchain/x/distribution/types"
 	"github.com/okex/exchain/x/staking/exported"
 	stakingexported "github.com/okex/exchain/x/staking/exported"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
 )
 
 var (
@@ -18,7 +19,7 @@ var (
 
 // AllocateTokens allocates fees from fee_collector
 //1. 25% rewards to validators, equally.
-//2. 75% rewards to validators and candidates, by shares' weight
+//2. 75% rewards to validators and candidates, by shares' weight, which are shared with their delegators
 func (k Keeper) AllocateTokens(ctx sdk.Context, totalPreviousPower int64,
 	previousProposer sdk.ConsAddress, previousVotes []abci.VoteInfo) {
 	logger := k.Logger(ctx)
@@ -103,7 +104,8 @@ func (k Keeper) allocateByEqual(ctx sdk.Context, rewards sdk.SysCoins, previousV
 	remaining := rewards
 	reward := rewards.MulDecTruncate(powerFraction)
 	for _, val := range validators {
-		k.AllocateTokensToValidator(ctx, val, reward)
+		// the rewards allocated equally are for the validators themselves
+		k.addValidatorCommission(ctx, val, reward)
 		logger.Debug("allocate by equal", val.GetOperator(), reward.String())
 		remaining = remaining.Sub(reward)
 	}
@@ -146,11 +148,61 @@ func (k Keeper) allocateByShares(ctx sdk.Context, rewards sdk.SysCoins) sdk.SysC
 	return remaining
 }
 
-// AllocateTokensToValidator allocate tokens to a particular validator, splitting according to commissions
+// AllocateTokensToValidator allocates tokens to a particular validator, splitting them between the commission of the
+// validator and the rewards of the delegators who added shares to it. The whole tokens are the commission before the
+// CommissionUpgradeHeight, as they used to be
 func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val exported.ValidatorI, tokens sdk.SysCoins) {
-	// split tokens between validator and delegators according to commissions
-	// commissions is always 1.0, so tokens.MulDec(val.GetCommission()) = tokens
-	// only update current commissions
+	if !stakingtypes.IsCommissionUpgraded(ctx.BlockHeight()) {
+		k.addValidatorCommission(ctx, val, tokens)
+		return
+	}
+
+	// split tokens between validator and delegators according to commission
+	commission := tokens.MulDecTruncate(val.GetCommission())
+	shared := tokens.Sub(commission)
+
+	// the shares of the msd belong to the validator itself, so that their rewards are added into the commission
+	totalShares, delegatorShares := val.GetDelegatorShares(), val.GetDelegatorShares()
+	if val.GetMinSelfDelegation().IsPositive() {
+		delegatorShares = delegatorShares.Sub(k.stakingKeeper.GetSharesFromDefaultMinSelfDelegation())
+	}
+
+	if delegatorShares.IsPositive() {
+		rewards := shared.MulDecTruncate(delegatorShares.QuoTruncate(totalShares))
+		k.allocateTokensToDelegators(ctx, val.GetOperator(), delegatorShares, rewards)
+		shared = shared.Sub(rewards)
+	}
+
+	commission = commission.Add(shared...)
+	k.addValidatorCommission(ctx, val, commission)
+}
+
+// allocateTokensToDelegators adds the rewards to the cumulative reward ratio and the outstanding rewards of the
+// validator, so that every delegator earns the rewards in proportion to its shares on the validator
+func (k Keeper) allocateTokensToDelegators(ctx sdk.Context, valAddr sdk.ValAddress, delegatorShares sdk.Dec,
+	rewards sdk.SysCoins) {
+	if rewards.IsZero() {
+		return
+	}
+
+	ratio := k.GetValidatorCumulativeRewardRatio(ctx, valAddr)
+	k.SetValidatorCumulativeRewardRatio(ctx, valAddr, ratio.Add(rewards.QuoDecTruncate(delegatorShares)...))
+
+	// the dust truncated from the ratio stays in the outstanding rewards until the validator is removed
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr)
+	k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding.Add(rewards...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+}
+
+// addValidatorCommission adds the tokens to the accumulated commission of the validator
+func (k Keeper) addValidatorCommission(ctx sdk.Context, val exported.ValidatorI, tokens sdk.SysCoins) {
 	commission := k.GetValidatorAccumulatedCommission(ctx, val.GetOperator())
 	commission = commission.Add(tokens...)
 	k.SetValidatorAccumulatedCommission(ctx, val.GetOperator(), commission)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -11,8 +12,15 @@ import (
 
 	"github.com/okex/exchain/x/distribution/types"
 	"github.com/okex/exchain/x/staking"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
 )
 
+// TestMain runs the tests with the commission upgraded, from which the validators set their own commission rates
+func TestMain(m *testing.M) {
+	stakingtypes.CommissionUpgradeHeight = 0
+	os.Exit(m.Run())
+}
+
 func TestAllocateTokensToValidatorWithCommission(t *testing.T) {
 	//init
 	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
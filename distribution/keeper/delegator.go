@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/distribution/types"
+)
+
+// initializeDelegation records the starting info of the delegator with its current shares on the validator
+func (k Keeper) initializeDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	shares, found := k.stakingKeeper.GetShares(ctx, delAddr, valAddr)
+	if !found || !shares.IsPositive() {
+		k.deleteDelegatorStartingInfo(ctx, delAddr, valAddr)
+		return
+	}
+
+	ratio := k.GetValidatorCumulativeRewardRatio(ctx, valAddr)
+	k.SetDelegatorStartingInfo(ctx, delAddr, valAddr,
+		types.NewDelegatorStartingInfo(ratio, shares, uint64(ctx.BlockHeight())))
+}
+
+// calculateDelegationRewards calculates the rewards that the delegator has earned on the validator since the starting
+// info was recorded
+func (k Keeper) calculateDelegationRewards(ctx sdk.Context, valAddr sdk.ValAddress,
+	startingInfo types.DelegatorStartingInfo) sdk.SysCoins {
+	ratio := k.GetValidatorCumulativeRewardRatio(ctx, valAddr)
+	difference := ratio.Sub(startingInfo.RewardRatio)
+	if difference.IsAnyNegative() {
+		panic("negative rewards should not be possible")
+	}
+	return difference.MulDecTruncate(startingInfo.Shares)
+}
+
+// withdrawDelegationRewards sends the rewards of the delegator on the validator to its withdraw address, and deletes
+// the starting info of the delegator. The decimal remainder of the rewards is sent to the community pool. When the
+// delegator is a proxy, the rewards of its bound delegators are split from the ones and accrued to them
+func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.SysCoins, error) {
+	startingInfo, found := k.GetDelegatorStartingInfo(ctx, delAddr, valAddr)
+	if !found {
+		return nil, types.ErrEmptyDelegationDistInfo()
+	}
+
+	rewards := k.calculateDelegationRewards(ctx, valAddr, startingInfo)
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr)
+	// the rewards can't be more than the outstanding rewards, truncate them for safety
+	rewards = rewards.Intersect(outstanding)
+	k.SetValidatorOutstandingRewards(ctx, valAddr, outstanding.Sub(rewards))
+
+	// the rewards of the delegators bound to the proxy are kept in the module account until they withdraw them
+	rewards = k.allocateProxyRewards(ctx, delAddr, rewards)
+
+	// truncate coins, return remainder to community pool
+	coins, remainder := rewards.TruncateDecimal()
+	if !coins.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+		if err != nil {
+			return nil, types.ErrSendCoinsFromModuleToAccountFailed()
+		}
+	}
+
+	if !remainder.IsZero() {
+		feePool := k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+		k.SetFeePool(ctx, feePool)
+	}
+	k.deleteDelegatorStartingInfo(ctx, delAddr, valAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return coins, nil
+}
+
+// WithdrawDelegationRewards withdraws the rewards of the delegator on the validator
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.SysCoins, error) {
+	rewards, err := k.withdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// reinitialize the delegation for the shares still on the validator
+	k.initializeDelegation(ctx, delAddr, valAddr)
+	return rewards, nil
+}
+
+// WithdrawDelegationAllRewards withdraws the rewards of the delegator on all the validators it added shares to, and the
+// rewards it accrued from its proxies
+func (k Keeper) WithdrawDelegationAllRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.SysCoins, error) {
+	// settle the rewards of the proxy bound, so that the delegator withdraws its part of them as well
+	if delegator := k.stakingKeeper.Delegator(ctx, delAddr); delegator != nil && delegator.GetProxyAddress() != nil {
+		k.settleProxyRewards(ctx, delegator.GetProxyAddress())
+	}
+
+	valAddrs := k.getDelegatorValidators(ctx, delAddr)
+	proxyRewards := k.GetProxyDelegatorRewards(ctx, delAddr)
+	if len(valAddrs) == 0 && proxyRewards.IsZero() {
+		return nil, types.ErrEmptyDelegationDistInfo()
+	}
+
+	total := sdk.SysCoins{}
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(rewards...)
+	}
+
+	if !proxyRewards.IsZero() {
+		rewards, err := k.withdrawProxyDelegatorRewards(ctx, delAddr)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(rewards...)
+	}
+	return total, nil
+}
+
+// CalculateDelegationRewards returns the rewards that the delegator can withdraw from the validator
+func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	sdk.SysCoins, error) {
+	startingInfo, found := k.GetDelegatorStartingInfo(ctx, delAddr, valAddr)
+	if !found {
+		return nil, types.ErrEmptyDelegationDistInfo()
+	}
+	return k.calculateDelegationRewards(ctx, valAddr, startingInfo), nil
+}
+
+// getDelegatorValidators returns the addresses of the validators that the delegator is earning rewards from
+func (k Keeper) getDelegatorValidators(ctx sdk.Context, delAddr sdk.AccAddress) (valAddrs []sdk.ValAddress) {
+	k.IterateDelegatorStartingInfosByDelegator(ctx, delAddr,
+		func(_ sdk.AccAddress, valAddr sdk.ValAddress, _ types.DelegatorStartingInfo) (stop bool) {
+			valAddrs = append(valAddrs, valAddr)
+			return false
+		})
+	return valAddrs
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/distribution/types"
+	"github.com/okex/exchain/x/staking"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
+)
+
+func setupDelegationTest(t *testing.T) (sdk.Context, Keeper, staking.Keeper) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	h := staking.NewHandler(sk)
+
+	// delegators share all the rewards that don't belong to the msd
+	val, found := sk.GetValidator(ctx, valOpAddr1)
+	require.True(t, found)
+	val.Commission.Rate = sdk.ZeroDec()
+	sk.SetValidator(ctx, val)
+
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2} {
+		_, err := h(ctx, staking.NewMsgDeposit(delAddr, NewTestSysCoin(100, 0)))
+		require.Nil(t, err)
+		_, err = h(ctx, staking.NewMsgAddShares(delAddr, []sdk.ValAddress{valOpAddr1}))
+		require.Nil(t, err)
+	}
+	return ctx, k, sk
+}
+
+func TestDelegationRewards(t *testing.T) {
+	ctx, k, sk := setupDelegationTest(t)
+
+	_, found := k.GetDelegatorStartingInfo(ctx, delAddr1, valOpAddr1)
+	require.True(t, found)
+	rewards, err := k.CalculateDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards.IsZero())
+
+	// allocate tokens to the validator
+	tokens := NewTestSysCoins(1000, 0)
+	require.Nil(t, k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr4, types.ModuleName, tokens))
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	// the two delegators have the same shares and earn the same rewards
+	rewards1, err := k.CalculateDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	rewards2, err := k.CalculateDelegationRewards(ctx, delAddr2, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards1.IsAllPositive())
+	require.Equal(t, rewards1, rewards2)
+	total := rewards1.Add(rewards2...).Add(k.GetValidatorAccumulatedCommission(ctx, valOpAddr1)...)
+	require.True(t, total.AmountOf(sdk.DefaultBondDenom).LTE(tokens.AmountOf(sdk.DefaultBondDenom)))
+
+	// withdraw the rewards
+	withdrawn, err := k.WithdrawDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, rewards1.AmountOf(sdk.DefaultBondDenom).TruncateInt64(),
+		withdrawn.AmountOf(sdk.DefaultBondDenom).TruncateInt64())
+	rewards1, err = k.CalculateDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards1.IsZero())
+
+	_, err = k.WithdrawDelegationAllRewards(ctx, delAddr2)
+	require.Nil(t, err)
+
+	msg, broken := ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestDelegationRewardsBeforeCommissionUpgrade(t *testing.T) {
+	ctx, k, sk := setupDelegationTest(t)
+	upgradeHeight := stakingtypes.CommissionUpgradeHeight
+	stakingtypes.CommissionUpgradeHeight = ctx.BlockHeight() + 1
+	defer func() { stakingtypes.CommissionUpgradeHeight = upgradeHeight }()
+
+	// the whole tokens are the commission of the validator before the upgrade, whatever its commission rate is
+	tokens := NewTestSysCoins(1000, 0)
+	require.Nil(t, k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr4, types.ModuleName, tokens))
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	require.Equal(t, tokens, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1))
+	for _, delAddr := range []sdk.AccAddress{delAddr1, delAddr2} {
+		rewards, err := k.CalculateDelegationRewards(ctx, delAddr, valOpAddr1)
+		require.Nil(t, err)
+		require.True(t, rewards.IsZero())
+	}
+}
+
+func TestDelegationRewardsAfterSharesModified(t *testing.T) {
+	ctx, k, sk := setupDelegationTest(t)
+	h := staking.NewHandler(sk)
+
+	tokens := NewTestSysCoins(1000, 0)
+	require.Nil(t, k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr4, types.ModuleName, tokens))
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	outstanding := k.GetValidatorOutstandingRewards(ctx, valOpAddr1)
+
+	// the rewards are withdrawn automatically when the shares are modified
+	_, err := h(ctx, staking.NewMsgDeposit(delAddr1, NewTestSysCoin(100, 0)))
+	require.Nil(t, err)
+	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr1).AmountOf(sdk.DefaultBondDenom).
+		LT(outstanding.AmountOf(sdk.DefaultBondDenom)))
+	rewards, err := k.CalculateDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards.IsZero())
+
+	startingInfo, found := k.GetDelegatorStartingInfo(ctx, delAddr1, valOpAddr1)
+	require.True(t, found)
+	shares, found := sk.GetShares(ctx, delAddr1, valOpAddr1)
+	require.True(t, found)
+	require.Equal(t, shares, startingInfo.Shares)
+
+	msg, broken := ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestWithdrawDelegationRewardsWithoutShares(t *testing.T) {
+	ctx, k, _ := setupDelegationTest(t)
+
+	_, err := k.WithdrawDelegationRewards(ctx, delAddr3, valOpAddr1)
+	require.NotNil(t, err)
+	_, err = k.WithdrawDelegationAllRewards(ctx, delAddr3)
+	require.NotNil(t, err)
+}
+
+func TestProxyDelegationRewards(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	h := staking.NewHandler(sk)
+	val, found := sk.GetValidator(ctx, valOpAddr1)
+	require.True(t, found)
+	val.Commission.Rate = sdk.ZeroDec()
+	sk.SetValidator(ctx, val)
+
+	// delAddr1 is a proxy taking 1% commission since 24h after its registration, and delAddr2 binds it with three times
+	// of its tokens
+	_, err := h(ctx, staking.NewMsgDeposit(delAddr1, NewTestSysCoin(100, 0)))
+	require.Nil(t, err)
+	_, err = h(ctx, stakingtypes.NewMsgRegProxy(delAddr1, true))
+	require.Nil(t, err)
+	_, err = h(ctx, staking.NewMsgEditProxyCommissionRate(delAddr1, sdk.NewDecWithPrec(1, 2)))
+	require.NotNil(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	_, err = h(ctx, staking.NewMsgEditProxyCommissionRate(delAddr1, sdk.NewDecWithPrec(1, 2)))
+	require.Nil(t, err)
+	_, err = h(ctx, staking.NewMsgDeposit(delAddr2, NewTestSysCoin(300, 0)))
+	require.Nil(t, err)
+	_, err = h(ctx, stakingtypes.NewMsgBindProxy(delAddr2, delAddr1))
+	require.Nil(t, err)
+	_, err = h(ctx, staking.NewMsgAddShares(delAddr1, []sdk.ValAddress{valOpAddr1}))
+	require.Nil(t, err)
+
+	tokens := NewTestSysCoins(1000, 0)
+	require.Nil(t, k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr4, types.ModuleName, tokens))
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	rewards, err := k.CalculateDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards.IsAllPositive())
+
+	// the pending rewards are split without being settled
+	proxyPending, delPending := k.CalculateProxyRewards(ctx, delAddr1)
+	require.Equal(t, 1, len(delPending))
+	require.True(t, delAddr2.Equals(delPending[0].DelegatorAddress))
+	require.Equal(t, rewards, proxyPending.Add(delPending[0].Rewards...))
+	require.True(t, k.GetProxyDelegatorRewards(ctx, delAddr2).IsZero())
+
+	// the bound delegator is owed 75% of the rewards after the commission of 1%
+	expected := rewards.AmountOf(sdk.DefaultBondDenom).MulTruncate(sdk.NewDecWithPrec(7425, 4))
+	_, err = k.WithdrawDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	accrued := k.GetProxyDelegatorRewards(ctx, delAddr2)
+	require.True(t, accrued.AmountOf(sdk.DefaultBondDenom).Sub(expected).Abs().LTE(sdk.NewDecWithPrec(1, 15)))
+	msg, broken := ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken, msg)
+
+	// the bound delegator withdraws the rewards accrued without adding any shares
+	withdrawn, err := k.WithdrawDelegationAllRewards(ctx, delAddr2)
+	require.Nil(t, err)
+	require.Equal(t, accrued.AmountOf(sdk.DefaultBondDenom).TruncateInt64(),
+		withdrawn.AmountOf(sdk.DefaultBondDenom).TruncateInt64())
+	require.True(t, k.GetProxyDelegatorRewards(ctx, delAddr2).AmountOf(sdk.DefaultBondDenom).LT(sdk.OneDec()))
+
+	// the rewards are settled by the old tokens before the tokens of the bound delegator change
+	require.Nil(t, k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr4, types.ModuleName, tokens))
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	_, delPending = k.CalculateProxyRewards(ctx, delAddr1)
+	_, err = h(ctx, staking.NewMsgDeposit(delAddr2, NewTestSysCoin(100, 0)))
+	require.Nil(t, err)
+	rewards, err = k.CalculateDelegationRewards(ctx, delAddr1, valOpAddr1)
+	require.Nil(t, err)
+	require.True(t, rewards.IsZero())
+	require.True(t, k.GetProxyDelegatorRewards(ctx, delAddr2).AmountOf(sdk.DefaultBondDenom).
+		GTE(delPending[0].Rewards.AmountOf(sdk.DefaultBondDenom)))
+
+	msg, broken = ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
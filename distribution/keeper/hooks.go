@@ -12,7 +12,11 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ stakingtypes.StakingHooks = Hooks{}
+var (
+	_ stakingtypes.StakingHooks = Hooks{}
+	_ stakingtypes.SharesHooks  = Hooks{}
+	_ stakingtypes.ProxyHooks   = Hooks{}
+)
 
 // Hooks creates new distribution hooks
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
@@ -49,6 +53,40 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 	// remove commission record
 	h.k.deleteValidatorAccumulatedCommission(ctx, valAddr)
+
+	// the outstanding rewards left are the dust that no delegator can withdraw, send them to the community pool
+	outstanding := h.k.GetValidatorOutstandingRewards(ctx, valAddr)
+	if !outstanding.IsZero() {
+		feePool := h.k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(outstanding...)
+		h.k.SetFeePool(ctx, feePool)
+	}
+
+	// remove rewards records
+	h.k.deleteValidatorOutstandingRewards(ctx, valAddr)
+	h.k.deleteValidatorCumulativeRewardRatio(ctx, valAddr)
+}
+
+// BeforeDelegationSharesModified withdraws the rewards of the delegator earned with its last shares on the validator
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if _, found := h.k.GetDelegatorStartingInfo(ctx, delAddr, valAddr); !found {
+		return
+	}
+
+	if _, err := h.k.withdrawDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		panic(err)
+	}
+}
+
+// AfterDelegationModified records the starting info of the delegator with its new shares on the validator
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	h.k.initializeDelegation(ctx, delAddr, valAddr)
+}
+
+// BeforeProxyModified settles the rewards of the proxy by the tokens of the proxy and its bound delegators before they
+// change
+func (h Hooks) BeforeProxyModified(ctx sdk.Context, proxyAddr sdk.AccAddress) {
+	h.k.settleProxyRewards(ctx, proxyAddr)
 }
 
 // AfterValidatorDestroyed nothing to do
@@ -69,7 +69,7 @@ func CanWithdrawInvariant(k Keeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
-// is consistent with the sum of accumulated commissions
+// is consistent with the sum of accumulated commissions, outstanding rewards and the rewards accrued from the proxies
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var accumulatedCommission sdk.SysCoins
@@ -78,12 +78,25 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 				accumulatedCommission = accumulatedCommission.Add(commission...)
 				return false
 			})
+		var outstandingRewards sdk.SysCoins
+		k.IterateValidatorOutstandingRewards(ctx,
+			func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+				outstandingRewards = outstandingRewards.Add(rewards...)
+				return false
+			})
+		var proxyRewards sdk.SysCoins
+		k.IterateProxyDelegatorRewards(ctx,
+			func(_ sdk.AccAddress, rewards sdk.SysCoins) (stop bool) {
+				proxyRewards = proxyRewards.Add(rewards...)
+				return false
+			})
 		communityPool := k.GetFeePoolCommunityCoins(ctx)
+		expectedCoins := communityPool.Add(accumulatedCommission...).Add(outstandingRewards...).Add(proxyRewards...)
 		macc := k.GetDistributionAccount(ctx)
-		broken := !macc.GetCoins().IsEqual(communityPool.Add(accumulatedCommission...))
+		broken := !macc.GetCoins().IsEqual(expectedCoins)
 		return sdk.FormatInvariant(types.ModuleName, "ModuleAccount coins",
 			fmt.Sprintf("\texpected distribution ModuleAccount coins:     %s\n"+
 				"\tacutal distribution ModuleAccount coins: %s\n",
-				accumulatedCommission, macc.GetCoins())), broken
+				expectedCoins, macc.GetCoins())), broken
 	}
 }
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/exchain/x/distribution/types"
+)
+
+// splitProxyRewards splits the rewards earned by the shares of the proxy between the proxy and its bound delegators in
+// proportion to their tokens. The proxy takes its commission from the part of the bound delegators, and keeps the
+// decimal dust of the split
+func (k Keeper) splitProxyRewards(ctx sdk.Context, proxyAddr sdk.AccAddress, rewards sdk.SysCoins) (
+	proxyRewards sdk.SysCoins, delRewards []types.ProxyDelegatorRewardsRecord) {
+	proxy := k.stakingKeeper.Delegator(ctx, proxyAddr)
+	if proxy == nil || rewards.IsZero() {
+		return rewards, nil
+	}
+	delegated := proxy.GetTotalDelegatedTokens()
+	if !delegated.IsPositive() {
+		return rewards, nil
+	}
+
+	// the part of the bound delegators after the commission of the proxy
+	total := proxy.GetTokens().Add(delegated)
+	delegatorsPart := rewards.MulDecTruncate(delegated).QuoDecTruncate(total).
+		MulDecTruncate(sdk.OneDec().Sub(proxy.GetProxyCommissionRate()))
+
+	proxyRewards = rewards
+	for _, delAddr := range k.stakingKeeper.GetDelegatorsByProxy(ctx, proxyAddr) {
+		delegator := k.stakingKeeper.Delegator(ctx, delAddr)
+		if delegator == nil || !delegator.GetTokens().IsPositive() {
+			continue
+		}
+
+		delegatorRewards := delegatorsPart.MulDecTruncate(delegator.GetTokens()).QuoDecTruncate(delegated)
+		rest, negative := proxyRewards.SafeSub(delegatorRewards)
+		if delegatorRewards.IsZero() || negative {
+			continue
+		}
+		proxyRewards = rest
+		delRewards = append(delRewards, types.ProxyDelegatorRewardsRecord{
+			DelegatorAddress: delAddr,
+			Rewards:          delegatorRewards,
+		})
+	}
+	return proxyRewards, delRewards
+}
+
+// allocateProxyRewards accrues the rewards of the delegators bound to the proxy split from the rewards, and returns the
+// rest that belongs to the proxy
+func (k Keeper) allocateProxyRewards(ctx sdk.Context, proxyAddr sdk.AccAddress, rewards sdk.SysCoins) sdk.SysCoins {
+	proxyRewards, delRewards := k.splitProxyRewards(ctx, proxyAddr, rewards)
+	for _, record := range delRewards {
+		accrued := k.GetProxyDelegatorRewards(ctx, record.DelegatorAddress)
+		k.SetProxyDelegatorRewards(ctx, record.DelegatorAddress, accrued.Add(record.Rewards...))
+	}
+	return proxyRewards
+}
+
+// settleProxyRewards withdraws the rewards of the proxy on all the validators, which splits them by the current tokens
+// of the proxy and its bound delegators
+func (k Keeper) settleProxyRewards(ctx sdk.Context, proxyAddr sdk.AccAddress) {
+	for _, valAddr := range k.getDelegatorValidators(ctx, proxyAddr) {
+		if _, err := k.WithdrawDelegationRewards(ctx, proxyAddr, valAddr); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// withdrawProxyDelegatorRewards sends the rewards that the delegator accrued from its proxies to its withdraw address.
+// The decimal remainder of the rewards is kept for the next withdrawal
+func (k Keeper) withdrawProxyDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.SysCoins, error) {
+	coins, remainder := k.GetProxyDelegatorRewards(ctx, delAddr).TruncateDecimal()
+	if !coins.IsZero() {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+		if err != nil {
+			return nil, types.ErrSendCoinsFromModuleToAccountFailed()
+		}
+	}
+	k.SetProxyDelegatorRewards(ctx, delAddr, remainder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawProxyRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return coins, nil
+}
+
+// CalculateProxyRewards returns the unsettled rewards of the proxy on all the validators split between the proxy and
+// its bound delegators, in the same way as they will be settled
+func (k Keeper) CalculateProxyRewards(ctx sdk.Context, proxyAddr sdk.AccAddress) (
+	proxyRewards sdk.SysCoins, delRewards []types.ProxyDelegatorRewardsRecord) {
+	rewards := sdk.SysCoins{}
+	k.IterateDelegatorStartingInfosByDelegator(ctx, proxyAddr,
+		func(_ sdk.AccAddress, valAddr sdk.ValAddress, info types.DelegatorStartingInfo) (stop bool) {
+			rewards = rewards.Add(k.calculateDelegationRewards(ctx, valAddr, info)...)
+			return false
+		})
+	return k.splitProxyRewards(ctx, proxyAddr, rewards)
+}
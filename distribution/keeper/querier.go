@@ -25,6 +25,21 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryDelegationRewards:
+			return queryDelegationRewards(ctx, path[1:], req, k)
+
+		case types.QueryDelegatorRewards:
+			return queryDelegatorTotalRewards(ctx, path[1:], req, k)
+
+		case types.QueryDelegatorValidators:
+			return queryDelegatorValidators(ctx, path[1:], req, k)
+
+		case types.QueryProxyRewards:
+			return queryProxyRewards(ctx, path[1:], req, k)
+
+		case types.QueryProxyDelegator:
+			return queryProxyDelegatorRewards(ctx, path[1:], req, k)
+
 		default:
 			return nil, types.ErrUnknownDistributionQueryType()
 		}
@@ -103,3 +118,145 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 
 	return bz, nil
 }
+
+func queryDelegationRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegationRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	rewards, err := k.CalculateDelegationRewards(ctx, params.DelegatorAddress, params.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if rewards == nil {
+		rewards = sdk.SysCoins{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, rewards)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDelegatorTotalRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	total := sdk.SysCoins{}
+	var delRewards []types.DelegationRewards
+	k.IterateDelegatorStartingInfosByDelegator(ctx, params.DelegatorAddress,
+		func(_ sdk.AccAddress, valAddr sdk.ValAddress, info types.DelegatorStartingInfo) (stop bool) {
+			rewards := k.calculateDelegationRewards(ctx, valAddr, info)
+			delRewards = append(delRewards, types.NewDelegationRewards(valAddr, rewards))
+			total = total.Add(rewards...)
+			return false
+		})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, types.NewQueryDelegatorTotalRewardsResponse(delRewards, total))
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDelegatorValidators(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	valAddrs := k.getDelegatorValidators(ctx, params.DelegatorAddress)
+	if valAddrs == nil {
+		valAddrs = []sdk.ValAddress{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, valAddrs)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryProxyRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProxyParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	proxy := k.stakingKeeper.Delegator(ctx, params.ProxyAddress)
+	if proxy == nil {
+		return nil, types.ErrProxyNotFound(params.ProxyAddress.String())
+	}
+
+	proxyPending, delPending := k.CalculateProxyRewards(ctx, params.ProxyAddress)
+	res := types.QueryProxyRewardsResponse{
+		ProxyAddress:     params.ProxyAddress,
+		CommissionRate:   proxy.GetProxyCommissionRate(),
+		ProxyPending:     proxyPending,
+		DelegatorRewards: []types.ProxyDelegatorRewards{},
+	}
+	for _, delAddr := range k.stakingKeeper.GetDelegatorsByProxy(ctx, params.ProxyAddress) {
+		delegator := k.stakingKeeper.Delegator(ctx, delAddr)
+		if delegator == nil {
+			continue
+		}
+		res.DelegatorRewards = append(res.DelegatorRewards, types.NewProxyDelegatorRewards(delAddr,
+			params.ProxyAddress, delegator.GetTokens(), k.GetProxyDelegatorRewards(ctx, delAddr),
+			pendingProxyDelegatorRewards(delPending, delAddr)))
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, res)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryProxyDelegatorRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	// the rewards accrued from the proxies bound before are still withdrawable after unbinding
+	res := types.NewProxyDelegatorRewards(params.DelegatorAddress, nil, sdk.ZeroDec(),
+		k.GetProxyDelegatorRewards(ctx, params.DelegatorAddress), sdk.SysCoins{})
+	if delegator := k.stakingKeeper.Delegator(ctx, params.DelegatorAddress); delegator != nil {
+		res.Tokens = delegator.GetTokens()
+		if proxyAddr := delegator.GetProxyAddress(); proxyAddr != nil {
+			_, delPending := k.CalculateProxyRewards(ctx, proxyAddr)
+			res.ProxyAddress = proxyAddr
+			res.Pending = pendingProxyDelegatorRewards(delPending, params.DelegatorAddress)
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, res)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+// pendingProxyDelegatorRewards returns the pending rewards of the delegator among the ones split from the proxy
+func pendingProxyDelegatorRewards(records []types.ProxyDelegatorRewardsRecord, delAddr sdk.AccAddress) sdk.SysCoins {
+	for _, record := range records {
+		if record.DelegatorAddress.Equals(delAddr) {
+			return record.Rewards
+		}
+	}
+	return sdk.SysCoins{}
+}
@@ -145,3 +145,178 @@ func (k Keeper) IterateValidatorAccumulatedCommissions(ctx sdk.Context,
 		}
 	}
 }
+
+// GetValidatorOutstandingRewards returns the outstanding rewards of the delegators of a validator
+func (k Keeper) GetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress) (
+	rewards types.ValidatorOutstandingRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorOutstandingRewardsKey(val))
+	if b == nil {
+		return types.ValidatorOutstandingRewards{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &rewards)
+	return rewards
+}
+
+// SetValidatorOutstandingRewards sets the outstanding rewards of the delegators of a validator
+func (k Keeper) SetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress,
+	rewards types.ValidatorOutstandingRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(rewards)
+	store.Set(types.GetValidatorOutstandingRewardsKey(val), b)
+}
+
+// deleteValidatorOutstandingRewards deletes the outstanding rewards of the delegators of a validator
+func (k Keeper) deleteValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorOutstandingRewardsKey(val))
+}
+
+// IterateValidatorOutstandingRewards iterates over the outstanding rewards of the validators
+func (k Keeper) IterateValidatorOutstandingRewards(ctx sdk.Context,
+	handler func(val sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorOutstandingRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.ValidatorOutstandingRewards
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rewards)
+		addr := types.GetValidatorOutstandingRewardsAddress(iter.Key())
+		if handler(addr, rewards) {
+			break
+		}
+	}
+}
+
+// GetValidatorCumulativeRewardRatio returns the cumulative reward ratio of a validator
+func (k Keeper) GetValidatorCumulativeRewardRatio(ctx sdk.Context, val sdk.ValAddress) (
+	ratio types.ValidatorCumulativeRewardRatio) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorCumulativeRewardRatioKey(val))
+	if b == nil {
+		return types.ValidatorCumulativeRewardRatio{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &ratio)
+	return ratio
+}
+
+// SetValidatorCumulativeRewardRatio sets the cumulative reward ratio of a validator
+func (k Keeper) SetValidatorCumulativeRewardRatio(ctx sdk.Context, val sdk.ValAddress,
+	ratio types.ValidatorCumulativeRewardRatio) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(ratio)
+	store.Set(types.GetValidatorCumulativeRewardRatioKey(val), b)
+}
+
+// deleteValidatorCumulativeRewardRatio deletes the cumulative reward ratio of a validator
+func (k Keeper) deleteValidatorCumulativeRewardRatio(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorCumulativeRewardRatioKey(val))
+}
+
+// IterateValidatorCumulativeRewardRatios iterates over the cumulative reward ratios of the validators
+func (k Keeper) IterateValidatorCumulativeRewardRatios(ctx sdk.Context,
+	handler func(val sdk.ValAddress, ratio types.ValidatorCumulativeRewardRatio) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorCumulativeRewardRatioPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ratio types.ValidatorCumulativeRewardRatio
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &ratio)
+		addr := types.GetValidatorCumulativeRewardRatioAddress(iter.Key())
+		if handler(addr, ratio) {
+			break
+		}
+	}
+}
+
+// GetDelegatorStartingInfo returns the starting info of a delegator on a validator
+func (k Keeper) GetDelegatorStartingInfo(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (
+	info types.DelegatorStartingInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegatorStartingInfoKey(delAddr, valAddr))
+	if b == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &info)
+	return info, true
+}
+
+// SetDelegatorStartingInfo sets the starting info of a delegator on a validator
+func (k Keeper) SetDelegatorStartingInfo(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	info types.DelegatorStartingInfo) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(info)
+	store.Set(types.GetDelegatorStartingInfoKey(delAddr, valAddr), b)
+}
+
+// deleteDelegatorStartingInfo deletes the starting info of a delegator on a validator
+func (k Keeper) deleteDelegatorStartingInfo(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorStartingInfoKey(delAddr, valAddr))
+}
+
+// IterateDelegatorStartingInfos iterates over all the starting infos of the delegators
+func (k Keeper) IterateDelegatorStartingInfos(ctx sdk.Context,
+	handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, info types.DelegatorStartingInfo) (stop bool)) {
+	k.iterateDelegatorStartingInfos(ctx, types.DelegatorStartingInfoPrefix, handler)
+}
+
+// IterateDelegatorStartingInfosByDelegator iterates over the starting infos of a delegator on all the validators
+func (k Keeper) IterateDelegatorStartingInfosByDelegator(ctx sdk.Context, delAddr sdk.AccAddress,
+	handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, info types.DelegatorStartingInfo) (stop bool)) {
+	k.iterateDelegatorStartingInfos(ctx, types.GetDelegatorStartingInfosPrefix(delAddr), handler)
+}
+
+func (k Keeper) iterateDelegatorStartingInfos(ctx sdk.Context, prefix []byte,
+	handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress, info types.DelegatorStartingInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.DelegatorStartingInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &info)
+		delAddr, valAddr := types.GetDelegatorStartingInfoAddresses(iter.Key())
+		if handler(delAddr, valAddr, info) {
+			break
+		}
+	}
+}
+
+// GetProxyDelegatorRewards returns the rewards that a delegator accrued from its proxies
+func (k Keeper) GetProxyDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress) (rewards sdk.SysCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetProxyDelegatorRewardsKey(delAddr))
+	if b == nil {
+		return sdk.SysCoins{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &rewards)
+	return rewards
+}
+
+// SetProxyDelegatorRewards sets the rewards that a delegator accrued from its proxies, and deletes them if they're zero
+func (k Keeper) SetProxyDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress, rewards sdk.SysCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if rewards.IsZero() {
+		store.Delete(types.GetProxyDelegatorRewardsKey(delAddr))
+		return
+	}
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(rewards)
+	store.Set(types.GetProxyDelegatorRewardsKey(delAddr), b)
+}
+
+// IterateProxyDelegatorRewards iterates over the rewards that the delegators accrued from their proxies
+func (k Keeper) IterateProxyDelegatorRewards(ctx sdk.Context,
+	handler func(delAddr sdk.AccAddress, rewards sdk.SysCoins) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ProxyDelegatorRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards sdk.SysCoins
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rewards)
+		addr := types.GetProxyDelegatorRewardsAddress(iter.Key())
+		if handler(addr, rewards) {
+			break
+		}
+	}
+}
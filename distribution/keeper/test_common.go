@@ -147,7 +147,8 @@ func CreateTestInputDefault(t *testing.T, isCheckTx bool, initPower int64) (
 	// create four validators
 	for i := int64(0); i < 4; i++ {
 		msg := staking.NewMsgCreateValidator(valOpAddrs[i], valConsPks[i],
-			staking.Description{}, NewTestSysCoin(i+1, 0))
+			staking.Description{}, staking.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec()),
+			NewTestSysCoin(i+1, 0))
 		// assert initial state: zero current rewards
 		_, e := h(ctx, msg)
 		require.Nil(t, e)
@@ -234,7 +235,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64, comm
 	keeper.supplyKeeper.SetModuleAccount(ctx, distrAcc)
 
 	// set the distribution hooks on staking
-	sk.SetHooks(keeper.Hooks())
+	sk.SetHooks(keeper.Hooks()).SetSharesHooks(keeper.Hooks()).SetProxyHooks(keeper.Hooks())
 
 	// set genesis items required for distribution
 	keeper.SetFeePool(ctx, types.InitialFeePool())
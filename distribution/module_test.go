@@ -1,15 +1,23 @@
 package distribution
 
 import (
+	"os"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/okex/exchain/x/distribution/keeper"
 	"github.com/okex/exchain/x/distribution/types"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// TestMain runs the tests with the commission upgraded, from which the validators set their own commission rates
+func TestMain(m *testing.M) {
+	stakingtypes.CommissionUpgradeHeight = 0
+	os.Exit(m.Run())
+}
+
 func TestAppModule(t *testing.T) {
 	ctx, _, k, _, supplyKeeper := keeper.CreateTestInputDefault(t, false, 1000)
 
@@ -8,6 +8,8 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawValidatorCommission{}, "filechain/distribution/MsgWithdrawReward", nil)
 	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "filechain/distribution/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgWithdrawDelegatorReward{}, "filechain/distribution/MsgWithdrawDelegatorReward", nil)
+	cdc.RegisterConcrete(MsgWithdrawDelegatorAllRewards{}, "filechain/distribution/MsgWithdrawDelegatorAllRewards", nil)
 	cdc.RegisterConcrete(CommunityPoolSpendProposal{}, "filechain/distribution/CommunityPoolSpendProposal", nil)
 }
 
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegatorStartingInfo is the starting info of the rewards of a delegator on a validator. The rewards of the delegator
+// are calculated as Shares * (the current cumulative reward ratio of the validator - RewardRatio)
+type DelegatorStartingInfo struct {
+	RewardRatio ValidatorCumulativeRewardRatio `json:"reward_ratio" yaml:"reward_ratio"`
+	Shares      sdk.Dec                        `json:"shares" yaml:"shares"`
+	Height      uint64                         `json:"creation_height" yaml:"creation_height"`
+}
+
+// NewDelegatorStartingInfo creates a new instance of DelegatorStartingInfo
+func NewDelegatorStartingInfo(rewardRatio ValidatorCumulativeRewardRatio, shares sdk.Dec,
+	height uint64) DelegatorStartingInfo {
+	return DelegatorStartingInfo{
+		RewardRatio: rewardRatio,
+		Shares:      shares,
+		Height:      height,
+	}
+}
+
+// String returns a human readable string representation of DelegatorStartingInfo
+func (dsi DelegatorStartingInfo) String() string {
+	return fmt.Sprintf(`DelegatorStartingInfo:
+  RewardRatio:    %s
+  Shares:         %s
+  Height:         %d`,
+		dsi.RewardRatio, dsi.Shares, dsi.Height)
+}
+
+// DelegationRewards is the rewards of a delegator on a validator
+type DelegationRewards struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Reward           sdk.SysCoins   `json:"reward" yaml:"reward"`
+}
+
+// NewDelegationRewards creates a new instance of DelegationRewards
+func NewDelegationRewards(valAddr sdk.ValAddress, reward sdk.SysCoins) DelegationRewards {
+	return DelegationRewards{
+		ValidatorAddress: valAddr,
+		Reward:           reward,
+	}
+}
+
+// String returns a human readable string representation of DelegationRewards
+func (dr DelegationRewards) String() string {
+	return fmt.Sprintf("%s: %s", dr.ValidatorAddress, dr.Reward)
+}
@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -28,6 +30,9 @@ const (
 	CodeBadDistribution                             uint32 = 67816
 	CodeInvalidProposalAmount                       uint32 = 67817
 	CodeEmptyProposalRecipient                      uint32 = 67818
+	CodeEmptyDelegationDistInfo                     uint32 = 67819
+	CodeNoDelegationRewards                         uint32 = 67820
+	CodeProxyNotFound                               uint32 = 67821
 )
 
 func ErrNilDelegatorAddr() sdk.Error {
@@ -89,3 +94,15 @@ func ErrInvalidProposalAmount() sdk.Error {
 func ErrEmptyProposalRecipient() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeEmptyProposalRecipient, "invalid community pool spend proposal recipient")
 }
+
+func ErrEmptyDelegationDistInfo() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeEmptyDelegationDistInfo, "no delegation distribution info")
+}
+
+func ErrNoDelegationRewards() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNoDelegationRewards, "no delegation rewards to withdraw")
+}
+
+func ErrProxyNotFound(proxyAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeProxyNotFound, fmt.Sprintf("proxy %s not found", proxyAddr))
+}
@@ -6,9 +6,14 @@ const (
 	EventTypeCommission         = "commission"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeRewards            = "rewards"
+	EventTypeWithdrawRewards    = "withdraw_rewards"
+
+	EventTypeWithdrawProxyRewards = "withdraw_proxy_rewards"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
 
 	AttributeValueCategory = ModuleName
 )
@@ -35,6 +35,15 @@ type StakingKeeper interface {
 
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
+
+	// get the shares that a delegator added to a validator
+	GetShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Dec, bool)
+	// get a particular delegator by address
+	Delegator(ctx sdk.Context, delAddr sdk.AccAddress) stakingexported.DelegatorI
+	// get the addresses of the delegators bound to a proxy
+	GetDelegatorsByProxy(ctx sdk.Context, proxyAddr sdk.AccAddress) []sdk.AccAddress
+	// get the shares added by the msd of a validator
+	GetSharesFromDefaultMinSelfDelegation() sdk.Dec
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -17,6 +17,31 @@ type ValidatorAccumulatedCommissionRecord struct {
 	Accumulated      ValidatorAccumulatedCommission `json:"accumulated" yaml:"accumulated"`
 }
 
+// ValidatorOutstandingRewardsRecord is used for import / export via genesis json
+type ValidatorOutstandingRewardsRecord struct {
+	ValidatorAddress   sdk.ValAddress              `json:"validator_address" yaml:"validator_address"`
+	OutstandingRewards ValidatorOutstandingRewards `json:"outstanding_rewards" yaml:"outstanding_rewards"`
+}
+
+// ValidatorCumulativeRewardRatioRecord is used for import / export via genesis json
+type ValidatorCumulativeRewardRatioRecord struct {
+	ValidatorAddress sdk.ValAddress                 `json:"validator_address" yaml:"validator_address"`
+	RewardRatio      ValidatorCumulativeRewardRatio `json:"reward_ratio" yaml:"reward_ratio"`
+}
+
+// DelegatorStartingInfoRecord is used for import / export via genesis json
+type DelegatorStartingInfoRecord struct {
+	DelegatorAddress sdk.AccAddress        `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress        `json:"validator_address" yaml:"validator_address"`
+	StartingInfo     DelegatorStartingInfo `json:"starting_info" yaml:"starting_info"`
+}
+
+// ProxyDelegatorRewardsRecord is used for import / export via genesis json
+type ProxyDelegatorRewardsRecord struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Rewards          sdk.SysCoins   `json:"rewards" yaml:"rewards"`
+}
+
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
 	Params                          Params                                 `json:"params" yaml:"params"`
@@ -25,6 +50,10 @@ type GenesisState struct {
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer" yaml:"previous_proposer"`
 	ValidatorAccumulatedCommissions []ValidatorAccumulatedCommissionRecord `json:"validator_accumulated_commissions" yaml:"validator_accumulated_commissions"`
 	CommunityAddress                sdk.AccAddress                         `json:"community_address" yaml:"community_address"`
+	OutstandingRewards              []ValidatorOutstandingRewardsRecord    `json:"outstanding_rewards" yaml:"outstanding_rewards"`
+	ValidatorCumulativeRewardRatios []ValidatorCumulativeRewardRatioRecord `json:"validator_cumulative_reward_ratios" yaml:"validator_cumulative_reward_ratios"`
+	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
+	ProxyDelegatorRewards           []ProxyDelegatorRewardsRecord          `json:"proxy_delegator_rewards" yaml:"proxy_delegator_rewards"`
 }
 
 // NewGenesisState creates a new object of GenesisState
@@ -50,6 +79,10 @@ func DefaultGenesisState() GenesisState {
 		PreviousProposer:                nil,
 		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
 		CommunityAddress:                nil,
+		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
+		ValidatorCumulativeRewardRatios: []ValidatorCumulativeRewardRatioRecord{},
+		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
+		ProxyDelegatorRewards:           []ProxyDelegatorRewardsRecord{},
 	}
 }
 
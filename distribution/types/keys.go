@@ -24,14 +24,26 @@ const (
 //
 // - 0x01: sdk.ConsAddress
 //
+// - 0x02<valAddr_Bytes>: ValidatorOutstandingRewards
+//
 // - 0x03<accAddr_Bytes>: sdk.AccAddress
 //
+// - 0x04<accAddr_Bytes><valAddr_Bytes>: DelegatorStartingInfo
+//
+// - 0x05<valAddr_Bytes>: ValidatorCumulativeRewardRatio
+//
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
+//
+// - 0x08<accAddr_Bytes>: sdk.SysCoins
 var (
 	FeePoolKey                           = []byte{0x00} // key for global distribution state
 	ProposerKey                          = []byte{0x01} // key for the proposer operator address
+	ValidatorOutstandingRewardsPrefix    = []byte{0x02} // key for outstanding rewards of the delegators of validator
 	DelegatorWithdrawAddrPrefix          = []byte{0x03} // key for delegator withdraw address
+	DelegatorStartingInfoPrefix          = []byte{0x04} // key for delegator starting info
+	ValidatorCumulativeRewardRatioPrefix = []byte{0x05} // key for cumulative reward ratio of validator
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
+	ProxyDelegatorRewardsPrefix          = []byte{0x08} // key for rewards that delegators accrued from their proxies
 	CommunityKey                         = []byte{0x10} // key for community address
 )
 
@@ -53,6 +65,42 @@ func GetValidatorAccumulatedCommissionAddress(key []byte) (valAddr sdk.ValAddres
 	return sdk.ValAddress(addr)
 }
 
+// GetValidatorOutstandingRewardsAddress returns the address from a validator's outstanding rewards key
+func GetValidatorOutstandingRewardsAddress(key []byte) (valAddr sdk.ValAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ValAddress(addr)
+}
+
+// GetValidatorCumulativeRewardRatioAddress returns the address from a validator's cumulative reward ratio key
+func GetValidatorCumulativeRewardRatioAddress(key []byte) (valAddr sdk.ValAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ValAddress(addr)
+}
+
+// GetDelegatorStartingInfoAddresses returns the addresses from a delegator starting info key
+func GetDelegatorStartingInfoAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addrs := key[1:]
+	if len(addrs) != 2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addrs[:sdk.AddrLen]), sdk.ValAddress(addrs[sdk.AddrLen:])
+}
+
+// GetProxyDelegatorRewardsAddress returns the address from a delegator's proxy rewards key
+func GetProxyDelegatorRewardsAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
 // GetDelegatorWithdrawAddrKey returns the key for a delegator's withdraw addr
 func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
@@ -62,3 +110,28 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 func GetValidatorAccumulatedCommissionKey(v sdk.ValAddress) []byte {
 	return append(ValidatorAccumulatedCommissionPrefix, v.Bytes()...)
 }
+
+// GetValidatorOutstandingRewardsKey returns the key for a validator's outstanding rewards
+func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
+}
+
+// GetValidatorCumulativeRewardRatioKey returns the key for a validator's cumulative reward ratio
+func GetValidatorCumulativeRewardRatioKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorCumulativeRewardRatioPrefix, valAddr.Bytes()...)
+}
+
+// GetDelegatorStartingInfosPrefix returns the prefix key of all the starting infos of a delegator
+func GetDelegatorStartingInfosPrefix(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorStartingInfoPrefix, delAddr.Bytes()...)
+}
+
+// GetDelegatorStartingInfoKey returns the key for a delegator's starting info on a validator
+func GetDelegatorStartingInfoKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegatorStartingInfosPrefix(delAddr), valAddr.Bytes()...)
+}
+
+// GetProxyDelegatorRewardsKey returns the key for the rewards that a delegator accrued from its proxies
+func GetProxyDelegatorRewardsKey(delAddr sdk.AccAddress) []byte {
+	return append(ProxyDelegatorRewardsPrefix, delAddr.Bytes()...)
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProxyDelegatorRewards is the rewards that a delegator bound to a proxy is owed
+type ProxyDelegatorRewards struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ProxyAddress     sdk.AccAddress `json:"proxy_address" yaml:"proxy_address"`
+	Tokens           sdk.Dec        `json:"tokens" yaml:"tokens"`
+	// the rewards settled from the proxy, which are withdrawn by MsgWithdrawDelegatorAllRewards
+	Accrued sdk.SysCoins `json:"accrued" yaml:"accrued"`
+	// the part of the unsettled rewards of the proxy, which is settled when the proxy is modified or the rewards are
+	// withdrawn
+	Pending sdk.SysCoins `json:"pending" yaml:"pending"`
+}
+
+// NewProxyDelegatorRewards creates a new instance of ProxyDelegatorRewards
+func NewProxyDelegatorRewards(delAddr, proxyAddr sdk.AccAddress, tokens sdk.Dec, accrued,
+	pending sdk.SysCoins) ProxyDelegatorRewards {
+	return ProxyDelegatorRewards{
+		DelegatorAddress: delAddr,
+		ProxyAddress:     proxyAddr,
+		Tokens:           tokens,
+		Accrued:          accrued,
+		Pending:          pending,
+	}
+}
+
+// String returns a human readable string representation of ProxyDelegatorRewards
+func (pdr ProxyDelegatorRewards) String() string {
+	return fmt.Sprintf(`%s:
+      Tokens:     %s
+      Accrued:    %s
+      Pending:    %s`, pdr.DelegatorAddress, pdr.Tokens, pdr.Accrued, pdr.Pending)
+}
+
+// QueryProxyRewardsResponse defines the properties of the 'custom/distr/proxy_rewards' query response
+type QueryProxyRewardsResponse struct {
+	ProxyAddress   sdk.AccAddress `json:"proxy_address" yaml:"proxy_address"`
+	CommissionRate sdk.Dec        `json:"commission_rate" yaml:"commission_rate"`
+	// the part of the unsettled rewards that the proxy takes, including the commission
+	ProxyPending     sdk.SysCoins            `json:"proxy_pending" yaml:"proxy_pending"`
+	DelegatorRewards []ProxyDelegatorRewards `json:"delegator_rewards" yaml:"delegator_rewards"`
+}
+
+// String returns a human readable string representation of QueryProxyRewardsResponse
+func (res QueryProxyRewardsResponse) String() string {
+	out := "Proxy Rewards:\n"
+	out += fmt.Sprintf("  ProxyAddress:      %s\n", res.ProxyAddress)
+	out += fmt.Sprintf("  CommissionRate:    %s\n", res.CommissionRate)
+	out += fmt.Sprintf("  ProxyPending:      %s\n", res.ProxyPending)
+	out += "  DelegatorRewards:"
+	for _, rewards := range res.DelegatorRewards {
+		out += fmt.Sprintf("\n    %s", rewards)
+	}
+	return out
+}
@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // querier keys
 const (
@@ -8,6 +12,11 @@ const (
 	QueryValidatorCommission = "validator_commission"
 	QueryWithdrawAddr        = "withdraw_addr"
 	QueryCommunityPool       = "community_pool"
+	QueryDelegationRewards   = "delegation_rewards"
+	QueryDelegatorRewards    = "delegator_total_rewards"
+	QueryDelegatorValidators = "delegator_validators"
+	QueryProxyRewards        = "proxy_rewards"
+	QueryProxyDelegator      = "proxy_delegator_rewards"
 
 	ParamCommunityTax        = "community_tax"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
@@ -34,3 +43,63 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// QueryDelegationRewardsParams is the struct of params for query 'custom/distr/delegation_rewards'
+type QueryDelegationRewardsParams struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// NewQueryDelegationRewardsParams creates a new instance of QueryDelegationRewardsParams
+func NewQueryDelegationRewardsParams(delegatorAddr sdk.AccAddress,
+	validatorAddr sdk.ValAddress) QueryDelegationRewardsParams {
+	return QueryDelegationRewardsParams{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+	}
+}
+
+// QueryDelegatorParams is the struct of params for query 'custom/distr/delegator_total_rewards',
+// 'custom/distr/delegator_validators' and 'custom/distr/proxy_delegator_rewards'
+type QueryDelegatorParams struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+}
+
+// NewQueryDelegatorParams creates a new instance of QueryDelegatorParams
+func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams {
+	return QueryDelegatorParams{DelegatorAddress: delegatorAddr}
+}
+
+// QueryProxyParams is the struct of params for query 'custom/distr/proxy_rewards'
+type QueryProxyParams struct {
+	ProxyAddress sdk.AccAddress `json:"proxy_address" yaml:"proxy_address"`
+}
+
+// NewQueryProxyParams creates a new instance of QueryProxyParams
+func NewQueryProxyParams(proxyAddr sdk.AccAddress) QueryProxyParams {
+	return QueryProxyParams{ProxyAddress: proxyAddr}
+}
+
+// QueryDelegatorTotalRewardsResponse defines the properties of the 'custom/distr/delegator_total_rewards' query
+// response
+type QueryDelegatorTotalRewardsResponse struct {
+	Rewards []DelegationRewards `json:"rewards" yaml:"rewards"`
+	Total   sdk.SysCoins        `json:"total" yaml:"total"`
+}
+
+// NewQueryDelegatorTotalRewardsResponse creates a new instance of QueryDelegatorTotalRewardsResponse
+func NewQueryDelegatorTotalRewardsResponse(rewards []DelegationRewards,
+	total sdk.SysCoins) QueryDelegatorTotalRewardsResponse {
+	return QueryDelegatorTotalRewardsResponse{Rewards: rewards, Total: total}
+}
+
+// String returns a human readable string representation of QueryDelegatorTotalRewardsResponse
+func (res QueryDelegatorTotalRewardsResponse) String() string {
+	out := "Delegator Total Rewards:\n"
+	out += "  Rewards:"
+	for _, reward := range res.Rewards {
+		out += fmt.Sprintf("\n    %s", reward)
+	}
+	out += fmt.Sprintf("\n  Total:    %s", res.Total)
+	return out
+}
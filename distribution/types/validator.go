@@ -12,3 +12,10 @@ type ValidatorAccumulatedCommission = sdk.SysCoins
 func InitialValidatorAccumulatedCommission() ValidatorAccumulatedCommission {
 	return ValidatorAccumulatedCommission{}
 }
+
+// ValidatorOutstandingRewards is the rewards allocated to the delegators of a validator, which haven't been withdrawn yet
+type ValidatorOutstandingRewards = sdk.SysCoins
+
+// ValidatorCumulativeRewardRatio is the rewards per share that the delegators of a validator have earned since the
+// validator was created. It only grows when tokens are allocated to the delegators of the validator
+type ValidatorCumulativeRewardRatio = sdk.SysCoins
@@ -15,11 +15,12 @@ const (
 
 // nolint
 var (
-	NewKeeper = keeper.NewKeeper
-	TxDecoder = types.TxDecoder
+	NewKeeper       = keeper.NewKeeper
+	TxDecoder       = types.TxDecoder
+	HeightTxDecoder = types.HeightTxDecoder
 )
 
-//nolint
+// nolint
 type (
 	Keeper       = keeper.Keeper
 	GenesisState = types.GenesisState
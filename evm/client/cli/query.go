@@ -32,17 +32,20 @@ func GetQueryCmd(moduleName string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryParams(moduleName, cdc),
 		GetCmdQueryContractDeploymentWhitelist(moduleName, cdc),
 		GetCmdQueryContractBlockedList(moduleName, cdc),
+		GetCmdQueryNativeTokens(moduleName, cdc),
+		GetCmdQueryContractPatches(moduleName, cdc),
 	)...)
 	return evmQueryCmd
 }
 
 // GetCmdQueryContractBlockedList gets the contract blocked list query command.
 func GetCmdQueryContractBlockedList(storeName string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "contract-blocked-list",
 		Short: "Query the contract blocked list",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the current blocked list of contract addresses during evm calling.
+The contracts with only some of their methods blocked are queried by the methods subcommand.
 
 Example:
 $ %s query evm contract-blocked-list
@@ -64,6 +67,109 @@ $ %s query evm contract-blocked-list
 			return cliCtx.PrintOutput(blockedList)
 		},
 	}
+
+	cmd.AddCommand(flags.GetCommands(getCmdQueryContractMethodBlockedList(storeName, cdc))...)
+	return cmd
+}
+
+// getCmdQueryContractMethodBlockedList gets the contract method blocked list query command.
+func getCmdQueryContractMethodBlockedList(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "methods",
+		Short: "Query the contract method blocked list",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current blocked list of contract methods during evm calling.
+
+Example:
+$ %s query evm contract-blocked-list methods
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryContractMethodBlockedList)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var contractList types.BlockedContractList
+			cdc.MustUnmarshalJSON(bz, &contractList)
+			return cliCtx.PrintOutput(contractList)
+		},
+	}
+}
+
+// GetCmdQueryNativeTokens gets the native tokens query command.
+func GetCmdQueryNativeTokens(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "native-tokens",
+		Short: "Query the native tokens mapped into the evm",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens of the token module mapped into the evm together with their ERC-20 contract addresses.
+
+Example:
+$ %s query evm native-tokens
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryNativeTokens)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var tokens []types.NativeToken
+			cdc.MustUnmarshalJSON(bz, &tokens)
+			return cliCtx.PrintOutput(tokens)
+		},
+	}
+}
+
+// GetCmdQueryContractPatches gets the contract patches query command.
+func GetCmdQueryContractPatches(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-patches [contract-address]",
+		Short: "Query the contract patches applied by proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the records of the emergency patches on the code, storage and balance of contracts applied by
+proposals. The records of all the contracts are queried when the contract address is omitted.
+
+Example:
+$ %s query evm contract-patches
+$ %s query evm contract-patches 0xc2e67c8da7a4c5d7f5e6a3c1cd87c92f5a5e3b2d
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryContractPatches)
+			if len(args) == 1 {
+				addr, err := accountToHex(args[0])
+				if err != nil {
+					return errors.Wrap(err, "could not parse contract address")
+				}
+				route = fmt.Sprintf("%s/%s", route, addr)
+			}
+
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var records []types.ContractPatchRecord
+			cdc.MustUnmarshalJSON(bz, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
 }
 
 // GetCmdQueryContractDeploymentWhitelist gets the contract deployment whitelist query command.
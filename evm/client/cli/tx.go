@@ -300,3 +300,204 @@ Where proposal.json contains:
 		},
 	}
 }
+
+// GetCmdManageContractMethodBlockedListProposal implements a command handler for submitting a manage contract method
+// blocked list proposal transaction
+func GetCmdManageContractMethodBlockedListProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-contract-method-blocked-list [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update contract method blocked list proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update contract method blocked list proposal along with an initial deposit.
+The blocked methods of a contract are identified by their 4 bytes selectors, while the other methods can still be invoked.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-contract-method-blocked-list <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "update contract method blocked list proposal with a contract list",
+  "description": "add the methods of a contract list into the method blocked list",
+  "contract_addresses": [
+    {
+      "address": "ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02",
+      "block_methods": [
+        {
+          "sign": "0x3ccfd60b",
+          "extra": "withdraw()"
+        }
+      ]
+    }
+  ],
+  "is_added": true,
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseManageContractMethodBlockedListProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewManageContractMethodBlockedListProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.ContractList,
+				proposal.IsAdded,
+			)
+
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdManageNativeTokenMappingProposal implements a command handler for submitting a manage native token mapping
+// proposal transaction
+func GetCmdManageNativeTokenMappingProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-native-token-mapping [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update native token mapping proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update native token mapping proposal along with an initial deposit.
+The mapped tokens of the token module are exposed to the evm as ERC-20 contracts.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-native-token-mapping <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "update native token mapping proposal with a token symbol list",
+  "description": "map a token symbol list into the evm",
+  "symbols": [
+    "xxb-781",
+    "usdk-017"
+  ],
+  "is_added": true,
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseManageNativeTokenMappingProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewManageNativeTokenMappingProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.Symbols,
+				proposal.IsAdded,
+			)
+
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdContractPatchProposal implements a command handler for submitting a contract patch proposal transaction
+func GetCmdContractPatchProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-patch [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a contract patch proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an emergency contract patch proposal along with an initial deposit.
+The code, the storage slots and the balance of the contracts are overwritten once the proposal passes. The code or the
+balance is left untouched if it's omitted, and "0x" as the code removes the code of the contract. The balance added to
+a contract is paid by the evm module account, and the balance removed from it is returned to the evm module account.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal contract-patch <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "contract patch proposal",
+  "description": "fix the owner of an exploited contract",
+  "patches": [
+    {
+      "address": "ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02",
+      "code": "",
+      "storage": [
+        {
+          "key": "0x0000000000000000000000000000000000000000000000000000000000000000",
+          "value": "0x000000000000000000000000c2e67c8da7a4c5d7f5e6a3c1cd87c92f5a5e3b2d"
+        }
+      ],
+      "balance": "0"
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseContractPatchProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewContractPatchProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.Patches,
+			)
+
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -18,4 +18,22 @@ var (
 		cli.GetCmdManageContractBlockedListProposal,
 		rest.ManageContractBlockedListProposalRESTHandler,
 	)
+
+	// ManageContractMethodBlockedListProposalHandler alias gov NewProposalHandler
+	ManageContractMethodBlockedListProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdManageContractMethodBlockedListProposal,
+		rest.ManageContractMethodBlockedListProposalRESTHandler,
+	)
+
+	// ManageNativeTokenMappingProposalHandler alias gov NewProposalHandler
+	ManageNativeTokenMappingProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdManageNativeTokenMappingProposal,
+		rest.ManageNativeTokenMappingProposalRESTHandler,
+	)
+
+	// ContractPatchProposalHandler alias gov NewProposalHandler
+	ContractPatchProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdContractPatchProposal,
+		rest.ContractPatchProposalRESTHandler,
+	)
 )
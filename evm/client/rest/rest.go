@@ -191,6 +191,21 @@ func ManageContractBlockedListProposalRESTHandler(context.CLIContext) govRest.Pr
 	return govRest.ProposalRESTHandler{}
 }
 
+// ManageContractMethodBlockedListProposalRESTHandler defines evm proposal handler
+func ManageContractMethodBlockedListProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+// ManageNativeTokenMappingProposalRESTHandler defines evm proposal handler
+func ManageNativeTokenMappingProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+// ContractPatchProposalRESTHandler defines evm proposal handler
+func ContractPatchProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
 func QuerySectionFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", evmtypes.RouterKey, evmtypes.QuerySection))
@@ -27,6 +27,32 @@ type (
 		IsAdded       bool              `json:"is_added" yaml:"is_added"`
 		Deposit       sdk.SysCoins      `json:"deposit" yaml:"deposit"`
 	}
+	// ManageContractMethodBlockedListProposalJSON defines a ManageContractMethodBlockedListProposal with a deposit used
+	// to parse manage contract method blocked list proposals from a JSON file.
+	ManageContractMethodBlockedListProposalJSON struct {
+		Title        string                    `json:"title" yaml:"title"`
+		Description  string                    `json:"description" yaml:"description"`
+		ContractList types.BlockedContractList `json:"contract_addresses" yaml:"contract_addresses"`
+		IsAdded      bool                      `json:"is_added" yaml:"is_added"`
+		Deposit      sdk.SysCoins              `json:"deposit" yaml:"deposit"`
+	}
+	// ContractPatchProposalJSON defines a ContractPatchProposal with a deposit used to parse contract patch proposals
+	// from a JSON file.
+	ContractPatchProposalJSON struct {
+		Title       string                `json:"title" yaml:"title"`
+		Description string                `json:"description" yaml:"description"`
+		Patches     types.ContractPatches `json:"patches" yaml:"patches"`
+		Deposit     sdk.SysCoins          `json:"deposit" yaml:"deposit"`
+	}
+	// ManageNativeTokenMappingProposalJSON defines a ManageNativeTokenMappingProposal with a deposit used to parse
+	// manage native token mapping proposals from a JSON file.
+	ManageNativeTokenMappingProposalJSON struct {
+		Title       string       `json:"title" yaml:"title"`
+		Description string       `json:"description" yaml:"description"`
+		Symbols     []string     `json:"symbols" yaml:"symbols"`
+		IsAdded     bool         `json:"is_added" yaml:"is_added"`
+		Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseManageContractDeploymentWhitelistProposalJSON parses json from proposal file to ManageContractDeploymentWhitelistProposalJSON
@@ -53,3 +79,40 @@ func ParseManageContractBlockedListProposalJSON(cdc *codec.Codec, proposalFilePa
 	cdc.MustUnmarshalJSON(contents, &proposal)
 	return
 }
+
+// ParseManageContractMethodBlockedListProposalJSON parses json from proposal file to
+// ManageContractMethodBlockedListProposalJSON struct
+func ParseManageContractMethodBlockedListProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ManageContractMethodBlockedListProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
+
+// ParseManageNativeTokenMappingProposalJSON parses json from proposal file to ManageNativeTokenMappingProposalJSON struct
+func ParseManageNativeTokenMappingProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ManageNativeTokenMappingProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
+
+// ParseContractPatchProposalJSON parses json from proposal file to ContractPatchProposalJSON struct
+func ParseContractPatchProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ContractPatchProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
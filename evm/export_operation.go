@@ -70,6 +70,8 @@ func initExportEnv(dataPath, mode string, goroutineNum uint64) {
 	case "db":
 		initEVMDB(dataPath)
 		initGoroutinePool(goroutineNum)
+	case "snapshot":
+		evmSnapshotWriter = newSnapshotWriter(dataPath)
 	default:
 		panic("unsupported export mode")
 	}
@@ -90,6 +92,10 @@ func initImportEnv(dataPath, mode string, goroutineNum uint64) {
 		initGoroutinePool(goroutineNum)
 	case "db":
 		initEVMDB(dataPath)
+	case "snapshot":
+		// verify and stage the snapshot into the evm db before importing
+		initEVMDB(dataPath)
+		stageSnapshot(dataPath)
 	default:
 		panic("unsupported import mode")
 	}
@@ -136,6 +142,11 @@ func importFromDB(ctx sdk.Context, k Keeper, address ethcmn.Address, codeHash []
 		panic("failed to open evm db")
 	}
 
+	importAccountFromDB(ctx, k, address, codeHash)
+}
+
+// importAccountFromDB import EVM code and storage of the account from the leveldb opened
+func importAccountFromDB(ctx sdk.Context, k Keeper, address ethcmn.Address, codeHash []byte) {
 	code, err := evmByteCodeDB.Get(append(types.KeyPrefixCode, codeHash...))
 	if err != nil {
 		panic(err)
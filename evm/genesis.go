@@ -64,6 +64,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, accountKeeper types.AccountKeeper, d
 			importFromFile(ctx, logger, k, address, ethAcc.CodeHash)
 		case dbMode:
 			importFromDB(ctx, k, address, ethAcc.CodeHash)
+		case snapshotMode:
+			// the snapshot has been verified and staged into the evm db
+			importAccountFromDB(ctx, k, address, ethAcc.CodeHash)
 		default:
 			panic("unsupported import mode")
 		}
@@ -80,6 +83,19 @@ func InitGenesis(ctx sdk.Context, k Keeper, accountKeeper types.AccountKeeper, d
 	// set contract blocked list into store
 	csdb.SetContractBlockedList(data.ContractBlockedList)
 
+	// set contract method blocked list into store
+	csdb.SetContractMethodBlockedList(data.ContractMethodBlockedList)
+
+	// map the native tokens into the evm, their contracts are imported together with the accounts
+	symbols := make([]string, len(data.NativeTokens))
+	for i, token := range data.NativeTokens {
+		symbols[i] = token.Symbol
+	}
+	csdb.SetNativeTokens(symbols)
+
+	// set the records of the contract patches into store
+	csdb.SetContractPatchRecords(data.ContractPatchRecords)
+
 	logger.Debug("Import finished", "code", codeCount, "storage", storageCount)
 
 	// set state objects and code to store
@@ -140,7 +156,8 @@ func ExportGenesis(ctx sdk.Context, k Keeper, ak types.AccountKeeper) GenesisSta
 			exportToFile(ctx, k, addr)
 		case dbMode:
 			exportToDB(ctx, k, addr, ethAccount.CodeHash)
-
+		case snapshotMode:
+			evmSnapshotWriter.writeAccount(ctx, k, addr)
 		default:
 			panic("unsupported export mode")
 		}
@@ -158,6 +175,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper, ak types.AccountKeeper) GenesisSta
 	if mode == filesMode || mode == dbMode {
 		wg.Wait()
 	}
+	if mode == snapshotMode {
+		evmSnapshotWriter.finish(ctx.BlockHeight(), ctx.BlockHeader().AppHash)
+	}
 	logger.Debug("Export finished", "code", codeCount, "storage", storageCount)
 
 	config, _ := k.GetChainConfig(ctx)
@@ -167,5 +187,8 @@ func ExportGenesis(ctx sdk.Context, k Keeper, ak types.AccountKeeper) GenesisSta
 		Params:                      k.GetParams(ctx),
 		ContractDeploymentWhitelist: csdb.GetContractDeploymentWhitelist(),
 		ContractBlockedList:         csdb.GetContractBlockedList(),
+		ContractMethodBlockedList:   csdb.GetContractMethodBlockedList(),
+		NativeTokens:                csdb.GetNativeTokens(),
+		ContractPatchRecords:        csdb.GetContractPatchRecords(nil),
 	}
 }
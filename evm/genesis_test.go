@@ -1,11 +1,14 @@
 package evm_test
 
 import (
+	"encoding/hex"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -510,3 +513,166 @@ func testImport_files(suite *EvmTestSuite,
 		suite.Require().Equal(expectedAddrList, suite.stateDB.GetContractBlockedList())
 	})
 }
+
+func (suite *EvmTestSuite) TestExport_snapshot() {
+	privkey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+
+	address := ethcmn.HexToAddress(privkey.PubKey().Address().String())
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, address.Bytes())
+	suite.Require().NotNil(acc)
+
+	code := []byte{1, 2, 3}
+	ethAccount := ethermint.EthAccount{
+		BaseAccount: &auth.BaseAccount{
+			Address: acc.GetAddress(),
+		},
+		CodeHash: ethcrypto.Keccak256(code),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, ethAccount)
+
+	storage := types.Storage{
+		{Key: common.BytesToHash([]byte("key1")), Value: common.BytesToHash([]byte("value1"))},
+		{Key: common.BytesToHash([]byte("key2")), Value: common.BytesToHash([]byte("value2"))},
+		{Key: common.BytesToHash([]byte("key3")), Value: common.BytesToHash([]byte("value3"))},
+	}
+	initGenesis := types.GenesisState{
+		Params:   types.DefaultParams(),
+		Accounts: []types.GenesisAccount{{Address: address.String(), Code: code, Storage: storage}},
+	}
+	evm.InitGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper, initGenesis)
+
+	// the snapshot records the height and app hash of the exported state
+	header := suite.ctx.BlockHeader()
+	header.Height, header.AppHash = 10, []byte("app hash")
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+
+	tmpPath := "./test_tmp_snapshot"
+	viper.Set(server.FlagEvmExportMode, "snapshot")
+	viper.Set(server.FlagEvmExportPath, tmpPath)
+	// the small chunk size cuts the storage into 2 chunks
+	viper.Set(evm.FlagSnapshotChunkSize, 100)
+	defer func() {
+		viper.Set(server.FlagEvmExportMode, "")
+		viper.Set(server.FlagEvmImportMode, "")
+		viper.Set(evm.FlagSnapshotChunkSize, 0)
+		viper.Set(evm.FlagSnapshotHeight, 0)
+		viper.Set(evm.FlagSnapshotAppHash, "")
+		os.RemoveAll(tmpPath)
+	}()
+
+	var exportState types.GenesisState
+	suite.Require().NotPanics(func() {
+		exportState = evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
+	})
+	suite.Require().Equal(hexutil.Bytes(nil), exportState.Accounts[0].Code)
+	suite.Require().Equal(types.Storage(nil), exportState.Accounts[0].Storage)
+	suite.Require().FileExists(filepath.Join(tmpPath, "manifest.json"))
+	suite.Require().FileExists(filepath.Join(tmpPath, "chunks", "000000.chunk"))
+	chunkPath := filepath.Join(tmpPath, "chunks", "000001.chunk")
+	suite.Require().FileExists(chunkPath)
+
+	importSnapshot := func() {
+		viper.Set(server.FlagEvmImportMode, "")
+		suite.SetupTest() // reset
+		suite.app.AccountKeeper.SetAccount(suite.ctx, ethAccount)
+		viper.Set(server.FlagEvmImportMode, "snapshot")
+		viper.Set(server.FlagEvmImportPath, tmpPath)
+		defer evm.CloseDB()
+		evm.InitGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper, exportState)
+	}
+
+	// the snapshot of the other height or app hash is rejected before staging
+	suite.Require().Panics(importSnapshot)
+	viper.Set(evm.FlagSnapshotHeight, 11)
+	viper.Set(evm.FlagSnapshotAppHash, hex.EncodeToString([]byte("app hash")))
+	suite.Require().Panics(importSnapshot)
+	viper.Set(evm.FlagSnapshotHeight, 10)
+	viper.Set(evm.FlagSnapshotAppHash, hex.EncodeToString([]byte("other hash")))
+	suite.Require().Panics(importSnapshot)
+	suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, address))
+	viper.Set(evm.FlagSnapshotAppHash, hex.EncodeToString([]byte("app hash")))
+
+	// the corrupted chunk is detected before any state is applied
+	chunk, err := ioutil.ReadFile(chunkPath)
+	suite.Require().NoError(err)
+	corrupted := append([]byte{}, chunk...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	suite.Require().NoError(ioutil.WriteFile(chunkPath, corrupted, 0644))
+	suite.Require().Panics(importSnapshot)
+	suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, address))
+
+	// the import resumes once the chunk is repaired
+	suite.Require().NoError(ioutil.WriteFile(chunkPath, chunk, 0644))
+	suite.Require().NotPanics(importSnapshot)
+	suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, address))
+	var imported types.Storage
+	suite.app.EvmKeeper.ForEachStorage(suite.ctx, address, func(key, value ethcmn.Hash) bool {
+		imported = append(imported, types.State{Key: key, Value: value})
+		return false
+	})
+	suite.Require().ElementsMatch(storage, imported)
+}
+
+func (suite *EvmTestSuite) TestExportImportNativeTokens() {
+	symbols := []string{"xxb", "yyb"}
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	csdb.SetNativeTokens(symbols)
+	_, err := csdb.Commit(false)
+	suite.Require().NoError(err)
+
+	var genState types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
+	})
+	suite.Require().Len(genState.NativeTokens, 2)
+	suite.Require().NoError(genState.Validate())
+
+	// the mappings are restored by the import
+	csdb.DeleteNativeTokens(symbols)
+	suite.Require().Empty(csdb.GetNativeTokens())
+	_ = evm.InitGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper, genState)
+	for _, symbol := range symbols {
+		contract := types.NativeTokenContractAddress(symbol)
+		mapped, found := csdb.GetNativeTokenSymbol(contract)
+		suite.Require().True(found)
+		suite.Require().Equal(symbol, mapped)
+		suite.Require().Equal(types.NativeTokenContractCode(), suite.app.EvmKeeper.GetCode(suite.ctx, contract))
+	}
+
+	// the contract address of a native token must match its symbol
+	genState.NativeTokens[0].ContractAddress = types.NativeTokenContractAddress("zzb")
+	suite.Require().Error(genState.Validate())
+}
+
+func (suite *EvmTestSuite) TestExportImportContractPatchRecords() {
+	contract := ethcmn.BytesToAddress([]byte("contract"))
+	key, value := ethcmn.BytesToHash([]byte{0x1}), ethcmn.BytesToHash([]byte("owner"))
+	patch := types.ContractPatch{
+		Address: contract.Bytes(),
+		Storage: types.Storage{types.NewState(key, value)},
+	}
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	csdb.SetContractPatchRecord(3, patch)
+
+	var genState types.GenesisState
+	suite.Require().NotPanics(func() {
+		genState = evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
+	})
+	suite.Require().Equal([]types.ContractPatchRecord{{ProposalID: 3, Height: suite.ctx.BlockHeight(), Patch: patch}},
+		genState.ContractPatchRecords)
+	suite.Require().NoError(genState.Validate())
+
+	// the records are imported as they are, with the heights they were applied at
+	record := types.ContractPatchRecord{ProposalID: 1, Height: 100, Patch: patch}
+	genState.ContractPatchRecords = append(genState.ContractPatchRecords, record)
+	suite.Require().NoError(genState.Validate())
+	_ = evm.InitGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper, genState)
+	records := csdb.GetContractPatchRecords(contract.Bytes())
+	suite.Require().Len(records, 2)
+	suite.Require().Equal(record, records[0])
+
+	// a contract is patched once by a proposal
+	genState.ContractPatchRecords = append(genState.ContractPatchRecords, record)
+	suite.Require().Error(genState.Validate())
+}
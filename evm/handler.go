@@ -1,6 +1,8 @@
 package evm
 
 import (
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
@@ -53,6 +55,11 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 		return nil, err
 	}
 
+	// The typed transaction values are checked at the block height, they aren't accepted before TypedTxHeight
+	if err := msg.ValidateTypedTx(ctx.BlockHeight()); err != nil {
+		return nil, err
+	}
+
 	// Verify signature and retrieve sender address
 	sender, err := msg.VerifySig(chainIDEpoch)
 	if err != nil {
@@ -69,6 +76,7 @@ func handleMsgEthereumTx(ctx sdk.Context, k *Keeper, msg types.MsgEthereumTx) (*
 		Recipient:    msg.Data.Recipient,
 		Amount:       msg.Data.Amount,
 		Payload:      msg.Data.Payload,
+		AccessList:   msg.Data.Accesses,
 		Csdb:         types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx),
 		ChainID:      chainIDEpoch,
 		TxHash:       &ethHash,
@@ -176,6 +184,25 @@ func handleMsgEthermint(ctx sdk.Context, k *Keeper, msg types.MsgEthermint) (*sd
 		st.Recipient = &to
 	}
 
+	if len(msg.StateOverrides) > 0 {
+		// the overrides of eth_call are only applied to the simulated state
+		if !st.Simulate {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "state overrides are only allowed in simulation")
+		}
+
+		var overrides types.StateOverride
+		if err := json.Unmarshal(msg.StateOverrides, &overrides); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		// the reads of the overridden accounts don't consume the gas of the call
+		st.Csdb.WithContext(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+		if err := overrides.Apply(st.Csdb); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		st.Csdb.WithContext(ctx)
+	}
+
 	if !st.Simulate {
 		// Prepare db for logs
 		st.Csdb.Prepare(ethHash, k.Bhash, k.TxCount)
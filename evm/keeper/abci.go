@@ -56,7 +56,7 @@ func (k Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.Valid
 
 
 	k.Watcher.SaveBlock(bloom)
-	k.Watcher.Commit()
+	k.Watcher.Commit(k.Logger(ctx))
 
 	if types.GetEnableBloomFilter() {
 		// the hash of current block is stored when executing BeginBlock of next block.
@@ -37,6 +37,7 @@ type Keeper struct {
 	supplyKeeper  types.SupplyKeeper
 	bankKeeper    bank.Keeper
 	govKeeper     GovKeeper
+	tokenKeeper   types.TokenKeeper
 
 	// Transaction counter in a block. Used on StateSB's Prepare function.
 	// It is reset to 0 every block on BeginBlock so there's no point in storing the counter
@@ -57,6 +58,8 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	types.RegisterNativeTokenPrecompile()
+
 	if enable := viper.GetBool(types.FlagEnableBloomFilter); enable {
 		types.SetEnableBloomFilter(enable)
 		db := types.BloomDb()
@@ -86,6 +89,7 @@ func (k Keeper) GenerateCSDBParams() types.CommitStateDBParams {
 		AccountKeeper: k.accountKeeper,
 		SupplyKeeper:  k.supplyKeeper,
 		BankKeeper:    k.bankKeeper,
+		TokenKeeper:   k.tokenKeeper,
 	}
 }
 
@@ -205,3 +209,8 @@ func (k Keeper) SetChainConfig(ctx sdk.Context, config types.ChainConfig) {
 func (k *Keeper) SetGovKeeper(gk GovKeeper) {
 	k.govKeeper = gk
 }
+
+// SetTokenKeeper sets keeper of token
+func (k *Keeper) SetTokenKeeper(tk types.TokenKeeper) {
+	k.tokenKeeper = tk
+}
@@ -6,9 +6,8 @@ import (
 )
 
 // GetParams returns the total set of evm parameters.
-func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
-	return
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.GetParamsFromSubspace(ctx, k.paramSpace)
 }
 
 // SetParams sets the evm parameters to the param space.
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+
 	"github.com/okex/exchain/x/evm/types"
 )
 
@@ -11,3 +13,22 @@ func (suite *KeeperTestSuite) TestParams() {
 	newParams := suite.app.EvmKeeper.GetParams(suite.ctx)
 	suite.Require().Equal(newParams, params)
 }
+
+func (suite *KeeperTestSuite) TestParamsWithoutEnableNativeToken() {
+	// the param space of a chain started before EnableNativeToken is added
+	subspace := suite.app.ParamsKeeper.Subspace("evm_before_native_token").WithKeyTable(types.ParamKeyTable())
+	params := types.DefaultParams()
+	params.EnableCall = true
+	for _, pair := range params.ParamSetPairs() {
+		if !bytes.Equal(pair.Key, types.ParamStoreKeyEnableNativeToken) {
+			subspace.Set(suite.ctx, pair.Key, pair.Value)
+		}
+	}
+	suite.Require().False(subspace.Has(suite.ctx, types.ParamStoreKeyEnableNativeToken))
+
+	suite.Require().Equal(params, types.GetParamsFromSubspace(suite.ctx, subspace))
+
+	params.EnableNativeToken = true
+	subspace.Set(suite.ctx, types.ParamStoreKeyEnableNativeToken, params.EnableNativeToken)
+	suite.Require().Equal(params, types.GetParamsFromSubspace(suite.ctx, subspace))
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/okex/exchain/x/evm/types"
 	sdkGov "github.com/okex/exchain/x/gov"
 	govKeeper "github.com/okex/exchain/x/gov/keeper"
@@ -16,7 +17,9 @@ var _ govKeeper.ProposalHandler = (*Keeper)(nil)
 // GetMinDeposit returns min deposit
 func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
 	switch content.(type) {
-	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal:
+	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal,
+		types.ManageNativeTokenMappingProposal, types.ManageContractMethodBlockedListProposal,
+		types.ContractPatchProposal:
 		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
 	}
 
@@ -26,7 +29,9 @@ func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDepos
 // GetMaxDepositPeriod returns max deposit period
 func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
 	switch content.(type) {
-	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal:
+	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal,
+		types.ManageNativeTokenMappingProposal, types.ManageContractMethodBlockedListProposal,
+		types.ContractPatchProposal:
 		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
 	}
 
@@ -36,7 +41,9 @@ func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (ma
 // GetVotingPeriod returns voting period
 func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
 	switch content.(type) {
-	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal:
+	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal,
+		types.ManageNativeTokenMappingProposal, types.ManageContractMethodBlockedListProposal,
+		types.ContractPatchProposal:
 		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
 	}
 
@@ -46,10 +53,25 @@ func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (voting
 // CheckMsgSubmitProposal validates MsgSubmitProposal
 func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitProposal) sdk.Error {
 	switch content := msg.Content.(type) {
-	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal:
+	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal,
+		types.ManageContractMethodBlockedListProposal:
 		// whole target address list will be added/deleted to/from the contract deployment whitelist/contract blocked list.
 		// It's not necessary to check the existence in CheckMsgSubmitProposal
 		return nil
+	case types.ManageNativeTokenMappingProposal:
+		if !content.IsAdded {
+			return nil
+		}
+		return k.CheckNativeTokens(ctx, content.Symbols)
+	case types.ContractPatchProposal:
+		// only the existing contracts can be patched
+		csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+		for _, patch := range content.Patches {
+			if csdb.GetCodeSize(ethcmn.BytesToAddress(patch.Address)) == 0 {
+				return types.ErrInvalidContractPatch(patch.Address, "not a contract")
+			}
+		}
+		return nil
 	default:
 		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized %s proposal content type: %T", types.DefaultCodespace, content))
 	}
@@ -62,3 +84,36 @@ func (k Keeper) RejectedHandler(_ sdk.Context, _ govTypes.Content)             {
 func (k Keeper) VoteHandler(_ sdk.Context, _ govTypes.Proposal, _ govTypes.Vote) (string, sdk.Error) {
 	return "", nil
 }
+
+// CheckNativeTokens checks that the tokens exist in the token module, so that they can be mapped into the evm
+func (k Keeper) CheckNativeTokens(ctx sdk.Context, symbols []string) sdk.Error {
+	for _, symbol := range symbols {
+		if !k.tokenKeeper.TokenExist(ctx, symbol) {
+			return types.ErrInvalidNativeToken(symbol)
+		}
+	}
+	return nil
+}
+
+// PatchBalance sets the balance of the contract patched by a proposal. The difference isn't minted or burned, it's
+// moved from or to the evm module account, so that the total supply is kept
+func (k Keeper) PatchBalance(ctx sdk.Context, contract sdk.AccAddress, balance sdk.Dec) sdk.Error {
+	current := sdk.ZeroDec()
+	if acc := k.accountKeeper.GetAccount(ctx, contract); acc != nil {
+		current = acc.GetCoins().AmountOf(sdk.DefaultBondDenom)
+	}
+
+	var err error
+	switch {
+	case balance.GT(current):
+		amt := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balance.Sub(current)))
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contract, amt)
+	case balance.LT(current):
+		amt := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, current.Sub(balance)))
+		err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, contract, types.ModuleName, amt)
+	}
+	if err != nil {
+		return types.ErrInvalidContractPatch(contract, err.Error())
+	}
+	return nil
+}
@@ -16,7 +16,7 @@ import (
 
 // NewQuerier is the module level router for state queries
 func NewQuerier(keeper Keeper) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		if len(path) < 1 {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
 				"Insufficient parameters, at least 1 parameter is required")
@@ -49,12 +49,31 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryContractDeploymentWhitelist(ctx, keeper)
 		case types.QueryContractBlockedList:
 			return queryContractBlockedList(ctx, keeper)
+		case types.QueryContractMethodBlockedList:
+			return queryContractMethodBlockedList(ctx, keeper)
+		case types.QueryTraceTx:
+			return queryTraceTx(ctx, req, keeper)
+		case types.QueryNativeTokens:
+			return queryNativeTokens(ctx, keeper)
+		case types.QueryContractPatches:
+			return queryContractPatches(ctx, path, keeper)
+		case types.QueryChainConfig:
+			return queryChainConfig(ctx, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
 		}
 	}
 }
 
+func queryTraceTx(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryTraceParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return keeper.TraceTx(ctx, params)
+}
+
 func queryContractBlockedList(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
 	blockedList := types.CreateEmptyCommitStateDB(keeper.GeneratePureCSDBParams(), ctx).GetContractBlockedList()
 	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, blockedList)
@@ -65,6 +84,42 @@ func queryContractBlockedList(ctx sdk.Context, keeper Keeper) (res []byte, err s
 	return res, nil
 }
 
+func queryContractMethodBlockedList(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	contractList := types.CreateEmptyCommitStateDB(keeper.GeneratePureCSDBParams(), ctx).GetContractMethodBlockedList()
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, contractList)
+	if errUnmarshal != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", errUnmarshal.Error()))
+	}
+
+	return res, nil
+}
+
+func queryNativeTokens(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	tokens := types.CreateEmptyCommitStateDB(keeper.GeneratePureCSDBParams(), ctx).GetNativeTokens()
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, tokens)
+	if errUnmarshal != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", errUnmarshal.Error()))
+	}
+
+	return res, nil
+}
+
+func queryContractPatches(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	// the records of all the contracts are queried without the contract address
+	var contractAddr sdk.AccAddress
+	if len(path) > 1 {
+		contractAddr = ethcmn.HexToAddress(path[1]).Bytes()
+	}
+
+	records := types.CreateEmptyCommitStateDB(keeper.GeneratePureCSDBParams(), ctx).GetContractPatchRecords(contractAddr)
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if errUnmarshal != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", errUnmarshal.Error()))
+	}
+
+	return res, nil
+}
+
 func queryContractDeploymentWhitelist(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
 	whitelist := types.CreateEmptyCommitStateDB(keeper.GeneratePureCSDBParams(), ctx).GetContractDeploymentWhitelist()
 	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, whitelist)
@@ -252,6 +307,19 @@ func queryParams(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
 	return res, nil
 }
 
+func queryChainConfig(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	config, found := keeper.GetChainConfig(ctx)
+	if !found {
+		return nil, sdk.ErrInternal("chain config not found")
+	}
+
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, config)
+	if errUnmarshal != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", errUnmarshal.Error()))
+	}
+	return res, nil
+}
+
 func queryHeightToHash(ctx sdk.Context, path []string, keeper Keeper) ([]byte, error) {
 	if len(path) < 2 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
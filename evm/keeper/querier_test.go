@@ -54,6 +54,9 @@ func (suite *KeeperTestSuite) TestQuerier() {
 		}, true},
 		{"unknown request", []string{"other"}, func() {}, false},
 		{"parameters", []string{types.QueryParameters}, func() {}, true},
+		{"chain config", []string{types.QueryChainConfig}, func() {
+			suite.app.EvmKeeper.SetChainConfig(suite.ctx, types.DefaultChainConfig())
+		}, true},
 	}
 
 	for i, tc := range testCases {
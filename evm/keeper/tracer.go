@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethermint "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/x/evm/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// TraceTx re-executes the txs of a block on top of the state of its parent block and traces the last
+// one (or the extra call message) with the tracer described by the trace config.
+// NOTE: the ctx is expected to be a query context at the height of the parent block, so that none of
+// the state changes done here are persisted
+func (k Keeper) TraceTx(ctx sdk.Context, params types.QueryTraceParams) (json.RawMessage, error) {
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	config, found := k.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+
+	header := ctx.BlockHeader()
+	header.Height = params.BlockHeight
+	header.Time = params.BlockTime
+	header.ProposerAddress = params.ProposerAddr
+	ctx = ctx.WithBlockHeader(header)
+
+	if len(params.Txs) == 0 && params.Call == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no transaction to trace")
+	}
+
+	txDecoder := types.TxDecoder(k.cdc)
+	for i, txBytes := range params.Txs {
+		tx, err := txDecoder(txBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		// only the evm txs are replayed, the state changes of the cosmos txs are not taken into account
+		msg, ok := tx.(types.MsgEthereumTx)
+		if !ok {
+			if params.Call == nil && i == len(params.Txs)-1 {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the traced transaction is not an evm transaction")
+			}
+			continue
+		}
+
+		sender, err := msg.VerifySig(chainIDEpoch)
+		if err != nil {
+			return nil, err
+		}
+
+		txHash := ethcmn.BytesToHash(tmtypes.Tx(txBytes).Hash())
+		st := types.StateTransition{
+			AccountNonce: msg.Data.AccountNonce,
+			Price:        msg.Data.Price,
+			GasLimit:     msg.Data.GasLimit,
+			Recipient:    msg.Data.Recipient,
+			Amount:       msg.Data.Amount,
+			Payload:      msg.Data.Payload,
+			AccessList:   msg.Data.Accesses,
+			Csdb:         types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx),
+			ChainID:      chainIDEpoch,
+			TxHash:       &txHash,
+			Sender:       sender,
+		}
+		st.Csdb.Prepare(txHash, params.BlockHash, i)
+
+		if err := k.payStateTransitionFee(st); err != nil {
+			return nil, err
+		}
+
+		if params.Call == nil && i == len(params.Txs)-1 {
+			return k.traceStateTransition(ctx, st, config, params.Config)
+		}
+
+		k.replayStateTransition(ctx, st, config)
+	}
+
+	txHash := ethcmn.BytesToHash(tmtypes.Tx(params.Call.GetSignBytes()).Hash())
+	st := types.StateTransition{
+		Price:     params.Call.Price.BigInt(),
+		GasLimit:  params.Call.GasLimit,
+		Recipient: params.Call.To(),
+		Amount:    params.Call.Amount.BigInt(),
+		Payload:   params.Call.Payload,
+		Csdb:      types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx),
+		ChainID:   chainIDEpoch,
+		TxHash:    &txHash,
+		Sender:    ethcmn.BytesToAddress(params.Call.From.Bytes()),
+	}
+	st.Csdb.Prepare(txHash, params.BlockHash, len(params.Txs))
+	// the call is executed with the current nonce of the sender, the same as eth_call
+	st.AccountNonce = st.Csdb.GetNonce(st.Sender)
+
+	return k.traceStateTransition(ctx, st, config, params.Config)
+}
+
+// payStateTransitionFee deducts the fee of the whole gas limit from the sender and increments its nonce, as
+// the ante handler does on chain
+func (k Keeper) payStateTransitionFee(st types.StateTransition) error {
+	csdb := st.Csdb
+	csdb.SubBalance(st.Sender, new(big.Int).Mul(st.Price, new(big.Int).SetUint64(st.GasLimit)))
+	csdb.SetNonce(st.Sender, csdb.GetNonce(st.Sender)+1)
+	return csdb.Finalise(true)
+}
+
+// replayStateTransition applies the state transition to the ctx and refunds the unused gas to the sender, as
+// the gas refund handler does on chain
+func (k Keeper) replayStateTransition(ctx sdk.Context, st types.StateTransition, config types.ChainConfig) {
+	gasMeter := sdk.NewInfiniteGasMeter()
+	// a failed tx only consumes gas, the same as on chain
+	_, _, _ = st.TransitionDb(ctx.WithGasMeter(gasMeter), config)
+
+	if gasUsed := gasMeter.GasConsumed(); gasUsed < st.GasLimit {
+		refund := new(big.Int).Mul(st.Price, new(big.Int).SetUint64(st.GasLimit-gasUsed))
+		csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+		csdb.AddBalance(st.Sender, refund)
+		_ = csdb.Finalise(true)
+	}
+}
+
+// traceStateTransition executes the state transition in simulate mode with the tracer attached and returns the
+// formatted trace result
+func (k Keeper) traceStateTransition(ctx sdk.Context, st types.StateTransition, config types.ChainConfig,
+	traceConfig types.TraceConfig) (json.RawMessage, error) {
+	tracer, release, err := traceConfig.NewTracer(vm.TxContext{
+		Origin:   st.Sender,
+		GasPrice: st.Price,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	defer release()
+
+	st.Simulate = true
+	st.Tracer = tracer
+
+	gasMeter := sdk.NewInfiniteGasMeter()
+	_, _, err = st.TransitionDb(ctx.WithGasMeter(gasMeter), config)
+
+	return types.FormatTraceResult(tracer, gasMeter.GasConsumed(), err != nil)
+}
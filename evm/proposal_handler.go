@@ -1,7 +1,10 @@
 package evm
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/okex/exchain/x/common"
 	"github.com/okex/exchain/x/evm/types"
 	govTypes "github.com/okex/exchain/x/gov/types"
@@ -15,6 +18,12 @@ func NewManageContractDeploymentWhitelistProposalHandler(k *Keeper) govTypes.Han
 			return handleManageContractDeploymentWhitelistProposal(ctx, k, proposal)
 		case types.ManageContractBlockedListProposal:
 			return handleManageContractBlockedlListProposal(ctx, k, proposal)
+		case types.ManageNativeTokenMappingProposal:
+			return handleManageNativeTokenMappingProposal(ctx, k, proposal)
+		case types.ManageContractMethodBlockedListProposal:
+			return handleManageContractMethodBlockedlListProposal(ctx, k, proposal)
+		case types.ContractPatchProposal:
+			return handleContractPatchProposal(ctx, k, proposal)
 		default:
 			return common.ErrUnknownProposalType(types.DefaultCodespace, content.ProposalType())
 		}
@@ -58,3 +67,97 @@ func handleManageContractBlockedlListProposal(ctx sdk.Context, k *Keeper, propos
 	csdb.DeleteContractBlockedList(manageContractBlockedListProposal.ContractAddrs)
 	return nil
 }
+
+func handleManageContractMethodBlockedlListProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	// check
+	manageContractMethodBlockedListProposal, ok := proposal.Content.(types.ManageContractMethodBlockedListProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType
+	}
+
+	csdb := types.CreateEmptyCommitStateDB(k.GeneratePureCSDBParams(), ctx)
+	if manageContractMethodBlockedListProposal.IsAdded {
+		// add contract methods into method blocked list
+		csdb.SetContractMethodBlockedList(manageContractMethodBlockedListProposal.ContractList)
+		return nil
+	}
+
+	// remove contract methods from method blocked list
+	csdb.DeleteContractMethodBlockedList(manageContractMethodBlockedListProposal.ContractList)
+	return nil
+}
+
+func handleManageNativeTokenMappingProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	// check
+	manageNativeTokenMappingProposal, ok := proposal.Content.(types.ManageNativeTokenMappingProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType
+	}
+
+	csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+	if manageNativeTokenMappingProposal.IsAdded {
+		// the tokens are checked again, as they might have been changed during the voting period
+		if err := k.CheckNativeTokens(ctx, manageNativeTokenMappingProposal.Symbols); err != nil {
+			return err
+		}
+
+		// map native tokens into the evm and deploy their contracts
+		csdb.SetNativeTokens(manageNativeTokenMappingProposal.Symbols)
+		if _, err := csdb.Commit(false); err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
+		return nil
+	}
+
+	// unmap native tokens from the evm
+	csdb.DeleteNativeTokens(manageNativeTokenMappingProposal.Symbols)
+	return nil
+}
+
+func handleContractPatchProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	// check
+	contractPatchProposal, ok := proposal.Content.(types.ContractPatchProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType
+	}
+
+	csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+	for _, patch := range contractPatchProposal.Patches {
+		if err := csdb.ApplyContractPatch(patch); err != nil {
+			return types.ErrInvalidContractPatch(patch.Address, err.Error())
+		}
+	}
+	if _, err := csdb.Commit(false); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+
+	// the balances are patched once the state objects are committed, so that they aren't overwritten by the cache
+	for _, patch := range contractPatchProposal.Patches {
+		balance, err := patch.GetBalance()
+		if err != nil {
+			return types.ErrInvalidContractPatch(patch.Address, err.Error())
+		}
+		if balance != nil {
+			if err := k.PatchBalance(ctx, patch.Address, *balance); err != nil {
+				return err
+			}
+		}
+	}
+
+	// record the patches for auditing
+	for _, patch := range contractPatchProposal.Patches {
+		csdb.SetContractPatchRecord(proposal.ProposalID, patch)
+
+		contract := ethcmn.BytesToAddress(patch.Address)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeContractPatch,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ProposalID, 10)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, csdb.GetCodeHash(contract).Hex()),
+			sdk.NewAttribute(types.AttributeKeyStorageSlots, strconv.Itoa(len(patch.Storage))),
+			sdk.NewAttribute(types.AttributeKeyBalance, patch.Balance),
+		))
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package evm_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/okex/exchain/x/evm"
 	"github.com/okex/exchain/x/evm/types"
@@ -170,3 +172,88 @@ func (suite *EvmTestSuite) TestProposalHandler_ManageContractBlockedListProposal
 		})
 	}
 }
+
+func (suite *EvmTestSuite) TestProposalHandler_ContractPatchProposal() {
+	contract := ethcmn.BytesToAddress([]byte("contract"))
+	suite.stateDB.SetCode(contract, []byte{0x60, 0x00})
+	_, err := suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	key, value := ethcmn.BytesToHash([]byte{0x1}), ethcmn.BytesToHash([]byte("owner"))
+	patch := types.ContractPatch{
+		Address: contract.Bytes(),
+		Code:    "0x6001",
+		Storage: types.Storage{types.NewState(key, value)},
+	}
+	proposal := types.NewContractPatchProposal("default title", "default description", types.ContractPatches{patch})
+	suite.Require().NoError(proposal.ValidateBasic())
+
+	suite.govHandler = evm.NewManageContractDeploymentWhitelistProposalHandler(suite.app.EvmKeeper)
+	govProposal := govtypes.Proposal{
+		Content:    proposal,
+		ProposalID: 7,
+	}
+	suite.Require().NoError(suite.govHandler(suite.ctx, &govProposal))
+
+	suite.Require().Equal([]byte{0x60, 0x01}, suite.app.EvmKeeper.GetCode(suite.ctx, contract))
+	suite.Require().Equal(value, suite.app.EvmKeeper.GetState(suite.ctx, contract, key))
+
+	records := suite.stateDB.GetContractPatchRecords(contract.Bytes())
+	suite.Require().Equal([]types.ContractPatchRecord{{ProposalID: 7, Height: suite.ctx.BlockHeight(), Patch: patch}}, records)
+	suite.Require().Len(suite.stateDB.GetContractPatchRecords(nil), 1)
+
+	var found bool
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeContractPatch {
+			found = true
+		}
+	}
+	suite.Require().True(found)
+}
+
+func (suite *EvmTestSuite) TestProposalHandler_ContractPatchBalance() {
+	contract := ethcmn.BytesToAddress([]byte("contract"))
+	suite.stateDB.SetCode(contract, []byte{0x60, 0x00})
+	suite.stateDB.SetBalance(contract, sdk.NewDec(2).BigInt())
+	_, err := suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	suite.govHandler = evm.NewManageContractDeploymentWhitelistProposalHandler(suite.app.EvmKeeper)
+	moduleAddr := supply.NewModuleAddress(types.ModuleName)
+	totalSupply := suite.app.SupplyKeeper.GetSupply(suite.ctx).GetTotal()
+
+	testCases := []struct {
+		msg           string
+		balance       string
+		expPass       bool
+		expBalance    string
+		expModuleFund string
+	}{
+		{"decrease the balance into the module account", "1.5", true, "1.5", "0.5"},
+		{"increase the balance from the module account", "1.8", true, "1.8", "0.2"},
+		{"increase the balance beyond the module account", "3", false, "1.8", "0.2"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			patch := types.ContractPatch{Address: contract.Bytes(), Balance: tc.balance}
+			proposal := types.NewContractPatchProposal("default title", "default description", types.ContractPatches{patch})
+			suite.Require().NoError(proposal.ValidateBasic())
+
+			err := suite.govHandler(suite.ctx, &govtypes.Proposal{Content: proposal, ProposalID: 8})
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			balance := suite.app.EvmKeeper.GetBalance(suite.ctx, contract)
+			suite.Require().Equal(0, balance.Cmp(sdk.MustNewDecFromStr(tc.expBalance).BigInt()))
+			moduleAcc := suite.app.AccountKeeper.GetAccount(suite.ctx, moduleAddr)
+			suite.Require().NotNil(moduleAcc)
+			suite.Require().Equal(sdk.MustNewDecFromStr(tc.expModuleFund), moduleAcc.GetCoins().AmountOf(sdk.DefaultBondDenom))
+			// the total supply is kept by the balance patches
+			suite.Require().Equal(totalSupply, suite.app.SupplyKeeper.GetSupply(suite.ctx).GetTotal())
+		})
+	}
+}
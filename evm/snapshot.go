@@ -0,0 +1,384 @@
+package evm
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/spf13/viper"
+)
+
+// ************************************************************************************************************
+// the snapshot mode exports EVM code and storage as a single stream of items, which is cut into chunks:
+//    manifest.json           records the version, height, app hash and the hash and size of each chunk
+//    chunks/000000.chunk     a sequence of the items, none of which is split across chunks
+//
+// When importing, the height and app hash of the manifest are checked against the ones expected by the node operator,
+// then each chunk is verified against the manifest before its items are staged into the leveldb of the db mode. The
+// progress is recorded along with the items staged, so that an interrupted import resumes from the chunk following the
+// last one staged. The state is only applied once all the chunks are verified and staged, and the counts of the code
+// and storage items staged match the manifest.
+// ************************************************************************************************************
+
+const (
+	snapshotMode = "snapshot"
+
+	// FlagSnapshotChunkSize defines the size in bytes of the chunks of the snapshot
+	FlagSnapshotChunkSize = "evm-snapshot-chunk-size"
+	// FlagSnapshotHeight defines the height of the snapshot expected to be imported
+	FlagSnapshotHeight = "evm-snapshot-height"
+	// FlagSnapshotAppHash defines the hex encoded app hash of the snapshot expected to be imported
+	FlagSnapshotAppHash = "evm-snapshot-app-hash"
+
+	snapshotVersion          = 1
+	defaultSnapshotChunkSize = 64 * 1024 * 1024
+	snapshotManifestFile     = "manifest.json"
+	snapshotChunkSubPath     = "chunks"
+	snapshotChunkSuffix      = ".chunk"
+
+	// item = type | payload
+	// code item payload = uvarint(len(code)) | code
+	// storage item payload = address | key | value
+	snapshotItemCode    byte = 0x01
+	snapshotItemStorage byte = 0x02
+)
+
+var (
+	evmSnapshotWriter *snapshotWriter
+
+	// keySnapshotProgress is the key of the import progress in the staging state db
+	keySnapshotProgress = []byte("snapshot_progress")
+)
+
+// snapshotManifest describes the snapshot and its chunks
+type snapshotManifest struct {
+	Version      uint32          `json:"version"`
+	Height       int64           `json:"height"`
+	AppHash      hexutil.Bytes   `json:"app_hash"`
+	CodeCount    uint64          `json:"code_count"`
+	StorageCount uint64          `json:"storage_count"`
+	Chunks       []snapshotChunk `json:"chunks"`
+	// Hash is the hash of all the fields above, which identifies the snapshot
+	Hash hexutil.Bytes `json:"hash"`
+}
+
+// snapshotChunk is the hash and size of a chunk
+type snapshotChunk struct {
+	Hash hexutil.Bytes `json:"hash"`
+	Size int64         `json:"size"`
+}
+
+// computeHash computes the hash of the manifest
+func (m snapshotManifest) computeHash() []byte {
+	hasher := sha256.New()
+	writeUint64 := func(n uint64) {
+		var bz [8]byte
+		binary.BigEndian.PutUint64(bz[:], n)
+		hasher.Write(bz[:])
+	}
+
+	writeUint64(uint64(m.Version))
+	writeUint64(uint64(m.Height))
+	writeUint64(uint64(len(m.AppHash)))
+	hasher.Write(m.AppHash)
+	writeUint64(m.CodeCount)
+	writeUint64(m.StorageCount)
+	writeUint64(uint64(len(m.Chunks)))
+	for _, chunk := range m.Chunks {
+		hasher.Write(chunk.Hash)
+		writeUint64(uint64(chunk.Size))
+	}
+	return hasher.Sum(nil)
+}
+
+func snapshotChunkPath(dir string, index int) string {
+	return filepath.Join(dir, snapshotChunkSubPath, fmt.Sprintf("%06d%s", index, snapshotChunkSuffix))
+}
+
+// snapshotWriter writes the items into the chunks in order
+type snapshotWriter struct {
+	dir        string
+	chunkSize  int64
+	manifest   snapshotManifest
+	codeHashes map[ethcmn.Hash]struct{}
+
+	file   *os.File
+	writer *bufio.Writer
+	hasher hash.Hash
+	size   int64
+}
+
+// newSnapshotWriter creates a snapshot writer, removing the manifest and chunks of the stale snapshot in the path
+func newSnapshotWriter(dir string) *snapshotWriter {
+	if err := os.Remove(filepath.Join(dir, snapshotManifestFile)); err != nil && !os.IsNotExist(err) {
+		panic(err)
+	}
+	if err := os.RemoveAll(filepath.Join(dir, snapshotChunkSubPath)); err != nil {
+		panic(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, snapshotChunkSubPath), 0777); err != nil {
+		panic(err)
+	}
+
+	chunkSize := viper.GetInt64(FlagSnapshotChunkSize)
+	if chunkSize <= 0 {
+		chunkSize = defaultSnapshotChunkSize
+	}
+	return &snapshotWriter{
+		dir:        dir,
+		chunkSize:  chunkSize,
+		manifest:   snapshotManifest{Version: snapshotVersion},
+		codeHashes: make(map[ethcmn.Hash]struct{}),
+	}
+}
+
+// writeAccount writes the code and storage of the account. The code shared by several accounts is only written once
+func (w *snapshotWriter) writeAccount(ctx sdk.Context, k Keeper, address ethcmn.Address) {
+	if code := k.GetCode(ctx, address); len(code) != 0 {
+		codeHash := ethcrypto.Keccak256Hash(code)
+		if _, ok := w.codeHashes[codeHash]; !ok {
+			w.codeHashes[codeHash] = struct{}{}
+			w.writeCode(code)
+		}
+	}
+
+	err := k.ForEachStorage(ctx, address, func(key, value ethcmn.Hash) bool {
+		w.writeStorage(address, key, value)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (w *snapshotWriter) writeCode(code []byte) {
+	item := make([]byte, 1+binary.MaxVarintLen64+len(code))
+	item[0] = snapshotItemCode
+	n := binary.PutUvarint(item[1:], uint64(len(code)))
+	n += copy(item[1+n:], code)
+	w.writeItem(item[:1+n])
+	w.manifest.CodeCount++
+	codeCount++
+}
+
+func (w *snapshotWriter) writeStorage(address ethcmn.Address, key, value ethcmn.Hash) {
+	item := make([]byte, 0, 1+ethcmn.AddressLength+2*ethcmn.HashLength)
+	item = append(item, snapshotItemStorage)
+	item = append(item, address.Bytes()...)
+	item = append(item, key.Bytes()...)
+	item = append(item, value.Bytes()...)
+	w.writeItem(item)
+	w.manifest.StorageCount++
+	storageCount++
+}
+
+// writeItem writes the item into the current chunk, a new chunk being started once the current one is full
+func (w *snapshotWriter) writeItem(item []byte) {
+	if w.file != nil && w.size >= w.chunkSize {
+		w.closeChunk()
+	}
+	if w.file == nil {
+		w.file = createFile(snapshotChunkPath(w.dir, len(w.manifest.Chunks)))
+		w.writer = bufio.NewWriter(w.file)
+		w.hasher = sha256.New()
+		w.size = 0
+	}
+
+	if _, err := io.MultiWriter(w.writer, w.hasher).Write(item); err != nil {
+		panic(err)
+	}
+	w.size += int64(len(item))
+}
+
+func (w *snapshotWriter) closeChunk() {
+	closeFile(w.writer, w.file)
+	w.manifest.Chunks = append(w.manifest.Chunks, snapshotChunk{Hash: w.hasher.Sum(nil), Size: w.size})
+	w.file, w.writer, w.hasher = nil, nil, nil
+}
+
+// finish closes the last chunk and writes the manifest. The manifest is written at last, so a partial snapshot
+// without it is never imported
+func (w *snapshotWriter) finish(height int64, appHash []byte) {
+	if w.file != nil {
+		w.closeChunk()
+	}
+	w.manifest.Height = height
+	w.manifest.AppHash = appHash
+	w.manifest.Hash = w.manifest.computeHash()
+
+	bz, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	tmpPath := filepath.Join(w.dir, snapshotManifestFile+".tmp")
+	if err := ioutil.WriteFile(tmpPath, bz, 0644); err != nil {
+		panic(err)
+	}
+	if err := os.Rename(tmpPath, filepath.Join(w.dir, snapshotManifestFile)); err != nil {
+		panic(err)
+	}
+}
+
+// loadSnapshotManifest loads the manifest of the snapshot and verifies its hash
+func loadSnapshotManifest(dir string) snapshotManifest {
+	bz, err := ioutil.ReadFile(filepath.Join(dir, snapshotManifestFile))
+	if err != nil {
+		panic(fmt.Errorf("failed to read the snapshot manifest: %s", err))
+	}
+
+	var manifest snapshotManifest
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		panic(fmt.Errorf("invalid snapshot manifest: %s", err))
+	}
+	if manifest.Version != snapshotVersion {
+		panic(fmt.Errorf("unsupported snapshot version %d", manifest.Version))
+	}
+	if !bytes.Equal(manifest.Hash, manifest.computeHash()) {
+		panic(fmt.Errorf("snapshot manifest hash mismatch"))
+	}
+	return manifest
+}
+
+// validate checks that the manifest is the one of the snapshot expected at the height with the app hash
+func (m snapshotManifest) validate(height int64, appHash string) error {
+	if height <= 0 || len(appHash) == 0 {
+		return fmt.Errorf("the height and app hash of the snapshot must be specified by --%s and --%s",
+			FlagSnapshotHeight, FlagSnapshotAppHash)
+	}
+	expectedAppHash, err := hex.DecodeString(strings.TrimPrefix(appHash, "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid app hash %s of the snapshot: %s", appHash, err)
+	}
+
+	if m.Height != height {
+		return fmt.Errorf("snapshot height mismatch: %d vs %d", m.Height, height)
+	}
+	if !bytes.Equal(m.AppHash, expectedAppHash) {
+		return fmt.Errorf("snapshot app hash mismatch: %X vs %X", []byte(m.AppHash), expectedAppHash)
+	}
+	return nil
+}
+
+// snapshotProgress is the progress of the import, which is the index of the next chunk to be staged and the counts of
+// the items staged
+type snapshotProgress struct {
+	next         int
+	codeCount    uint64
+	storageCount uint64
+}
+
+// stageSnapshot verifies the chunks of the snapshot and stages their items into the leveldb of the db mode one by one.
+// It resumes from the chunk following the last one staged by the interrupted import of the same snapshot
+func stageSnapshot(dir string) snapshotManifest {
+	manifest := loadSnapshotManifest(dir)
+	if err := manifest.validate(viper.GetInt64(FlagSnapshotHeight), viper.GetString(FlagSnapshotAppHash)); err != nil {
+		panic(err)
+	}
+
+	progress := getSnapshotProgress(manifest.Hash)
+	for i := progress.next; i < len(manifest.Chunks); i++ {
+		bz, err := ioutil.ReadFile(snapshotChunkPath(dir, i))
+		if err != nil {
+			panic(fmt.Errorf("failed to read snapshot chunk %d: %s", i, err))
+		}
+		chunk := manifest.Chunks[i]
+		if sum := sha256.Sum256(bz); int64(len(bz)) != chunk.Size || !bytes.Equal(sum[:], chunk.Hash) {
+			panic(fmt.Errorf("snapshot chunk %d is corrupted: size %d, hash %x, expected size %d, hash %s",
+				i, len(bz), sum, chunk.Size, chunk.Hash))
+		}
+
+		progress = stageSnapshotChunk(bz, manifest.Hash, progress)
+	}
+
+	if progress.codeCount != manifest.CodeCount || progress.storageCount != manifest.StorageCount {
+		panic(fmt.Errorf("snapshot items count mismatch: code %d, storage %d, expected code %d, storage %d",
+			progress.codeCount, progress.storageCount, manifest.CodeCount, manifest.StorageCount))
+	}
+	return manifest
+}
+
+// getSnapshotProgress returns the progress of the interrupted import of the snapshot
+func getSnapshotProgress(snapshotHash []byte) snapshotProgress {
+	bz, err := evmStateDB.Get(keySnapshotProgress)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return snapshotProgress{}
+	}
+	if len(bz) != len(snapshotHash)+24 || !bytes.Equal(bz[:len(snapshotHash)], snapshotHash) {
+		panic("the staging db contains another snapshot, please remove it before importing")
+	}
+	bz = bz[len(snapshotHash):]
+	return snapshotProgress{
+		next:         int(binary.BigEndian.Uint64(bz)),
+		codeCount:    binary.BigEndian.Uint64(bz[8:]),
+		storageCount: binary.BigEndian.Uint64(bz[16:]),
+	}
+}
+
+// stageSnapshotChunk stages the items of the chunk, recording the progress along with the storage. The code is staged
+// ahead, so the chunk interrupted is staged again idempotently.
+func stageSnapshotChunk(bz []byte, snapshotHash []byte, progress snapshotProgress) snapshotProgress {
+	codeBatch, stateBatch := evmByteCodeDB.NewBatch(), evmStateDB.NewBatch()
+	defer codeBatch.Close()
+	defer stateBatch.Close()
+
+	rd := bytes.NewReader(bz)
+	for rd.Len() != 0 {
+		itemType, _ := rd.ReadByte()
+		switch itemType {
+		case snapshotItemCode:
+			size, err := binary.ReadUvarint(rd)
+			if err != nil || size > uint64(rd.Len()) {
+				panic("invalid code item of snapshot")
+			}
+			code := make([]byte, size)
+			_, _ = io.ReadFull(rd, code)
+			codeBatch.Set(append(types.KeyPrefixCode, ethcrypto.Keccak256(code)...), code)
+			progress.codeCount++
+		case snapshotItemStorage:
+			payload := make([]byte, ethcmn.AddressLength+2*ethcmn.HashLength)
+			if _, err := io.ReadFull(rd, payload); err != nil {
+				panic("invalid storage item of snapshot")
+			}
+			address := ethcmn.BytesToAddress(payload[:ethcmn.AddressLength])
+			key := payload[ethcmn.AddressLength : ethcmn.AddressLength+ethcmn.HashLength]
+			stateBatch.Set(append(types.AddressStoragePrefix(address), key...), payload[ethcmn.AddressLength+ethcmn.HashLength:])
+			progress.storageCount++
+		default:
+			panic(fmt.Sprintf("unknown item type %d of snapshot", itemType))
+		}
+	}
+
+	progress.next++
+	bzProgress := make([]byte, len(snapshotHash)+24)
+	n := copy(bzProgress, snapshotHash)
+	binary.BigEndian.PutUint64(bzProgress[n:], uint64(progress.next))
+	binary.BigEndian.PutUint64(bzProgress[n+8:], progress.codeCount)
+	binary.BigEndian.PutUint64(bzProgress[n+16:], progress.storageCount)
+	stateBatch.Set(keySnapshotProgress, bzProgress)
+
+	if err := codeBatch.WriteSync(); err != nil {
+		panic(err)
+	}
+	if err := stateBatch.WriteSync(); err != nil {
+		panic(err)
+	}
+	return progress
+}
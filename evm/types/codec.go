@@ -16,6 +16,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ChainConfig{}, "ethermint/ChainConfig", nil)
 	cdc.RegisterConcrete(ManageContractDeploymentWhitelistProposal{}, "filechain/evm/ManageContractDeploymentWhitelistProposal", nil)
 	cdc.RegisterConcrete(ManageContractBlockedListProposal{}, "filechain/evm/ManageContractBlockedListProposal", nil)
+	cdc.RegisterConcrete(ManageNativeTokenMappingProposal{}, "filechain/evm/ManageNativeTokenMappingProposal", nil)
+	cdc.RegisterConcrete(ManageContractMethodBlockedListProposal{}, "filechain/evm/ManageContractMethodBlockedListProposal", nil)
+	cdc.RegisterConcrete(ContractPatchProposal{}, "filechain/evm/ContractPatchProposal", nil)
 }
 
 func init() {
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ContractMethod is a method of a contract identified by its 4 bytes selector
+type ContractMethod struct {
+	// Sign is the hex encoded selector of the method, e.g. 0x3ccfd60b
+	Sign string `json:"sign" yaml:"sign"`
+	// Extra is an optional readable description of the method, e.g. withdraw()
+	Extra string `json:"extra" yaml:"extra"`
+}
+
+// ValidateBasic validates the selector of the contract method
+func (cm ContractMethod) ValidateBasic() sdk.Error {
+	if _, err := cm.Selector(); err != nil {
+		return ErrInvalidContractMethod(cm.Sign)
+	}
+	return nil
+}
+
+// Selector returns the 4 bytes selector of the contract method
+func (cm ContractMethod) Selector() ([]byte, error) {
+	selector, err := hexutil.Decode(cm.Sign)
+	if err != nil {
+		return nil, err
+	}
+	if len(selector) != 4 {
+		return nil, fmt.Errorf("invalid length of method selector %s", cm.Sign)
+	}
+	return selector, nil
+}
+
+// String returns a human readable string representation of ContractMethod
+func (cm ContractMethod) String() string {
+	if len(cm.Extra) == 0 {
+		return cm.Sign
+	}
+	return fmt.Sprintf("%s(%s)", cm.Sign, cm.Extra)
+}
+
+// ContractMethods is the type alias for []ContractMethod
+type ContractMethods []ContractMethod
+
+// Contains checks whether the selector is one of the contract methods
+func (cms ContractMethods) Contains(selector []byte) bool {
+	for _, cm := range cms {
+		if sel, err := cm.Selector(); err == nil && bytes.Equal(sel, selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// merge returns the contract methods with the other ones added, the selectors being kept unique
+func (cms ContractMethods) merge(others ContractMethods) ContractMethods {
+	merged := append(ContractMethods{}, cms...)
+	for _, cm := range others {
+		if selector, err := cm.Selector(); err == nil && !merged.Contains(selector) {
+			merged = append(merged, cm)
+		}
+	}
+	return merged
+}
+
+// subtract returns the contract methods with the other ones removed
+func (cms ContractMethods) subtract(others ContractMethods) ContractMethods {
+	var remained ContractMethods
+	for _, cm := range cms {
+		if selector, err := cm.Selector(); err == nil && !others.Contains(selector) {
+			remained = append(remained, cm)
+		}
+	}
+	return remained
+}
+
+// ValidateBasic validates the contract methods
+func (cms ContractMethods) ValidateBasic() sdk.Error {
+	if len(cms) == 0 {
+		return ErrEmptyMethodList
+	}
+
+	filter := make(map[string]struct{}, len(cms))
+	for _, cm := range cms {
+		if err := cm.ValidateBasic(); err != nil {
+			return err
+		}
+		key := strings.ToLower(cm.Sign)
+		if _, ok := filter[key]; ok {
+			return ErrDuplicatedMethod
+		}
+		filter[key] = struct{}{}
+	}
+	return nil
+}
+
+// BlockedContract is a contract whose methods are blocked
+type BlockedContract struct {
+	Address      sdk.AccAddress  `json:"address" yaml:"address"`
+	BlockMethods ContractMethods `json:"block_methods" yaml:"block_methods"`
+}
+
+// NewBlockedContract creates a new instance of BlockedContract
+func NewBlockedContract(addr sdk.AccAddress, methods ContractMethods) BlockedContract {
+	return BlockedContract{Address: addr, BlockMethods: methods}
+}
+
+// String returns a human readable string representation of BlockedContract
+func (bc BlockedContract) String() string {
+	var b strings.Builder
+	b.WriteString(bc.Address.String())
+	b.WriteByte(':')
+	for _, cm := range bc.BlockMethods {
+		b.WriteByte(' ')
+		b.WriteString(cm.String())
+	}
+	return b.String()
+}
+
+// BlockedContractList is the type alias for []BlockedContract
+type BlockedContractList []BlockedContract
+
+// ValidateBasic validates the blocked contract list
+func (bcl BlockedContractList) ValidateBasic() sdk.Error {
+	if len(bcl) == 0 {
+		return ErrEmptyAddressList
+	}
+
+	addrs := make([]sdk.AccAddress, len(bcl))
+	for i, bc := range bcl {
+		if err := bc.BlockMethods.ValidateBasic(); err != nil {
+			return err
+		}
+		addrs[i] = bc.Address
+	}
+
+	if isAddrDuplicated(addrs) {
+		return ErrDuplicatedAddr
+	}
+	return nil
+}
+
+// String returns a human readable string representation of BlockedContractList
+func (bcl BlockedContractList) String() string {
+	var b strings.Builder
+	b.WriteString("Blocked Contract List:\n")
+	for i := 0; i < len(bcl); i++ {
+		b.WriteString(bcl[i].String())
+		b.WriteByte('\n')
+	}
+
+	return strings.TrimSpace(b.String())
+}
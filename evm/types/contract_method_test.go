@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func (suite *StateDBTestSuite) TestContractMethods_ValidateBasic() {
+	testCases := []struct {
+		name    string
+		methods types.ContractMethods
+		expPass bool
+	}{
+		{"valid", types.ContractMethods{{Sign: "0x3ccfd60b", Extra: "withdraw()"}, {Sign: "0xa9059cbb"}}, true},
+		{"empty", types.ContractMethods{}, false},
+		{"invalid hex", types.ContractMethods{{Sign: "3ccfd60b"}}, false},
+		{"invalid length", types.ContractMethods{{Sign: "0x3ccfd6"}}, false},
+		{"duplicated", types.ContractMethods{{Sign: "0x3ccfd60b"}, {Sign: "0x3CCFD60B"}}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.methods.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *StateDBTestSuite) TestContractMethodBlockedList() {
+	addr1 := sdk.AccAddress(ethcmn.BytesToAddress([]byte("contract1")).Bytes())
+	addr2 := sdk.AccAddress(ethcmn.BytesToAddress([]byte("contract2")).Bytes())
+	withdraw := types.ContractMethod{Sign: "0x3ccfd60b", Extra: "withdraw()"}
+	transfer := types.ContractMethod{Sign: "0xa9059cbb", Extra: "transfer(address,uint256)"}
+
+	suite.Require().False(suite.stateDB.HasContractMethodBlockedList())
+	suite.stateDB.SetContractMethodBlockedList(types.BlockedContractList{
+		types.NewBlockedContract(addr1, types.ContractMethods{withdraw}),
+		types.NewBlockedContract(addr2, types.ContractMethods{transfer}),
+	})
+	// the methods are merged into the existing ones
+	suite.stateDB.SetContractMethodBlockedList(types.BlockedContractList{
+		types.NewBlockedContract(addr1, types.ContractMethods{withdraw, transfer}),
+	})
+	suite.Require().True(suite.stateDB.HasContractMethodBlockedList())
+	suite.Require().Equal(types.ContractMethods{withdraw, transfer}, suite.stateDB.GetContractBlockedMethods(addr1))
+	suite.Require().Len(suite.stateDB.GetContractMethodBlockedList(), 2)
+	suite.Require().True(suite.stateDB.IsContractMethodBlocked(addr1, hexutil.MustDecode(withdraw.Sign)))
+	suite.Require().False(suite.stateDB.IsContractMethodBlocked(addr2, hexutil.MustDecode(withdraw.Sign)))
+	// a contract with blocked methods is not blocked as a whole
+	suite.Require().False(suite.stateDB.IsContractInBlockedList(addr1))
+
+	suite.stateDB.DeleteContractMethodBlockedList(types.BlockedContractList{
+		types.NewBlockedContract(addr1, types.ContractMethods{withdraw}),
+		types.NewBlockedContract(addr2, types.ContractMethods{transfer}),
+	})
+	suite.Require().Equal(
+		types.BlockedContractList{types.NewBlockedContract(addr1, types.ContractMethods{transfer})},
+		suite.stateDB.GetContractMethodBlockedList(),
+	)
+}
+
+func (suite *StateDBTestSuite) TestTransitionDb_ContractMethodBlocked() {
+	params := types.DefaultParams()
+	params.EnableCreate = true
+	params.EnableCall = true
+	params.EnableContractBlockedList = true
+	suite.stateDB.SetParams(params)
+
+	withdraw := hexutil.MustDecode("0x3ccfd60b")
+	target := ethcmn.BytesToAddress([]byte("target"))
+	// a contract calling withdraw() of the target:
+	// PUSH4 selector PUSH1 0xe0 SHL PUSH1 0 MSTORE CALL(GAS, target, 0, 0, 4, 0, 0) STOP
+	code := append([]byte{0x63}, withdraw...)
+	code = append(code, 0x60, 0xe0, 0x1b, 0x60, 0x00, 0x52, 0x60, 0x00, 0x60, 0x00, 0x60, 0x04, 0x60, 0x00, 0x60, 0x00, 0x73)
+	code = append(code, target.Bytes()...)
+	code = append(code, 0x5a, 0xf1, 0x00)
+	caller := ethcmn.BytesToAddress([]byte("caller"))
+	suite.stateDB.SetCode(caller, code)
+	_, err := suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	transition := func(to ethcmn.Address, payload []byte) error {
+		st := types.StateTransition{
+			Price:     big.NewInt(1),
+			GasLimit:  100000,
+			Recipient: &to,
+			Amount:    big.NewInt(0),
+			Payload:   payload,
+			ChainID:   big.NewInt(1),
+			Csdb:      types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx),
+			TxHash:    &ethcmn.Hash{},
+			Sender:    suite.address,
+			Simulate:  true,
+		}
+		_, _, err := st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+		return err
+	}
+
+	suite.Require().NoError(transition(target, withdraw))
+	suite.Require().NoError(transition(caller, nil))
+
+	suite.stateDB.SetContractMethodBlockedList(types.BlockedContractList{
+		types.NewBlockedContract(target.Bytes(), types.ContractMethods{{Sign: "0x3ccfd60b"}}),
+	})
+	// the method is blocked for both the transaction and the internal calls, while other methods are still allowed
+	suite.Require().Error(transition(target, withdraw))
+	suite.Require().NoError(transition(target, hexutil.MustDecode("0xa9059cbb")))
+	suite.Require().Error(transition(caller, nil))
+
+	// the check is skipped once the blocked list is disabled
+	params.EnableContractBlockedList = false
+	suite.stateDB.SetParams(params)
+	suite.Require().NoError(transition(caller, nil))
+}
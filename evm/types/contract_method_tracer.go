@@ -0,0 +1,98 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// blockedContractMethod is the panic raised by the evm execution when a blocked method of a contract is invoked, which
+// is captured by TransitionDb in the same way as the calling of a blocked contract
+type blockedContractMethod struct {
+	contract ethcmn.Address
+	selector []byte
+}
+
+var _ vm.Tracer = (*contractMethodBlockedTracer)(nil)
+
+// contractMethodBlockedTracer checks the internal calls of the evm against the contract method blocked list. The evm
+// offers no hook on the input of the internal calls other than a tracer, so it's only attached when any method is
+// blocked. The calls are checked right before the CALL, CALLCODE, DELEGATECALL and STATICCALL opcodes are executed,
+// when the memory holding the input has already been expanded. The optional inner tracer (i.e. debug_trace*
+// execution) receives all the events unchanged.
+type contractMethodBlockedTracer struct {
+	csdb  *CommitStateDB
+	inner vm.Tracer
+}
+
+func newContractMethodBlockedTracer(csdb *CommitStateDB, inner vm.Tracer) *contractMethodBlockedTracer {
+	return &contractMethodBlockedTracer{csdb: csdb, inner: inner}
+}
+
+// CaptureStart implements the vm.Tracer interface
+func (t *contractMethodBlockedTracer) CaptureStart(from ethcmn.Address, to ethcmn.Address, create bool, input []byte,
+	gas uint64, value *big.Int) error {
+	if t.inner != nil {
+		return t.inner.CaptureStart(from, to, create, input, gas, value)
+	}
+	return nil
+}
+
+// CaptureState implements the vm.Tracer interface
+func (t *contractMethodBlockedTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64,
+	memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, rData []byte, contract *vm.Contract, depth int, err error,
+) error {
+	if err == nil {
+		t.checkCall(op, memory, stack)
+	}
+
+	if t.inner != nil {
+		return t.inner.CaptureState(env, pc, op, gas, cost, memory, stack, rStack, rData, contract, depth, err)
+	}
+	return nil
+}
+
+// CaptureFault implements the vm.Tracer interface
+func (t *contractMethodBlockedTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64,
+	memory *vm.Memory, stack *vm.Stack, rStack *vm.ReturnStack, contract *vm.Contract, depth int, err error,
+) error {
+	if t.inner != nil {
+		return t.inner.CaptureFault(env, pc, op, gas, cost, memory, stack, rStack, contract, depth, err)
+	}
+	return nil
+}
+
+// CaptureEnd implements the vm.Tracer interface
+func (t *contractMethodBlockedTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if t.inner != nil {
+		return t.inner.CaptureEnd(output, gasUsed, d, err)
+	}
+	return nil
+}
+
+// checkCall panics if the call about to be made by the opcode invokes a blocked method
+func (t *contractMethodBlockedTracer) checkCall(op vm.OpCode, memory *vm.Memory, stack *vm.Stack) {
+	// the position of the input offset on the stack, which is followed by the input size
+	var argsPos int
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		argsPos = 3
+	case vm.DELEGATECALL, vm.STATICCALL:
+		argsPos = 2
+	default:
+		return
+	}
+
+	offset, size := stack.Back(argsPos), stack.Back(argsPos+1)
+	if !offset.IsUint64() || !size.IsUint64() || size.Uint64() < 4 || offset.Uint64()+4 > uint64(memory.Len()) {
+		return
+	}
+
+	contractAddr := ethcmn.Address(stack.Back(1).Bytes20())
+	selector := memory.GetCopy(int64(offset.Uint64()), 4)
+	if t.csdb.IsContractMethodBlocked(contractAddr.Bytes(), selector) {
+		panic(blockedContractMethod{contract: contractAddr, selector: selector})
+	}
+}
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ContractPatch is an emergency patch on the code, storage and balance of a contract
+type ContractPatch struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	// Code is the hex encoded code replacing the one of the contract, which is left untouched when it's empty. "0x"
+	// removes the code
+	Code string `json:"code" yaml:"code"`
+	// Storage is the storage slots of the contract to overwrite
+	Storage Storage `json:"storage" yaml:"storage"`
+	// Balance is the decimal amount replacing the balance of the contract, which is left untouched when it's empty
+	Balance string `json:"balance" yaml:"balance"`
+}
+
+// ValidateBasic validates the contract patch
+func (cp ContractPatch) ValidateBasic() sdk.Error {
+	if len(cp.Address) != ethcmn.AddressLength {
+		return ErrInvalidContractPatch(cp.Address, "invalid contract address")
+	}
+	if len(cp.Code) == 0 && len(cp.Storage) == 0 && len(cp.Balance) == 0 {
+		return ErrInvalidContractPatch(cp.Address, "nothing to patch")
+	}
+
+	if _, err := cp.GetCode(); err != nil {
+		return ErrInvalidContractPatch(cp.Address, err.Error())
+	}
+	if err := cp.Storage.Validate(); err != nil {
+		return ErrInvalidContractPatch(cp.Address, err.Error())
+	}
+	if _, err := cp.GetBalance(); err != nil {
+		return ErrInvalidContractPatch(cp.Address, err.Error())
+	}
+	return nil
+}
+
+// GetCode returns the decoded code of the patch, which is nil if the code is not patched
+func (cp ContractPatch) GetCode() ([]byte, error) {
+	if len(cp.Code) == 0 {
+		return nil, nil
+	}
+	code, err := hexutil.Decode(cp.Code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid code %s", err)
+	}
+	return code, nil
+}
+
+// GetBalance returns the balance of the patch, which is nil if the balance is not patched
+func (cp ContractPatch) GetBalance() (*sdk.Dec, error) {
+	if len(cp.Balance) == 0 {
+		return nil, nil
+	}
+	balance, err := sdk.NewDecFromStr(cp.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("invalid balance %s", err)
+	}
+	if balance.IsNegative() {
+		return nil, fmt.Errorf("negative balance %s", cp.Balance)
+	}
+	return &balance, nil
+}
+
+// String returns a human readable string representation of ContractPatch
+func (cp ContractPatch) String() string {
+	var b strings.Builder
+	b.WriteString(cp.Address.String())
+	b.WriteByte(':')
+	if len(cp.Code) != 0 {
+		b.WriteString(fmt.Sprintf(" code(%d bytes)", (len(cp.Code)-2)/2))
+	}
+	if len(cp.Storage) != 0 {
+		b.WriteString(fmt.Sprintf(" storage(%d slots)", len(cp.Storage)))
+	}
+	if len(cp.Balance) != 0 {
+		b.WriteString(fmt.Sprintf(" balance(%s)", cp.Balance))
+	}
+	return b.String()
+}
+
+// ContractPatches is the type alias for []ContractPatch
+type ContractPatches []ContractPatch
+
+// ValidateBasic validates the contract patches
+func (cps ContractPatches) ValidateBasic() sdk.Error {
+	if len(cps) == 0 {
+		return ErrEmptyContractPatchList
+	}
+
+	addrs := make([]sdk.AccAddress, len(cps))
+	for i, cp := range cps {
+		if err := cp.ValidateBasic(); err != nil {
+			return err
+		}
+		addrs[i] = cp.Address
+	}
+
+	if isAddrDuplicated(addrs) {
+		return ErrDuplicatedAddr
+	}
+	return nil
+}
+
+// ContractPatchRecord is the record of a contract patch applied by a proposal
+type ContractPatchRecord struct {
+	ProposalID uint64        `json:"proposal_id" yaml:"proposal_id"`
+	Height     int64         `json:"height" yaml:"height"`
+	Patch      ContractPatch `json:"patch" yaml:"patch"`
+}
+
+// GetBalance returns the balance of the patch, which is nil if the balance is not patched
+func (cp ContractPatch) GetBalance() (*sdk.Dec, error) {
+	if len(cp.Balance) == 0 {
+		return nil, nil
+	}
+	balance, err := sdk.NewDecFromStr(cp.Balance)
+	if err != nil {
+		return nil, fmt.Errorf("invalid balance %s", err)
+	}
+	if balance.IsNegative() {
+		return nil, fmt.Errorf("negative balance %s", cp.Balance)
+	}
+	return &balance, nil
+}
+
+// String returns a human readable string representation of ContractPatchRecord
+func (cpr ContractPatchRecord) String() string {
+	return fmt.Sprintf("proposal %d at height %d, %s", cpr.ProposalID, cpr.Height, cpr.Patch.String())
+}
+
+// ApplyContractPatch patches the code and storage of the contract. The state objects are only updated in
+// the cache, so it's the duty of the caller to commit them. The balance isn't patched by the state db, it's moved
+// through the supply keeper by the keeper, so that the total supply is kept.
+func (csdb *CommitStateDB) ApplyContractPatch(patch ContractPatch) error {
+	contract := ethcmn.BytesToAddress(patch.Address)
+
+	code, err := patch.GetCode()
+	if err != nil {
+		return err
+	}
+	if code != nil {
+		csdb.SetCode(contract, code)
+	}
+
+	for _, state := range patch.Storage {
+		csdb.SetState(contract, state.Key, state.Value)
+	}
+	return nil
+}
+
+// SetContractPatchRecord sets the record of a contract patch applied by the proposal into db
+func (csdb *CommitStateDB) SetContractPatchRecord(proposalID uint64, patch ContractPatch) {
+	record := ContractPatchRecord{
+		ProposalID: proposalID,
+		Height:     csdb.ctx.BlockHeight(),
+		Patch:      patch,
+	}
+	csdb.SetContractPatchRecords([]ContractPatchRecord{record})
+}
+
+// SetContractPatchRecords sets the records of contract patches into db as they are, which is used by genesis import
+func (csdb *CommitStateDB) SetContractPatchRecords(records []ContractPatchRecord) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for _, record := range records {
+		store.Set(getContractPatchKey(record.Patch.Address, record.ProposalID),
+			ModuleCdc.MustMarshalBinaryLengthPrefixed(record))
+	}
+}
+
+// GetContractPatchRecords gets the records of the patches applied to the contract in the order of proposal id. When
+// the contract address is empty, the records of all the contracts are returned ordered by contract address first and
+// then by proposal id
+func (csdb *CommitStateDB) GetContractPatchRecords(contractAddr sdk.AccAddress) (records []ContractPatchRecord) {
+	iterator := sdk.KVStorePrefixIterator(csdb.ctx.KVStore(csdb.storeKey), getContractPatchPrefix(contractAddr))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record ContractPatchRecord
+		ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // NOTE: We can't use 1 since that error code is reserved for internal errors.
@@ -44,6 +45,21 @@ var (
 	// ErrDuplicatedAddr returns an error if the address is duplicated in address list
 	ErrDuplicatedAddr = sdkerrors.Register(ModuleName, 12, "Duplicated address in address list")
 
+	// ErrEmptyTokenList returns an error if the token symbol list is empty
+	ErrEmptyTokenList = sdkerrors.Register(ModuleName, 16, "Empty token symbol list")
+
+	// ErrDuplicatedToken returns an error if the token symbol is duplicated in token symbol list
+	ErrDuplicatedToken = sdkerrors.Register(ModuleName, 17, "Duplicated token symbol in token symbol list")
+
+	// ErrEmptyMethodList returns an error if the contract method list is empty
+	ErrEmptyMethodList = sdkerrors.Register(ModuleName, 20, "Empty contract method list")
+
+	// ErrDuplicatedMethod returns an error if the method is duplicated in contract method list
+	ErrDuplicatedMethod = sdkerrors.Register(ModuleName, 21, "Duplicated method in contract method list")
+
+	// ErrEmptyContractPatchList returns an error if the contract patch list is empty
+	ErrEmptyContractPatchList = sdkerrors.Register(ModuleName, 24, "Empty contract patch list")
+
 	CodeSpaceEvmCallFailed = uint32(7)
 
 	ErrorHexData = "HexData"
@@ -79,3 +95,59 @@ func ErrCallBlockedContract(contractAddr ethcmn.Address) sdk.EnvelopedErr {
 		),
 	}
 }
+
+// ErrInvalidNativeToken returns an error when the token can not be mapped into the evm
+func ErrInvalidNativeToken(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{
+		Err: sdkerrors.New(
+			DefaultParamspace,
+			18,
+			fmt.Sprintf("failed. the token %s can not be mapped into the evm", symbol),
+		),
+	}
+}
+
+// ErrOversizeTokenList returns an error when the length of token symbol list in the proposal is larger than the max
+// limitation
+func ErrOversizeTokenList(length int) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{
+		Err: sdkerrors.New(
+			DefaultParamspace,
+			19,
+			fmt.Sprintf("failed. the length of token symbol list in the proposal %d is larger than the max limitation %d",
+				length, maxAddressListLength,
+			))}
+}
+
+// ErrInvalidContractMethod returns an error when the selector of a contract method is invalid
+func ErrInvalidContractMethod(sign string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{
+		Err: sdkerrors.New(
+			DefaultParamspace,
+			22,
+			fmt.Sprintf("failed. invalid contract method selector %s, 4 bytes in hex is expected", sign),
+		),
+	}
+}
+
+// ErrCallBlockedContractMethod returns an error when the blocked method of a contract is invoked
+func ErrCallBlockedContractMethod(contractAddr ethcmn.Address, selector []byte) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{
+		Err: sdkerrors.New(
+			DefaultParamspace,
+			23,
+			fmt.Sprintf("failed. the method %s of contract %s is not allowed to invoke", hexutil.Encode(selector), contractAddr.Hex()),
+		),
+	}
+}
+
+// ErrInvalidContractPatch returns an error when the patch of a contract is invalid
+func ErrInvalidContractPatch(contractAddr sdk.AccAddress, reason string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{
+		Err: sdkerrors.New(
+			DefaultParamspace,
+			25,
+			fmt.Sprintf("failed. invalid patch of contract %s: %s", ethcmn.BytesToAddress(contractAddr).Hex(), reason),
+		),
+	}
+}
@@ -2,10 +2,15 @@ package types
 
 // Evm module events
 const (
-	EventTypeEthermint  = TypeMsgEthermint
-	EventTypeEthereumTx = TypeMsgEthereumTx
+	EventTypeEthermint     = TypeMsgEthermint
+	EventTypeEthereumTx    = TypeMsgEthereumTx
+	EventTypeContractPatch = "contract_patch"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
+	AttributeKeyProposalID      = "proposal_id"
+	AttributeKeyCodeHash        = "code_hash"
+	AttributeKeyStorageSlots    = "storage_slots"
+	AttributeKeyBalance         = "balance"
 	AttributeValueCategory      = ModuleName
 )
@@ -17,4 +17,12 @@ type AccountKeeper interface {
 
 type SupplyKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// TokenKeeper defines the expected token keeper interface used by the native token contracts
+type TokenKeeper interface {
+	TokenExist(ctx sdk.Context, symbol string) bool
+	GetTokenTotalSupply(ctx sdk.Context, symbol string) sdk.Dec
+	SendCoinsFromEVM(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.SysCoins) error
 }
@@ -12,12 +12,15 @@ import (
 type (
 	// GenesisState defines the evm module genesis state
 	GenesisState struct {
-		Accounts                    []GenesisAccount  `json:"accounts"`
-		TxsLogs                     []TransactionLogs `json:"txs_logs"`
-		ContractDeploymentWhitelist AddressList       `json:"contract_deployment_whitelist"`
-		ContractBlockedList         AddressList       `json:"contract_blocked_list"`
-		ChainConfig                 ChainConfig       `json:"chain_config"`
-		Params                      Params            `json:"params"`
+		Accounts                    []GenesisAccount      `json:"accounts"`
+		TxsLogs                     []TransactionLogs     `json:"txs_logs"`
+		ContractDeploymentWhitelist AddressList           `json:"contract_deployment_whitelist"`
+		ContractBlockedList         AddressList           `json:"contract_blocked_list"`
+		ContractMethodBlockedList   BlockedContractList   `json:"contract_method_blocked_list"`
+		NativeTokens                []NativeToken         `json:"native_tokens"`
+		ContractPatchRecords        []ContractPatchRecord `json:"contract_patch_records"`
+		ChainConfig                 ChainConfig           `json:"chain_config"`
+		Params                      Params                `json:"params"`
 	}
 
 	// GenesisAccount defines an account to be initialized in the genesis state.
@@ -88,6 +91,9 @@ func DefaultGenesisState() GenesisState {
 		TxsLogs:                     []TransactionLogs{},
 		ContractDeploymentWhitelist: AddressList{},
 		ContractBlockedList:         AddressList{},
+		ContractMethodBlockedList:   BlockedContractList{},
+		NativeTokens:                []NativeToken{},
+		ContractPatchRecords:        []ContractPatchRecord{},
 		ChainConfig:                 DefaultChainConfig(),
 		Params:                      DefaultParams(),
 	}
@@ -120,6 +126,35 @@ func (gs GenesisState) Validate() error {
 		seenTxs[tx.Hash.String()] = true
 	}
 
+	if len(gs.ContractMethodBlockedList) != 0 {
+		if err := gs.ContractMethodBlockedList.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	seenNativeTokens := make(map[string]bool)
+	for _, token := range gs.NativeTokens {
+		if seenNativeTokens[token.Symbol] {
+			return fmt.Errorf("duplicated native token %s", token.Symbol)
+		}
+		if token.ContractAddress != NativeTokenContractAddress(token.Symbol) {
+			return fmt.Errorf("invalid contract address %s of native token %s", token.ContractAddress.Hex(), token.Symbol)
+		}
+		seenNativeTokens[token.Symbol] = true
+	}
+
+	seenPatchRecords := make(map[string]bool)
+	for _, record := range gs.ContractPatchRecords {
+		key := string(getContractPatchKey(record.Patch.Address, record.ProposalID))
+		if seenPatchRecords[key] {
+			return fmt.Errorf("duplicated patch record of contract %s by proposal %d", record.Patch.Address, record.ProposalID)
+		}
+		if err := record.Patch.ValidateBasic(); err != nil {
+			return err
+		}
+		seenPatchRecords[key] = true
+	}
+
 	if err := gs.ChainConfig.Validate(); err != nil {
 		return err
 	}
@@ -192,6 +192,8 @@ type (
 		address *ethcmn.Address
 		slot    *ethcmn.Hash
 	}
+
+	nativeTransferChange struct{}
 )
 
 func (ch createObjectChange) revert(s *CommitStateDB) {
@@ -372,3 +374,11 @@ func (ch accessListAddSlotChange) revert(s *CommitStateDB) {
 func (ch accessListAddSlotChange) dirtied() *ethcmn.Address {
 	return nil
 }
+
+func (ch nativeTransferChange) revert(s *CommitStateDB) {
+	s.nativeTransfers = s.nativeTransfers[:len(s.nativeTransfers)-1]
+}
+
+func (ch nativeTransferChange) dirtied() *ethcmn.Address {
+	return nil
+}
@@ -28,6 +28,10 @@ var (
 	KeyPrefixHeightHash                  = []byte{0x07}
 	KeyPrefixContractDeploymentWhitelist = []byte{0x08}
 	KeyPrefixContractBlockedList         = []byte{0x09}
+	KeyPrefixNativeToken                 = []byte{0x0A}
+	KeyPrefixNativeTokenContract         = []byte{0x0B}
+	KeyPrefixContractMethodBlockedList   = []byte{0x0C}
+	KeyPrefixContractPatch               = []byte{0x0D}
 )
 
 // HeightHashKey returns the key for the given chain epoch and height.
@@ -67,4 +71,29 @@ func getContractBlockedListMemberKey(contractAddr sdk.AccAddress) []byte {
 // splitBlockedContractAddress splits the blocked contract address from a ContractBlockedListMemberKey
 func splitBlockedContractAddress(key []byte) sdk.AccAddress {
 	return key[1:]
-}
\ No newline at end of file
+}
+
+// getContractMethodBlockedListMemberKey builds the key for a contract with blocked methods
+func getContractMethodBlockedListMemberKey(contractAddr sdk.AccAddress) []byte {
+	return append(KeyPrefixContractMethodBlockedList, contractAddr...)
+}
+
+// getNativeTokenKey builds the key for the contract address of a native token
+func getNativeTokenKey(symbol string) []byte {
+	return append(KeyPrefixNativeToken, []byte(symbol)...)
+}
+
+// getNativeTokenContractKey builds the key for the native token symbol of a contract address
+func getNativeTokenContractKey(contractAddr ethcmn.Address) []byte {
+	return append(KeyPrefixNativeTokenContract, contractAddr.Bytes()...)
+}
+
+// getContractPatchKey builds the key for the patch record of a contract applied by a proposal
+func getContractPatchKey(contractAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(getContractPatchPrefix(contractAddr), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// getContractPatchPrefix builds the prefix to iterate over the patch records of a contract
+func getContractPatchPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(KeyPrefixContractPatch, contractAddr...)
+}
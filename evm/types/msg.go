@@ -50,6 +50,9 @@ type MsgEthermint struct {
 
 	// From address (formerly derived from signature)
 	From sdk.AccAddress `json:"from"`
+
+	// StateOverrides is the JSON encoded StateOverride applied before the simulation of the call
+	StateOverrides []byte `json:"state_overrides,omitempty"`
 }
 
 // NewMsgEthermint returns a reference to a new Ethermint transaction
@@ -225,6 +228,45 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	return nil
 }
 
+// ValidateTypedTx checks the typed transaction values at the block height. None of them is accepted before
+// TypedTxHeight, so that the transactions are processed there exactly as they were before the typed transactions.
+// It's height dependent, so it's checked by the ante handler and the handler instead of ValidateBasic
+func (msg MsgEthereumTx) ValidateTypedTx(height int64) error {
+	if !IsTypedTxEnabled(height) {
+		if msg.Data.hasTypedValues() {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "typed transactions are not enabled until height %d", TypedTxHeight)
+		}
+		return nil
+	}
+
+	switch msg.Data.Type {
+	case LegacyTxType:
+		if msg.Data.hasTypedValues() {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "legacy transaction cannot carry typed transaction values")
+		}
+
+	case AccessListTxType:
+		if msg.Data.GasTipCap != nil || msg.Data.GasFeeCap != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "access list transaction cannot carry fee caps")
+		}
+
+	case DynamicFeeTxType:
+		if msg.Data.GasTipCap == nil || msg.Data.GasFeeCap == nil {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "fee caps of a dynamic fee transaction cannot be empty")
+		}
+
+		if msg.Data.GasTipCap.Cmp(msg.Data.GasFeeCap) > 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidValue, "max priority fee per gas %s higher than max fee per gas %s",
+				msg.Data.GasTipCap, msg.Data.GasFeeCap)
+		}
+
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidValue, "transaction type %d not supported", msg.Data.Type)
+	}
+
+	return nil
+}
+
 // To returns the recipient address of the transaction. It returns nil if the
 // transaction is a contract creation.
 func (msg MsgEthereumTx) To() *ethcmn.Address {
@@ -258,8 +300,13 @@ func (msg MsgEthereumTx) GetSignBytes() []byte {
 }
 
 // RLPSignBytes returns the RLP hash of an Ethereum transaction message with a
-// given chainID used for signing.
+// given chainID used for signing. The hash of a typed transaction is prefixed
+// with its type according to EIP-2718.
 func (msg MsgEthereumTx) RLPSignBytes(chainID *big.Int) ethcmn.Hash {
+	if msg.Data.Type != LegacyTxType {
+		return typedSigHash(msg.Data, chainID)
+	}
+
 	return rlpHash([]interface{}{
 		msg.Data.AccountNonce,
 		msg.Data.Price,
@@ -284,19 +331,37 @@ func (msg MsgEthereumTx) HomesteadSignHash() ethcmn.Hash {
 	})
 }
 
-// EncodeRLP implements the rlp.Encoder interface.
+// EncodeRLP implements the rlp.Encoder interface. A typed transaction is
+// encoded as an RLP string of its EIP-2718 envelope.
 func (msg *MsgEthereumTx) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &msg.Data)
+	if msg.Data.Type == LegacyTxType {
+		return rlp.Encode(w, &msg.Data)
+	}
+
+	envelope, err := encodeTypedTxData(msg.Data)
+	if err != nil {
+		return err
+	}
+	return rlp.Encode(w, envelope)
 }
 
 // DecodeRLP implements the rlp.Decoder interface.
 func (msg *MsgEthereumTx) DecodeRLP(s *rlp.Stream) error {
-	_, size, err := s.Kind()
+	kind, size, err := s.Kind()
 	if err != nil {
 		// return error if stream is too large
 		return err
 	}
 
+	if kind != rlp.List {
+		// typed transaction envelope wrapped in an RLP string
+		envelope, err := s.Bytes()
+		if err != nil {
+			return err
+		}
+		return msg.decodeTyped(envelope)
+	}
+
 	if err := s.Decode(&msg.Data); err != nil {
 		return err
 	}
@@ -305,11 +370,46 @@ func (msg *MsgEthereumTx) DecodeRLP(s *rlp.Stream) error {
 	return nil
 }
 
+// MarshalBinary returns the canonical encoding of the transaction, which is
+// the RLP encoding for legacy transactions and the EIP-2718 envelope for typed
+// transactions.
+func (msg *MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	if msg.Data.Type == LegacyTxType {
+		return rlp.EncodeToBytes(&msg.Data)
+	}
+	return encodeTypedTxData(msg.Data)
+}
+
+// UnmarshalBinary decodes the canonical encoding of both the legacy and the
+// typed transactions, i.e. the raw transaction sent by Ethereum wallets.
+func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] > 0x7f {
+		// an RLP list prefix, it's a legacy transaction
+		return rlp.DecodeBytes(b, msg)
+	}
+	return msg.decodeTyped(b)
+}
+
+func (msg *MsgEthereumTx) decodeTyped(envelope []byte) error {
+	data, err := decodeTypedTxData(envelope)
+	if err != nil {
+		return err
+	}
+
+	msg.Data = data
+	msg.size.Store(ethcmn.StorageSize(len(envelope)))
+	return nil
+}
+
 // Sign calculates a secp256k1 ECDSA signature and signs the transaction. It
 // takes a private key and chainID to sign an Ethereum transaction according to
 // EIP155 standard. It mutates the transaction as it populates the V, R, S
 // fields of the Transaction's Signature.
 func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
+	if msg.Data.Type != LegacyTxType {
+		// typed transactions carry their chain id explicitly
+		msg.Data.ChainID = new(big.Int).Set(chainID)
+	}
 	txHash := msg.RLPSignBytes(chainID)
 
 	sig, err := ethcrypto.Sign(txHash[:], priv)
@@ -326,7 +426,10 @@ func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
 
 	var v *big.Int
 
-	if chainID.Sign() == 0 {
+	if msg.Data.Type != LegacyTxType {
+		// the signature of a typed transaction has a y-parity as V
+		v = new(big.Int).SetBytes([]byte{sig[64]})
+	} else if chainID.Sign() == 0 {
 		v = new(big.Int).SetBytes([]byte{sig[64] + 27})
 	} else {
 		v = big.NewInt(int64(sig[64] + 35))
@@ -344,6 +447,10 @@ func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
 // VerifySig attempts to verify a Transaction's signature for a given chainID.
 // A derived address is returned upon success or an error if recovery fails.
 func (msg *MsgEthereumTx) VerifySig(chainID *big.Int) (ethcmn.Address, error) {
+	if msg.Data.Type != LegacyTxType {
+		return msg.verifyTypedSig(chainID)
+	}
+
 	var signer ethtypes.Signer
 	if isProtectedV(msg.Data.V) {
 		signer = ethtypes.NewEIP155Signer(chainID)
@@ -388,6 +495,37 @@ func (msg *MsgEthereumTx) VerifySig(chainID *big.Int) (ethcmn.Address, error) {
 	return sender, nil
 }
 
+// verifyTypedSig verifies the signature of a typed transaction, which must be
+// signed for the given chainID with a y-parity as V.
+func (msg *MsgEthereumTx) verifyTypedSig(chainID *big.Int) (ethcmn.Address, error) {
+	if msg.Data.ChainID == nil || msg.Data.ChainID.Cmp(chainID) != 0 {
+		return ethcmn.Address{}, fmt.Errorf("invalid chain id for signer: have %s want %s", msg.Data.ChainID, chainID)
+	}
+
+	// typed transactions are always replay protected, so the EIP155 signer
+	// identifies the chain id that the sender is recovered for
+	signer := ethtypes.NewEIP155Signer(chainID)
+	if sc := msg.from.Load(); sc != nil {
+		sigCache := sc.(sigCache)
+		if sigCache.signer.Equal(signer) {
+			return sigCache.from, nil
+		}
+	}
+
+	if msg.Data.V.BitLen() > 1 {
+		return ethcmn.Address{}, errors.New("invalid signature")
+	}
+
+	V := new(big.Int).Add(msg.Data.V, big.NewInt(27))
+	sender, err := recoverEthSig(msg.Data.R, msg.Data.S, V, msg.RLPSignBytes(chainID))
+	if err != nil {
+		return ethcmn.Address{}, err
+	}
+
+	msg.from.Store(sigCache{signer: signer, from: sender})
+	return sender, nil
+}
+
 // codes from go-ethereum/core/types/transaction.go:122
 func isProtectedV(V *big.Int) bool {
 	if V.BitLen() <= 8 {
@@ -410,6 +548,12 @@ func (msg MsgEthereumTx) Fee() *big.Int {
 
 // ChainID returns which chain id this transaction was signed for (if at all)
 func (msg *MsgEthereumTx) ChainID() *big.Int {
+	if msg.Data.Type != LegacyTxType {
+		if msg.Data.ChainID == nil {
+			return new(big.Int)
+		}
+		return new(big.Int).Set(msg.Data.ChainID)
+	}
 	return deriveChainID(msg.Data.V)
 }
 
@@ -0,0 +1,406 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// The tokens of the token module are exposed to the evm as ERC-20 contracts. Each mapped token gets a contract at a
+// fixed address, whose code only forwards the calldata together with its caller to the native token precompile:
+//
+//   CALLER PUSH1 0 MSTORE                                   ; mem[0:32] = msg.sender
+//   CALLDATASIZE PUSH1 0 PUSH1 32 CALLDATACOPY              ; mem[32:] = msg.data
+//   CALL(gas, precompile, 0, 0, 32+CALLDATASIZE, 0, 0)
+//   RETURNDATACOPY, then RETURN or REVERT the returndata
+//
+// The precompile serves the ERC-20 methods of the token of the calling contract. Since a precompile only receives its
+// input, the immediate caller is recorded into the CommitStateDB by the Transfer function of the evm right before the
+// precompile runs, and only the registered token contracts are trusted to forward the sender. The token balances are
+// read from and moved through the token keeper, the transfers being buffered in the CommitStateDB so that they are
+// reverted together with the evm call frames and applied to the accounts on Commit.
+//
+// The native tokens are gated by the EnableNativeToken param: the evm only records the callers with the Transfer
+// function of the native tokens if the param is enabled, otherwise the precompile is inert and behaves as an empty
+// account, which it was before the native tokens were introduced.
+//
+// NOTE: the token contracts use a CALL into the precompile, which geth allows inside a static call frame as no value
+// is transferred. The ERC-20 methods are therefore not write protected when a token contract is reached through a
+// STATICCALL, in the same way as a plain contract calling another one with CALL inside a view function.
+
+const (
+	nativeTokenDecimals = 18
+
+	nativeTokenReadGas  uint64 = 2000
+	nativeTokenWriteGas uint64 = 30000
+)
+
+var (
+	// NativeTokenPrecompileAddress is the address of the precompile serving the native token contracts
+	NativeTokenPrecompileAddress = ethcmn.BytesToAddress([]byte{0x01, 0x00})
+
+	nativeTokenContractCode = hexutil.MustDecode("0x33600052366000602037600060003660200160006000" +
+		"73" + strings.TrimPrefix(NativeTokenPrecompileAddress.Hex(), "0x") + "5af13d600060003e603a573d6000fd5b3d6000f3")
+
+	// TransferEventTopic is the topic of the ERC-20 Transfer(address,address,uint256) event
+	TransferEventTopic = ethcrypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// ApprovalEventTopic is the topic of the ERC-20 Approval(address,address,uint256) event
+	ApprovalEventTopic = ethcrypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+
+	methodName         = erc20MethodID("name()")
+	methodSymbol       = erc20MethodID("symbol()")
+	methodDecimals     = erc20MethodID("decimals()")
+	methodTotalSupply  = erc20MethodID("totalSupply()")
+	methodBalanceOf    = erc20MethodID("balanceOf(address)")
+	methodAllowance    = erc20MethodID("allowance(address,address)")
+	methodTransfer     = erc20MethodID("transfer(address,uint256)")
+	methodApprove      = erc20MethodID("approve(address,uint256)")
+	methodTransferFrom = erc20MethodID("transferFrom(address,address,uint256)")
+
+	revertSelector = erc20MethodID("Error(string)")
+
+	registerNativeTokenOnce sync.Once
+
+	// the state db of the evm which called the native token precompile last, see nativeTokenTransfer
+	nativeTokenDBMtx sync.Mutex
+	nativeTokenDB    *CommitStateDB
+)
+
+// RegisterNativeTokenPrecompile registers the native token precompile. The evm of go-ethereum v1.9.25 only looks the
+// precompiles up in the tables of the vm package, so that it's registered once for all the evm instances. It stays
+// inert on the evm instances whose native tokens aren't enabled, see nativeTokenTransfer.
+func RegisterNativeTokenPrecompile() {
+	registerNativeTokenOnce.Do(func() {
+		for _, precompiles := range []map[ethcmn.Address]vm.PrecompiledContract{
+			vm.PrecompiledContractsHomestead,
+			vm.PrecompiledContractsByzantium,
+			vm.PrecompiledContractsIstanbul,
+			vm.PrecompiledContractsYoloV2,
+		} {
+			precompiles[NativeTokenPrecompileAddress] = nativeTokenPrecompile{}
+		}
+		vm.PrecompiledAddressesHomestead = append(vm.PrecompiledAddressesHomestead, NativeTokenPrecompileAddress)
+		vm.PrecompiledAddressesByzantium = append(vm.PrecompiledAddressesByzantium, NativeTokenPrecompileAddress)
+		vm.PrecompiledAddressesIstanbul = append(vm.PrecompiledAddressesIstanbul, NativeTokenPrecompileAddress)
+		vm.PrecompiledAddressesYoloV2 = append(vm.PrecompiledAddressesYoloV2, NativeTokenPrecompileAddress)
+	})
+}
+
+// NativeToken is a token of the token module mapped into the evm
+type NativeToken struct {
+	Symbol          string         `json:"symbol" yaml:"symbol"`
+	ContractAddress ethcmn.Address `json:"contract_address" yaml:"contract_address"`
+}
+
+// NativeTokenContractAddress returns the address of the ERC-20 contract of the native token
+func NativeTokenContractAddress(symbol string) ethcmn.Address {
+	return ethcmn.BytesToAddress(ethcrypto.Keccak256([]byte("native-token:" + symbol))[12:])
+}
+
+// NativeTokenContractCode returns the code of the ERC-20 contracts of the native tokens
+func NativeTokenContractCode() []byte {
+	return ethcmn.CopyBytes(nativeTokenContractCode)
+}
+
+type nativeTransfer struct {
+	from, to sdk.AccAddress
+	symbol   string
+	amount   sdk.Dec
+}
+
+// nativeTokenTransfer is the Transfer function of the evm whose native tokens are enabled. A CALL into the native token
+// precompile is the only way to reach it together with a value transfer, which happens right after the snapshot of the
+// new call frame and right before the precompile runs, so the caller is recorded here into the state db. The record is
+// cleared on the snapshot of every call frame, so that a STATICCALL or DELEGATECALL never sees the record of another
+// frame.
+//
+// NOTE: a precompile only receives its input, so that the state db is handed over to it by nativeTokenDB. The txs and
+// the queries are executed sequentially by the ABCI connections, the mutex only guards the handover itself.
+func nativeTokenTransfer(db vm.StateDB, sender, recipient ethcmn.Address, amount *big.Int) {
+	core.Transfer(db, sender, recipient, amount)
+	if recipient != NativeTokenPrecompileAddress {
+		return
+	}
+
+	csdb, ok := db.(*CommitStateDB)
+	if !ok {
+		return
+	}
+	csdb.nativeTokenCaller = &sender
+	nativeTokenDBMtx.Lock()
+	nativeTokenDB = csdb
+	nativeTokenDBMtx.Unlock()
+}
+
+// nativeTokenCall returns the state db and the caller recorded for the running native token precompile, or nil if
+// none was recorded
+func nativeTokenCall() (*CommitStateDB, *ethcmn.Address) {
+	nativeTokenDBMtx.Lock()
+	csdb := nativeTokenDB
+	nativeTokenDBMtx.Unlock()
+	if csdb == nil || csdb.nativeTokenCaller == nil {
+		return nil, nil
+	}
+	return csdb, csdb.nativeTokenCaller
+}
+
+// nativeTokenPrecompile serves the ERC-20 methods of the native token contracts. Its input is the 32 bytes sender
+// forwarded by the token contract followed by the calldata of the token contract.
+type nativeTokenPrecompile struct{}
+
+// RequiredGas implements the vm.PrecompiledContract interface
+func (nativeTokenPrecompile) RequiredGas(input []byte) uint64 {
+	if _, caller := nativeTokenCall(); caller == nil {
+		// inert as an empty account
+		return 0
+	}
+	if len(input) < 36 {
+		return nativeTokenReadGas
+	}
+
+	switch method := input[32:36]; {
+	case bytes.Equal(method, methodTransfer), bytes.Equal(method, methodApprove), bytes.Equal(method, methodTransferFrom):
+		return nativeTokenWriteGas
+	default:
+		return nativeTokenReadGas
+	}
+}
+
+// Run implements the vm.PrecompiledContract interface
+func (nativeTokenPrecompile) Run(input []byte) ([]byte, error) {
+	csdb, caller := nativeTokenCall()
+	if caller == nil {
+		// inert as an empty account
+		return nil, nil
+	}
+	csdb.nativeTokenCaller = nil
+	nativeTokenDBMtx.Lock()
+	nativeTokenDB = nil
+	nativeTokenDBMtx.Unlock()
+
+	symbol, found := csdb.GetNativeTokenSymbol(*caller)
+	if !found {
+		return nativeTokenRevert("native token precompile must be called by a native token contract")
+	}
+	if len(input) < 36 {
+		return nativeTokenRevert("invalid native token call")
+	}
+
+	sender := ethcmn.BytesToAddress(input[:32])
+	return csdb.runNativeToken(*caller, symbol, sender, input[32:36], input[36:])
+}
+
+// runNativeToken executes an ERC-20 method of the native token contract
+func (csdb *CommitStateDB) runNativeToken(contract ethcmn.Address, symbol string, sender ethcmn.Address, method, args []byte,
+) ([]byte, error) {
+	words, ok := splitWords(args)
+	if !ok {
+		return nativeTokenRevert("invalid native token call arguments")
+	}
+
+	switch {
+	case bytes.Equal(method, methodName), bytes.Equal(method, methodSymbol):
+		return encodeString(symbol), nil
+	case bytes.Equal(method, methodDecimals):
+		return ethcmn.BigToHash(big.NewInt(nativeTokenDecimals)).Bytes(), nil
+	case bytes.Equal(method, methodTotalSupply):
+		return ethcmn.BigToHash(csdb.tokenKeeper.GetTokenTotalSupply(csdb.ctx, symbol).BigInt()).Bytes(), nil
+	case bytes.Equal(method, methodBalanceOf) && len(words) >= 1:
+		owner := ethcmn.BytesToAddress(words[0])
+		return ethcmn.BigToHash(csdb.nativeTokenBalance(owner, symbol)).Bytes(), nil
+	case bytes.Equal(method, methodAllowance) && len(words) >= 2:
+		owner, spender := ethcmn.BytesToAddress(words[0]), ethcmn.BytesToAddress(words[1])
+		return csdb.GetState(contract, allowanceKey(owner, spender)).Bytes(), nil
+	case bytes.Equal(method, methodTransfer) && len(words) >= 2:
+		to, value := ethcmn.BytesToAddress(words[0]), new(big.Int).SetBytes(words[1])
+		if err := csdb.transferNativeToken(contract, symbol, sender, to, value); err != nil {
+			return nativeTokenRevert(err.Error())
+		}
+		return encodeBool(true), nil
+	case bytes.Equal(method, methodApprove) && len(words) >= 2:
+		spender, value := ethcmn.BytesToAddress(words[0]), ethcmn.BytesToHash(words[1])
+		csdb.SetState(contract, allowanceKey(sender, spender), value)
+		csdb.addNativeTokenLog(contract, ApprovalEventTopic, sender, spender, value.Big())
+		return encodeBool(true), nil
+	case bytes.Equal(method, methodTransferFrom) && len(words) >= 3:
+		from, to, value := ethcmn.BytesToAddress(words[0]), ethcmn.BytesToAddress(words[1]), new(big.Int).SetBytes(words[2])
+		key := allowanceKey(from, sender)
+		allowance := csdb.GetState(contract, key).Big()
+		if allowance.Cmp(value) < 0 {
+			return nativeTokenRevert("transfer amount exceeds allowance")
+		}
+		if err := csdb.transferNativeToken(contract, symbol, from, to, value); err != nil {
+			return nativeTokenRevert(err.Error())
+		}
+		csdb.SetState(contract, key, ethcmn.BigToHash(new(big.Int).Sub(allowance, value)))
+		return encodeBool(true), nil
+	default:
+		return nativeTokenRevert("unknown native token method")
+	}
+}
+
+// transferNativeToken moves the native token between the accounts, the transfer being applied on Commit
+func (csdb *CommitStateDB) transferNativeToken(contract ethcmn.Address, symbol string, from, to ethcmn.Address, value *big.Int,
+) error {
+	if to == (ethcmn.Address{}) {
+		return fmt.Errorf("transfer to the zero address")
+	}
+	if csdb.nativeTokenBalance(from, symbol).Cmp(value) < 0 {
+		return fmt.Errorf("transfer amount exceeds balance")
+	}
+	if csdb.bankKeeper.BlacklistedAddr(to.Bytes()) {
+		return fmt.Errorf("address <%s> in blacklist is not allowed", sdk.AccAddress(to.Bytes()))
+	}
+
+	csdb.journal.append(nativeTransferChange{})
+	csdb.nativeTransfers = append(csdb.nativeTransfers, nativeTransfer{
+		from:   from.Bytes(),
+		to:     to.Bytes(),
+		symbol: symbol,
+		amount: sdk.NewDecFromBigIntWithPrec(value, sdk.Precision), // int2dec
+	})
+	csdb.addNativeTokenLog(contract, TransferEventTopic, from, to, value)
+	return nil
+}
+
+// nativeTokenBalance returns the balance of the native token including the pending transfers
+func (csdb *CommitStateDB) nativeTokenBalance(addr ethcmn.Address, symbol string) *big.Int {
+	accAddr := sdk.AccAddress(addr.Bytes())
+	balance := csdb.bankKeeper.GetCoins(csdb.ctx, accAddr).AmountOf(symbol)
+	for _, transfer := range csdb.nativeTransfers {
+		if transfer.symbol != symbol {
+			continue
+		}
+		if transfer.from.Equals(accAddr) {
+			balance = balance.Sub(transfer.amount)
+		}
+		if transfer.to.Equals(accAddr) {
+			balance = balance.Add(transfer.amount)
+		}
+	}
+	return balance.BigInt()
+}
+
+func (csdb *CommitStateDB) addNativeTokenLog(contract ethcmn.Address, topic ethcmn.Hash, from, to ethcmn.Address, value *big.Int) {
+	csdb.AddLog(&ethtypes.Log{
+		Address:     contract,
+		Topics:      []ethcmn.Hash{topic, from.Hash(), to.Hash()},
+		Data:        ethcmn.BigToHash(value).Bytes(),
+		BlockNumber: uint64(csdb.ctx.BlockHeight()),
+	})
+}
+
+// applyNativeTransfers moves the native token balances of the transfers made by the evm through the token keeper
+func (csdb *CommitStateDB) applyNativeTransfers() error {
+	transfers := csdb.nativeTransfers
+	csdb.nativeTransfers = nil
+	for _, transfer := range transfers {
+		coins := sdk.SysCoins{sdk.NewDecCoinFromDec(transfer.symbol, transfer.amount)}
+		if err := csdb.tokenKeeper.SendCoinsFromEVM(csdb.ctx, transfer.from, transfer.to, coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetNativeTokens maps the native tokens into the evm by deploying their ERC-20 contracts
+func (csdb *CommitStateDB) SetNativeTokens(symbols []string) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for _, symbol := range symbols {
+		contract := NativeTokenContractAddress(symbol)
+		store.Set(getNativeTokenKey(symbol), contract.Bytes())
+		store.Set(getNativeTokenContractKey(contract), []byte(symbol))
+		if csdb.GetCodeSize(contract) == 0 {
+			csdb.SetCode(contract, NativeTokenContractCode())
+		}
+	}
+}
+
+// DeleteNativeTokens removes the mapping of the native tokens. The contracts are kept together with their
+// allowances, but every call into them is reverted until the tokens are mapped again.
+func (csdb *CommitStateDB) DeleteNativeTokens(symbols []string) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for _, symbol := range symbols {
+		store.Delete(getNativeTokenKey(symbol))
+		store.Delete(getNativeTokenContractKey(NativeTokenContractAddress(symbol)))
+	}
+}
+
+// GetNativeTokens gets all the native tokens mapped into the evm
+func (csdb *CommitStateDB) GetNativeTokens() (tokens []NativeToken) {
+	iterator := sdk.KVStorePrefixIterator(csdb.ctx.KVStore(csdb.storeKey), KeyPrefixNativeToken)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		tokens = append(tokens, NativeToken{
+			Symbol:          string(iterator.Key()[len(KeyPrefixNativeToken):]),
+			ContractAddress: ethcmn.BytesToAddress(iterator.Value()),
+		})
+	}
+
+	return
+}
+
+// GetNativeTokenSymbol returns the symbol of the native token mapped to the contract address
+func (csdb *CommitStateDB) GetNativeTokenSymbol(contract ethcmn.Address) (string, bool) {
+	bz := csdb.ctx.KVStore(csdb.storeKey).Get(getNativeTokenContractKey(contract))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// IsNativeTokenMapped checks whether the native token is mapped into the evm
+func (csdb *CommitStateDB) IsNativeTokenMapped(symbol string) bool {
+	return csdb.ctx.KVStore(csdb.storeKey).Has(getNativeTokenKey(symbol))
+}
+
+func allowanceKey(owner, spender ethcmn.Address) ethcmn.Hash {
+	return ethcrypto.Keccak256Hash(owner.Bytes(), spender.Bytes())
+}
+
+func erc20MethodID(signature string) []byte {
+	return ethcrypto.Keccak256([]byte(signature))[:4]
+}
+
+// splitWords splits the abi encoded arguments of static types into 32 bytes words
+func splitWords(args []byte) ([][]byte, bool) {
+	if len(args)%32 != 0 {
+		return nil, false
+	}
+	words := make([][]byte, 0, len(args)/32)
+	for i := 0; i < len(args); i += 32 {
+		words = append(words, args[i:i+32])
+	}
+	return words, true
+}
+
+func encodeBool(b bool) []byte {
+	if b {
+		return ethcmn.BigToHash(big.NewInt(1)).Bytes()
+	}
+	return ethcmn.Hash{}.Bytes()
+}
+
+func encodeString(s string) []byte {
+	length := (len(s) + 31) / 32 * 32
+	bz := make([]byte, 64+length)
+	copy(bz[:32], ethcmn.BigToHash(big.NewInt(32)).Bytes())
+	copy(bz[32:64], ethcmn.BigToHash(big.NewInt(int64(len(s)))).Bytes())
+	copy(bz[64:], s)
+	return bz
+}
+
+// nativeTokenRevert returns the Error(string) revert data of the reason
+func nativeTokenRevert(reason string) ([]byte, error) {
+	return append(ethcmn.CopyBytes(revertSelector), encodeString(reason)...), vm.ErrExecutionReverted
+}
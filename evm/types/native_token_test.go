@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	ethermint "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func erc20Call(signature string, args ...[]byte) []byte {
+	data := ethcrypto.Keccak256([]byte(signature))[:4]
+	for _, arg := range args {
+		data = append(data, ethcmn.LeftPadBytes(arg, 32)...)
+	}
+	return data
+}
+
+func (suite *StateDBTestSuite) TestNativeToken() {
+	const symbol = "xxb"
+	addr := sdk.AccAddress(suite.address.Bytes())
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000)), sdk.NewDecCoinFromDec(symbol, sdk.NewDec(100))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.EnableNativeToken = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	// map the token into the evm
+	contract := types.NativeTokenContractAddress(symbol)
+	suite.stateDB.SetNativeTokens([]string{symbol})
+	_, err := suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NativeTokenContractCode(), suite.app.EvmKeeper.GetCode(suite.ctx, contract))
+	suite.Require().Equal([]types.NativeToken{{Symbol: symbol, ContractAddress: contract}}, suite.stateDB.GetNativeTokens())
+
+	recipient := ethcmn.BytesToAddress([]byte("recipient"))
+	newStateTransition := func(nonce uint64, to ethcmn.Address, payload []byte, simulate bool) types.StateTransition {
+		return types.StateTransition{
+			AccountNonce: nonce,
+			Price:        big.NewInt(1),
+			GasLimit:     200000,
+			Recipient:    &to,
+			Amount:       big.NewInt(0),
+			Payload:      payload,
+			ChainID:      big.NewInt(1),
+			Csdb:         types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx),
+			TxHash:       &ethcmn.Hash{},
+			Sender:       suite.address,
+			Simulate:     simulate,
+		}
+	}
+
+	// transfer 10 tokens through the ERC-20 contract
+	amount := sdk.NewDec(10).BigInt()
+	st := newStateTransition(0, contract, erc20Call("transfer(address,uint256)", recipient.Bytes(), amount.Bytes()), false)
+	_, resData, err := st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().NoError(err)
+	suite.Require().Len(resData.Logs, 1)
+	suite.Require().Equal(contract, resData.Logs[0].Address)
+	suite.Require().Equal(types.TransferEventTopic, resData.Logs[0].Topics[0])
+	suite.Require().Equal(suite.address.Hash(), resData.Logs[0].Topics[1])
+	suite.Require().Equal(recipient.Hash(), resData.Logs[0].Topics[2])
+	suite.Require().Equal(amount, new(big.Int).SetBytes(resData.Logs[0].Data))
+
+	suite.Require().Equal(sdk.NewDec(90), suite.app.BankKeeper.GetCoins(suite.ctx, addr).AmountOf(symbol))
+	suite.Require().Equal(sdk.NewDec(10), suite.app.BankKeeper.GetCoins(suite.ctx, recipient.Bytes()).AmountOf(symbol))
+	// the evm balance is untouched
+	suite.Require().Equal(sdk.NewDec(5000).BigInt(), suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address))
+
+	// read the balance through the ERC-20 contract
+	st = newStateTransition(1, contract, erc20Call("balanceOf(address)", recipient.Bytes()), true)
+	_, resData, err = st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount, new(big.Int).SetBytes(resData.Ret))
+
+	// transfer more than the balance
+	st = newStateTransition(1, contract, erc20Call("transfer(address,uint256)", recipient.Bytes(), sdk.NewDec(91).BigInt().Bytes()), false)
+	_, _, err = st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(90), suite.app.BankKeeper.GetCoins(suite.ctx, addr).AmountOf(symbol))
+
+	// the precompile can not be called directly
+	st = newStateTransition(1, types.NativeTokenPrecompileAddress,
+		append(ethcmn.LeftPadBytes(suite.address.Bytes(), 32), erc20Call("transfer(address,uint256)", recipient.Bytes(), amount.Bytes())...), false)
+	_, _, err = st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().Error(err)
+
+	// the precompile is inert as an empty account while the native tokens are disabled
+	params.EnableNativeToken = false
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	st = newStateTransition(1, contract, erc20Call("balanceOf(address)", recipient.Bytes()), true)
+	_, resData, err = st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().NoError(err)
+	suite.Require().Empty(resData.Ret)
+	params.EnableNativeToken = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	// the contract reverts once the token is unmapped
+	suite.stateDB.DeleteNativeTokens([]string{symbol})
+	_, found := suite.stateDB.GetNativeTokenSymbol(contract)
+	suite.Require().False(found)
+	st = newStateTransition(1, contract, erc20Call("balanceOf(address)", recipient.Bytes()), true)
+	_, _, err = st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().Error(err)
+}
@@ -1,10 +1,12 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
 	"gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/okex/exchain/x/params"
 )
@@ -23,6 +25,7 @@ var (
 	ParamStoreKeyContractDeploymentWhitelist = []byte("EnableContractDeploymentWhitelist")
 	ParamStoreKeyContractBlockedList         = []byte("EnableContractBlockedList")
 	ParamStoreKeyMaxGasLimitPerTx            = []byte("MaxGasLimitPerTx")
+	ParamStoreKeyEnableNativeToken           = []byte("EnableNativeToken")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -44,6 +47,8 @@ type Params struct {
 	EnableContractBlockedList bool `json:"enable_contract_blocked_list" yaml:"enable_contract_blocked_list"`
 	// MaxGasLimit defines the max gas limit in transaction
 	MaxGasLimitPerTx uint64 `json:"max_gas_limit_per_tx" yaml:"max_gas_limit_per_tx"`
+	// EnableNativeToken controls the availability of the ERC-20 contracts of the native tokens
+	EnableNativeToken bool `json:"enable_native_token" yaml:"enable_native_token"`
 }
 
 // NewParams creates a new Params instance
@@ -68,6 +73,7 @@ func DefaultParams() Params {
 		EnableContractDeploymentWhitelist: false,
 		EnableContractBlockedList:         false,
 		MaxGasLimitPerTx:                  DefaultMaxGasLimitPerTx,
+		EnableNativeToken:                 false,
 	}
 }
 
@@ -86,9 +92,24 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(ParamStoreKeyContractDeploymentWhitelist, &p.EnableContractDeploymentWhitelist, validateBool),
 		params.NewParamSetPair(ParamStoreKeyContractBlockedList, &p.EnableContractBlockedList, validateBool),
 		params.NewParamSetPair(ParamStoreKeyMaxGasLimitPerTx, &p.MaxGasLimitPerTx, validateUint64),
+		params.NewParamSetPair(ParamStoreKeyEnableNativeToken, &p.EnableNativeToken, validateBool),
 	}
 }
 
+// GetParamsFromSubspace reads the evm parameters from the param space. EnableNativeToken is added after the genesis of
+// the running chains, so it's read only if it exists, and it keeps its default value until it's set by the governance
+func GetParamsFromSubspace(ctx sdk.Context, paramSpace params.Subspace) (p Params) {
+	p.EnableNativeToken = DefaultParams().EnableNativeToken
+	for _, pair := range p.ParamSetPairs() {
+		if bytes.Equal(pair.Key, ParamStoreKeyEnableNativeToken) {
+			paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+			continue
+		}
+		paramSpace.Get(ctx, pair.Key, pair.Value)
+	}
+	return
+}
+
 // Validate performs basic validation on evm parameters.
 func (p Params) Validate() error {
 	return validateEIPs(p.ExtraEIPs)
@@ -55,6 +55,7 @@ extra_eips: []
 enable_contract_deployment_whitelist: false
 enable_contract_blocked_list: false
 max_gas_limit_per_tx: 30000000
+enable_native_token: false
 `
 	require.True(t, strings.EqualFold(expectedParamsStr, DefaultParams().String()))
 }
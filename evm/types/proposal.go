@@ -14,18 +14,33 @@ const (
 	proposalTypeManageContractDeploymentWhitelist = "ManageContractDeploymentWhitelist"
 	// proposalTypeManageContractBlockedList defines the type for a ManageContractBlockedListProposal
 	proposalTypeManageContractBlockedList = "ManageContractBlockedList"
+	// proposalTypeManageNativeTokenMapping defines the type for a ManageNativeTokenMappingProposal
+	proposalTypeManageNativeTokenMapping = "ManageNativeTokenMapping"
+	// proposalTypeManageContractMethodBlockedList defines the type for a ManageContractMethodBlockedListProposal
+	proposalTypeManageContractMethodBlockedList = "ManageContractMethodBlockedList"
+	// proposalTypeContractPatch defines the type for a ContractPatchProposal
+	proposalTypeContractPatch = "ContractPatch"
 )
 
 func init() {
 	govtypes.RegisterProposalType(proposalTypeManageContractDeploymentWhitelist)
 	govtypes.RegisterProposalType(proposalTypeManageContractBlockedList)
+	govtypes.RegisterProposalType(proposalTypeManageNativeTokenMapping)
+	govtypes.RegisterProposalType(proposalTypeManageContractMethodBlockedList)
+	govtypes.RegisterProposalType(proposalTypeContractPatch)
 	govtypes.RegisterProposalTypeCodec(ManageContractDeploymentWhitelistProposal{}, "filechain/evm/ManageContractDeploymentWhitelistProposal")
 	govtypes.RegisterProposalTypeCodec(ManageContractBlockedListProposal{}, "filechain/evm/ManageContractBlockedListProposal")
+	govtypes.RegisterProposalTypeCodec(ManageNativeTokenMappingProposal{}, "filechain/evm/ManageNativeTokenMappingProposal")
+	govtypes.RegisterProposalTypeCodec(ManageContractMethodBlockedListProposal{}, "filechain/evm/ManageContractMethodBlockedListProposal")
+	govtypes.RegisterProposalTypeCodec(ContractPatchProposal{}, "filechain/evm/ContractPatchProposal")
 }
 
 var (
 	_ govtypes.Content = (*ManageContractDeploymentWhitelistProposal)(nil)
 	_ govtypes.Content = (*ManageContractBlockedListProposal)(nil)
+	_ govtypes.Content = (*ManageNativeTokenMappingProposal)(nil)
+	_ govtypes.Content = (*ManageContractMethodBlockedListProposal)(nil)
+	_ govtypes.Content = (*ContractPatchProposal)(nil)
 )
 
 // ManageContractDeploymentWhitelistProposal - structure for the proposal to add or delete deployer addresses from whitelist
@@ -239,3 +254,290 @@ func (mp ManageContractBlockedListProposal) String() string {
 
 	return strings.TrimSpace(builder.String())
 }
+
+// ManageNativeTokenMappingProposal - structure for the proposal to map or unmap native tokens into or from the evm as
+// ERC-20 contracts
+type ManageNativeTokenMappingProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Symbols     []string `json:"symbols" yaml:"symbols"`
+	IsAdded     bool     `json:"is_added" yaml:"is_added"`
+}
+
+// NewManageNativeTokenMappingProposal creates a new instance of ManageNativeTokenMappingProposal
+func NewManageNativeTokenMappingProposal(title, description string, symbols []string, isAdded bool,
+) ManageNativeTokenMappingProposal {
+	return ManageNativeTokenMappingProposal{
+		Title:       title,
+		Description: description,
+		Symbols:     symbols,
+		IsAdded:     isAdded,
+	}
+}
+
+// GetTitle returns title of a manage native token mapping proposal object
+func (mp ManageNativeTokenMappingProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of a manage native token mapping proposal object
+func (mp ManageNativeTokenMappingProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of a manage native token mapping proposal object
+func (mp ManageNativeTokenMappingProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a manage native token mapping proposal object
+func (mp ManageNativeTokenMappingProposal) ProposalType() string {
+	return proposalTypeManageNativeTokenMapping
+}
+
+// ValidateBasic validates a manage native token mapping proposal
+func (mp ManageNativeTokenMappingProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(mp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(mp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(mp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(mp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if mp.ProposalType() != proposalTypeManageNativeTokenMapping {
+		return govtypes.ErrInvalidProposalType(mp.ProposalType())
+	}
+
+	symbolLen := len(mp.Symbols)
+	if symbolLen == 0 {
+		return ErrEmptyTokenList
+	}
+
+	if symbolLen > maxAddressListLength {
+		return ErrOversizeTokenList(symbolLen)
+	}
+
+	filter := make(map[string]struct{}, symbolLen)
+	for _, symbol := range mp.Symbols {
+		// the native token of the chain is the ether of the evm
+		if len(symbol) == 0 || symbol == sdk.DefaultBondDenom {
+			return ErrInvalidNativeToken(symbol)
+		}
+		if _, ok := filter[symbol]; ok {
+			return ErrDuplicatedToken
+		}
+		filter[symbol] = struct{}{}
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a ManageNativeTokenMappingProposal
+func (mp ManageNativeTokenMappingProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString(
+		fmt.Sprintf(`ManageNativeTokenMappingProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ IsAdded:				%t
+ Symbols:
+`,
+			mp.Title, mp.Description, mp.ProposalType(), mp.IsAdded),
+	)
+
+	for i := 0; i < len(mp.Symbols); i++ {
+		builder.WriteString("\t\t\t\t\t\t")
+		builder.WriteString(mp.Symbols[i])
+		builder.Write([]byte{'\n'})
+	}
+
+	return strings.TrimSpace(builder.String())
+}
+
+// ManageContractMethodBlockedListProposal - structure for the proposal to add or delete the methods of contracts from
+// the contract method blocked list
+type ManageContractMethodBlockedListProposal struct {
+	Title        string              `json:"title" yaml:"title"`
+	Description  string              `json:"description" yaml:"description"`
+	ContractList BlockedContractList `json:"contract_addresses" yaml:"contract_addresses"`
+	IsAdded      bool                `json:"is_added" yaml:"is_added"`
+}
+
+// NewManageContractMethodBlockedListProposal creates a new instance of ManageContractMethodBlockedListProposal
+func NewManageContractMethodBlockedListProposal(title, description string, contractList BlockedContractList, isAdded bool,
+) ManageContractMethodBlockedListProposal {
+	return ManageContractMethodBlockedListProposal{
+		Title:        title,
+		Description:  description,
+		ContractList: contractList,
+		IsAdded:      isAdded,
+	}
+}
+
+// GetTitle returns title of a manage contract method blocked list proposal object
+func (mp ManageContractMethodBlockedListProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of a manage contract method blocked list proposal object
+func (mp ManageContractMethodBlockedListProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of a manage contract method blocked list proposal object
+func (mp ManageContractMethodBlockedListProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a manage contract method blocked list proposal object
+func (mp ManageContractMethodBlockedListProposal) ProposalType() string {
+	return proposalTypeManageContractMethodBlockedList
+}
+
+// ValidateBasic validates a manage contract method blocked list proposal
+func (mp ManageContractMethodBlockedListProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(mp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(mp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(mp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(mp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if mp.ProposalType() != proposalTypeManageContractMethodBlockedList {
+		return govtypes.ErrInvalidProposalType(mp.ProposalType())
+	}
+
+	contractLen := len(mp.ContractList)
+	if contractLen > maxAddressListLength {
+		return ErrOversizeAddrList(contractLen)
+	}
+
+	return mp.ContractList.ValidateBasic()
+}
+
+// String returns a human readable string representation of a ManageContractMethodBlockedListProposal
+func (mp ManageContractMethodBlockedListProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString(
+		fmt.Sprintf(`ManageContractMethodBlockedListProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ IsAdded:				%t
+ ContractList:
+`,
+			mp.Title, mp.Description, mp.ProposalType(), mp.IsAdded),
+	)
+
+	for i := 0; i < len(mp.ContractList); i++ {
+		builder.WriteString("\t\t\t\t\t\t")
+		builder.WriteString(mp.ContractList[i].String())
+		builder.Write([]byte{'\n'})
+	}
+
+	return strings.TrimSpace(builder.String())
+}
+
+// ContractPatchProposal - structure for the proposal to patch the code, storage and balance of contracts in emergency
+type ContractPatchProposal struct {
+	Title       string          `json:"title" yaml:"title"`
+	Description string          `json:"description" yaml:"description"`
+	Patches     ContractPatches `json:"patches" yaml:"patches"`
+}
+
+// NewContractPatchProposal creates a new instance of ContractPatchProposal
+func NewContractPatchProposal(title, description string, patches ContractPatches) ContractPatchProposal {
+	return ContractPatchProposal{
+		Title:       title,
+		Description: description,
+		Patches:     patches,
+	}
+}
+
+// GetTitle returns title of a contract patch proposal object
+func (cp ContractPatchProposal) GetTitle() string {
+	return cp.Title
+}
+
+// GetDescription returns description of a contract patch proposal object
+func (cp ContractPatchProposal) GetDescription() string {
+	return cp.Description
+}
+
+// ProposalRoute returns route key of a contract patch proposal object
+func (cp ContractPatchProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a contract patch proposal object
+func (cp ContractPatchProposal) ProposalType() string {
+	return proposalTypeContractPatch
+}
+
+// ValidateBasic validates a contract patch proposal
+func (cp ContractPatchProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(cp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(cp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(cp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(cp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if cp.ProposalType() != proposalTypeContractPatch {
+		return govtypes.ErrInvalidProposalType(cp.ProposalType())
+	}
+
+	patchesLen := len(cp.Patches)
+	if patchesLen > maxAddressListLength {
+		return ErrOversizeAddrList(patchesLen)
+	}
+
+	return cp.Patches.ValidateBasic()
+}
+
+// String returns a human readable string representation of a ContractPatchProposal
+func (cp ContractPatchProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString(
+		fmt.Sprintf(`ContractPatchProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Patches:
+`,
+			cp.Title, cp.Description, cp.ProposalType()),
+	)
+
+	for i := 0; i < len(cp.Patches); i++ {
+		builder.WriteString("\t\t\t\t\t\t")
+		builder.WriteString(cp.Patches[i].String())
+		builder.Write([]byte{'\n'})
+	}
+
+	return strings.TrimSpace(builder.String())
+}
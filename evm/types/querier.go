@@ -23,6 +23,11 @@ const (
 	QuerySection                     = "section"
 	QueryContractDeploymentWhitelist = "contract-deployment-whitelist"
 	QueryContractBlockedList         = "contract-blocked-list"
+	QueryContractMethodBlockedList   = "contract-method-blocked-list"
+	QueryTraceTx                     = "traceTx"
+	QueryNativeTokens                = "native-tokens"
+	QueryContractPatches             = "contract-patches"
+	QueryChainConfig                 = "chain-config"
 )
 
 // QueryResBalance is response type for balance query
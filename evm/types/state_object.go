@@ -76,6 +76,10 @@ type stateObject struct {
 	dirtyCode bool // true if the code was updated
 	suicided  bool
 	deleted   bool
+
+	// storageOverridden is set when the entire storage is replaced by the state override of a simulated call, then
+	// the storage in the store is ignored
+	storageOverridden bool
 }
 
 func newStateObject(db *CommitStateDB, accProto authexported.Account) *stateObject {
@@ -126,6 +130,23 @@ func (so *stateObject) SetState(db ethstate.Database, key, value ethcmn.Hash) {
 	so.setState(prefixKey, value)
 }
 
+// SetStorage replaces the entire storage of the state object with the given one.
+// It's only used for the state override of a simulated call, which is never
+// committed.
+func (so *stateObject) SetStorage(storage map[ethcmn.Hash]ethcmn.Hash) {
+	so.storageOverridden = true
+	so.originStorage = Storage{}
+	so.keyToOriginStorageIndex = make(map[ethcmn.Hash]int)
+	so.dirtyStorage = Storage{}
+	so.keyToDirtyStorageIndex = make(map[ethcmn.Hash]int)
+
+	for key, value := range storage {
+		prefixKey := so.GetStorageByAddressKey(key.Bytes())
+		so.originStorage = append(so.originStorage, NewState(prefixKey, value))
+		so.keyToOriginStorageIndex[prefixKey] = len(so.originStorage) - 1
+	}
+}
+
 // setState sets a state with a prefixed key and value to the dirty storage.
 func (so *stateObject) setState(key, value ethcmn.Hash) {
 	idx, ok := so.keyToDirtyStorageIndex[key]
@@ -361,6 +382,11 @@ func (so *stateObject) GetCommittedState(_ ethstate.Database, key ethcmn.Hash) e
 		return so.originStorage[idx].Value
 	}
 
+	// the storage has been replaced entirely, the missing entries are empty
+	if so.storageOverridden {
+		return ethcmn.Hash{}
+	}
+
 	// otherwise load the value from the KVStore
 	state := NewState(prefixKey, ethcmn.Hash{})
 
@@ -404,6 +430,7 @@ func (so *stateObject) deepCopy(db *CommitStateDB) *stateObject {
 	newStateObj.suicided = so.suicided
 	newStateObj.dirtyCode = so.dirtyCode
 	newStateObj.deleted = so.deleted
+	newStateObj.storageOverridden = so.storageOverridden
 
 	return newStateObj
 }
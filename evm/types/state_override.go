@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OverrideAccount indicates the overriding fields of an account during the simulation of a call.
+// Duplicate struct definition since geth struct is in internal package
+// Ref: https://github.com/ethereum/go-ethereum/blob/release/1.9/internal/ethapi/api.go#L808
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[ethcmn.Hash]ethcmn.Hash `json:"state"`
+	StateDiff *map[ethcmn.Hash]ethcmn.Hash `json:"stateDiff"`
+}
+
+// StateOverride is the collection of the overridden accounts
+type StateOverride map[ethcmn.Address]OverrideAccount
+
+// Apply overrides the fields of the specified accounts into the state db. It must only be applied to the state db of a
+// simulation, which is never committed
+func (diff *StateOverride) Apply(csdb *CommitStateDB) error {
+	if diff == nil {
+		return nil
+	}
+
+	for addr, account := range *diff {
+		// override account nonce
+		if account.Nonce != nil {
+			csdb.SetNonce(addr, uint64(*account.Nonce))
+		}
+
+		// override account code
+		if account.Code != nil {
+			csdb.SetCode(addr, *account.Code)
+		}
+
+		// override account balance
+		if account.Balance != nil {
+			csdb.SetBalance(addr, (*big.Int)(*account.Balance))
+		}
+
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		// replace entire state if caller requires
+		if account.State != nil {
+			csdb.SetStorage(addr, *account.State)
+		}
+
+		// apply state diff into specified accounts
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				csdb.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return nil
+}
@@ -25,12 +25,15 @@ type StateTransition struct {
 	Recipient    *common.Address
 	Amount       *big.Int
 	Payload      []byte
+	AccessList   AccessList
 
 	ChainID  *big.Int
 	Csdb     *CommitStateDB
 	TxHash   *common.Hash
 	Sender   common.Address
 	Simulate bool // i.e CheckTx execution
+	// Tracer is attached to the evm when set (i.e debug_trace* execution)
+	Tracer vm.Tracer
 }
 
 // GasInfo returns the gas limit, gas consumed and gas refunded from the EVM transition
@@ -81,10 +84,16 @@ func (st StateTransition) newEVM(
 	config ChainConfig,
 	extraEIPs []int,
 ) *vm.EVM {
+	// the callers of the native token precompile are only recorded if the native tokens are enabled
+	transfer := core.Transfer
+	if csdb.GetParams().EnableNativeToken {
+		transfer = nativeTokenTransfer
+	}
+
 	// Create context for evm
 	blockCtx := vm.BlockContext{
 		CanTransfer: core.CanTransfer,
-		Transfer:    core.Transfer,
+		Transfer:    transfer,
 		GetHash:     GetHashFn(ctx, csdb),
 		Coinbase:    common.BytesToAddress(ctx.BlockHeader().ProposerAddress),
 		BlockNumber: big.NewInt(ctx.BlockHeight()),
@@ -101,6 +110,15 @@ func (st StateTransition) newEVM(
 	vmConfig := vm.Config{
 		ExtraEips: extraEIPs,
 	}
+	tracer := st.Tracer
+	// the internal calls are checked against the contract method blocked list by a tracer
+	if csdb.GetParams().EnableContractBlockedList && csdb.HasContractMethodBlockedList() {
+		tracer = newContractMethodBlockedTracer(csdb, tracer)
+	}
+	if tracer != nil {
+		vmConfig.Debug = true
+		vmConfig.Tracer = tracer
+	}
 
 	return vm.NewEVM(blockCtx, txCtx, csdb, config.EthereumConfig(st.ChainID), vmConfig)
 }
@@ -115,6 +133,8 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 			// contract calling
 			if blockedContractAddr, ok := e.(common.Address); ok {
 				err = ErrCallBlockedContract(blockedContractAddr)
+			} else if blockedMethod, ok := e.(blockedContractMethod); ok {
+				err = ErrCallBlockedContractMethod(blockedMethod.contract, blockedMethod.selector)
 			} else {
 				// unexpected and unknown panic from lower part
 				panic(e)
@@ -128,6 +148,9 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	if err != nil {
 		return exeRes, resData, sdkerrors.Wrap(err, "invalid intrinsic gas for transaction")
 	}
+	if IsTypedTxEnabled(ctx.BlockHeight()) {
+		cost += st.AccessList.IntrinsicGas()
+	}
 
 	consumedGas := ctx.GasMeter().GasConsumed()
 	if consumedGas < cost {
@@ -157,6 +180,16 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		senderRef       = vm.AccountRef(st.Sender)
 	)
 
+	// Warm up the addresses and the storage slots in the access list (EIP-2930)
+	if IsTypedTxEnabled(ctx.BlockHeight()) {
+		for _, tuple := range st.AccessList {
+			csdb.AddAddressToAccessList(tuple.Address)
+			for _, key := range tuple.StorageKeys {
+				csdb.AddSlotToAccessList(tuple.Address, key)
+			}
+		}
+	}
+
 	// Get nonce of account outside of the EVM
 	currentNonce := csdb.GetNonce(st.Sender)
 	// Set nonce of sender account before evm state transition for usage in generating Create address
@@ -182,6 +215,12 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 			return exeRes, resData, ErrCallDisabled
 		}
 
+		// check the method called by the transaction, the internal calls are checked during the evm execution
+		if params.EnableContractBlockedList && len(st.Payload) >= 4 &&
+			csdb.IsContractMethodBlocked(st.Recipient.Bytes(), st.Payload[:4]) {
+			return exeRes, resData, ErrCallBlockedContractMethod(*st.Recipient, st.Payload[:4])
+		}
+
 		// Increment the nonce for the next transaction	(just for evm state transition)
 		csdb.SetNonce(st.Sender, csdb.GetNonce(st.Sender)+1)
 		ret, leftOverGas, err = evm.Call(senderRef, *st.Recipient, st.Payload, gasLimit, st.Amount)
@@ -35,6 +35,7 @@ type CommitStateDBParams struct {
 	AccountKeeper AccountKeeper
 	SupplyKeeper  SupplyKeeper
 	BankKeeper    bank.Keeper
+	TokenKeeper   TokenKeeper
 }
 
 // CommitStateDB implements the Geth state.StateDB interface. Instead of using
@@ -54,6 +55,7 @@ type CommitStateDB struct {
 	accountKeeper AccountKeeper
 	supplyKeeper  SupplyKeeper
 	bankKeeper    bank.Keeper
+	tokenKeeper   TokenKeeper
 
 	// array that hold 'live' objects, which will get modified while processing a
 	// state transition
@@ -70,6 +72,11 @@ type CommitStateDB struct {
 
 	logs []*ethtypes.Log
 
+	// native token transfers made through the native token contracts, which are applied to the accounts on Commit
+	nativeTransfers []nativeTransfer
+	// the immediate caller of the native token precompile, see nativeTokenTransfer
+	nativeTokenCaller *ethcmn.Address
+
 	// TODO: Determine if we actually need this as we do not need preimages in
 	// the SDK, but it seems to be used elsewhere in Geth.
 	preimages           []preimageEntry
@@ -136,6 +143,7 @@ func CreateEmptyCommitStateDB(csdbParams CommitStateDBParams, ctx sdk.Context) *
 		accountKeeper: csdbParams.AccountKeeper,
 		supplyKeeper:  csdbParams.SupplyKeeper,
 		bankKeeper:    csdbParams.BankKeeper,
+		tokenKeeper:   csdbParams.TokenKeeper,
 
 		stateObjects:         []stateEntry{},
 		addressToObjectIndex: make(map[ethcmn.Address]int),
@@ -222,6 +230,15 @@ func (csdb *CommitStateDB) SetState(addr ethcmn.Address, key, value ethcmn.Hash)
 	}
 }
 
+// SetStorage replaces the entire storage for the specified account with the
+// given one. It's only used for the state override of a simulated call.
+func (csdb *CommitStateDB) SetStorage(addr ethcmn.Address, storage map[ethcmn.Hash]ethcmn.Hash) {
+	so := csdb.GetOrNewStateObject(addr)
+	if so != nil {
+		so.(*stateObject).SetStorage(storage)
+	}
+}
+
 // SetCode sets the code for a given account.
 func (csdb *CommitStateDB) SetCode(addr ethcmn.Address, code []byte) {
 	so := csdb.GetOrNewStateObject(addr)
@@ -346,8 +363,7 @@ func (csdb *CommitStateDB) GetHeightHash(height uint64) ethcmn.Hash {
 // GetParams returns the total set of evm parameters.
 func (csdb *CommitStateDB) GetParams() Params {
 	if csdb.params == nil {
-		var params Params
-		csdb.paramSpace.GetParamSet(csdb.ctx, &params)
+		params := GetParamsFromSubspace(csdb.ctx, csdb.paramSpace)
 		csdb.params = &params
 	}
 	return *csdb.params
@@ -527,6 +543,11 @@ func (csdb *CommitStateDB) Commit(deleteEmptyObjects bool) (ethcmn.Hash, error)
 		delete(csdb.stateObjectsDirty, stateEntry.address)
 	}
 
+	// the native token balances are moved after the accounts are written, which would overwrite them otherwise
+	if err := csdb.applyNativeTransfers(); err != nil {
+		return ethcmn.Hash{}, err
+	}
+
 	// NOTE: Ethereum returns the trie merkle root here, but as commitment
 	// actually happens in the BaseApp at EndBlocker, we do not know the root at
 	// this time.
@@ -628,6 +649,9 @@ func (csdb *CommitStateDB) deleteStateObject(so *stateObject) {
 
 // Snapshot returns an identifier for the current revision of the state.
 func (csdb *CommitStateDB) Snapshot() int {
+	// a new call frame is entered, see nativeTokenTransfer
+	csdb.nativeTokenCaller = nil
+
 	id := csdb.nextRevisionID
 	csdb.nextRevisionID++
 
@@ -724,6 +748,8 @@ func (csdb *CommitStateDB) Reset(_ ethcmn.Hash) error {
 	csdb.preimages = []preimageEntry{}
 	csdb.hashToPreimageIndex = make(map[ethcmn.Hash]int)
 	csdb.accessList = newAccessList()
+	csdb.nativeTransfers = nil
+	csdb.nativeTokenCaller = nil
 	csdb.params = nil
 
 	csdb.clearJournalAndRefund()
@@ -995,3 +1021,65 @@ func (csdb *CommitStateDB) GetContractBlockedList() (blockedList AddressList) {
 func (csdb *CommitStateDB) IsContractInBlockedList(contractAddr sdk.AccAddress) bool {
 	return csdb.ctx.KVStore(csdb.storeKey).Has(getContractBlockedListMemberKey(contractAddr))
 }
+
+// SetContractMethodBlockedList adds the methods of the contracts into the method blocked list store
+func (csdb *CommitStateDB) SetContractMethodBlockedList(contractList BlockedContractList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(contractList); i++ {
+		methods := csdb.GetContractBlockedMethods(contractList[i].Address).merge(contractList[i].BlockMethods)
+		store.Set(getContractMethodBlockedListMemberKey(contractList[i].Address), ModuleCdc.MustMarshalBinaryLengthPrefixed(methods))
+	}
+}
+
+// DeleteContractMethodBlockedList deletes the methods of the contracts from the method blocked list store. The contract
+// is removed once none of its methods is blocked.
+func (csdb *CommitStateDB) DeleteContractMethodBlockedList(contractList BlockedContractList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(contractList); i++ {
+		key := getContractMethodBlockedListMemberKey(contractList[i].Address)
+		methods := csdb.GetContractBlockedMethods(contractList[i].Address).subtract(contractList[i].BlockMethods)
+		if len(methods) == 0 {
+			store.Delete(key)
+			continue
+		}
+		store.Set(key, ModuleCdc.MustMarshalBinaryLengthPrefixed(methods))
+	}
+}
+
+// GetContractMethodBlockedList gets the whole contract method blocked list currently
+func (csdb *CommitStateDB) GetContractMethodBlockedList() (contractList BlockedContractList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyPrefixContractMethodBlockedList)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var methods ContractMethods
+		ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &methods)
+		contractList = append(contractList, NewBlockedContract(splitBlockedContractAddress(iterator.Key()), methods))
+	}
+
+	return
+}
+
+// GetContractBlockedMethods gets the blocked methods of the contract
+func (csdb *CommitStateDB) GetContractBlockedMethods(contractAddr sdk.AccAddress) (methods ContractMethods) {
+	bz := csdb.ctx.KVStore(csdb.storeKey).Get(getContractMethodBlockedListMemberKey(contractAddr))
+	if len(bz) == 0 {
+		return
+	}
+
+	ModuleCdc.MustUnmarshalBinaryLengthPrefixed(bz, &methods)
+	return
+}
+
+// HasContractMethodBlockedList checks whether there is any contract with blocked methods
+func (csdb *CommitStateDB) HasContractMethodBlockedList() bool {
+	iterator := sdk.KVStorePrefixIterator(csdb.ctx.KVStore(csdb.storeKey), KeyPrefixContractMethodBlockedList)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// IsContractMethodBlocked checks whether the method of the contract is in the method blocked list
+func (csdb *CommitStateDB) IsContractMethodBlocked(contractAddr sdk.AccAddress, selector []byte) bool {
+	return csdb.GetContractBlockedMethods(contractAddr).Contains(selector)
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/okex/exchain/app"
@@ -835,3 +836,41 @@ func (suite *StateDBTestSuite) TestCommitStateDB_ContractBlockedList() {
 		})
 	}
 }
+
+func (suite *StateDBTestSuite) TestCommitStateDB_StateOverride() {
+	key1, key2 := ethcmn.BytesToHash([]byte("key1")), ethcmn.BytesToHash([]byte("key2"))
+	value1, value2 := ethcmn.BytesToHash([]byte("value1")), ethcmn.BytesToHash([]byte("value2"))
+
+	// committed storage of the account
+	suite.stateDB.SetState(suite.address, key1, value1)
+	_, err := suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	nonce := hexutil.Uint64(5)
+	code := hexutil.Bytes("code")
+	balance := (*hexutil.Big)(big.NewInt(100))
+	stateDiff := map[ethcmn.Hash]ethcmn.Hash{key2: value2}
+	overrides := types.StateOverride{
+		suite.address: {Nonce: &nonce, Code: &code, Balance: &balance, StateDiff: &stateDiff},
+	}
+	suite.Require().NoError(overrides.Apply(suite.stateDB))
+	suite.Require().Equal(uint64(5), suite.stateDB.GetNonce(suite.address))
+	suite.Require().Equal([]byte("code"), suite.stateDB.GetCode(suite.address))
+	suite.Require().Equal(big.NewInt(100), suite.stateDB.GetBalance(suite.address))
+	suite.Require().Equal(value1, suite.stateDB.GetState(suite.address, key1))
+	suite.Require().Equal(value2, suite.stateDB.GetState(suite.address, key2))
+
+	// the entire storage is replaced
+	state := map[ethcmn.Hash]ethcmn.Hash{key2: value1}
+	overrides = types.StateOverride{suite.address: {State: &state}}
+	suite.Require().NoError(overrides.Apply(suite.stateDB))
+	suite.Require().Equal(ethcmn.Hash{}, suite.stateDB.GetState(suite.address, key1))
+	suite.Require().Equal(value1, suite.stateDB.GetState(suite.address, key2))
+
+	// state and stateDiff can't be specified at the same time
+	overrides = types.StateOverride{suite.address: {State: &state, StateDiff: &stateDiff}}
+	suite.Require().Error(overrides.Apply(suite.stateDB))
+
+	var nilOverrides *types.StateOverride
+	suite.Require().NoError(nilOverrides.Apply(suite.stateDB))
+}
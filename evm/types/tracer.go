@@ -0,0 +1,176 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+const (
+	// defaultTraceTimeout is the amount of time a single transaction can execute
+	// by default before being forcefully aborted.
+	defaultTraceTimeout = 5 * time.Second
+)
+
+// TraceConfig holds the extra parameters of the debug_trace* json-rpc calls. The field names
+// follow the geth ones so that the existing tooling can be used without modification.
+type TraceConfig struct {
+	// Tracer is the name of a built-in tracer (e.g. callTracer) or the javascript code of a custom
+	// tracer. The struct logger is used when it's empty.
+	Tracer string `json:"tracer"`
+	// Timeout overrides the default timeout of javascript-based tracers, e.g. "10s"
+	Timeout string `json:"timeout"`
+
+	DisableStorage    bool  `json:"disableStorage"`
+	DisableMemory     bool  `json:"disableMemory"`
+	DisableStack      bool  `json:"disableStack"`
+	DisableReturnData bool  `json:"disableReturnData"`
+	Limit             int64 `json:"limit"`
+}
+
+// NewTracer creates the vm.Tracer requested by the trace config. The returned release function must be called once
+// the tracing ends, to stop the timeout timer of the javascript tracer
+func (tc TraceConfig) NewTracer(txCtx vm.TxContext) (tracer vm.Tracer, release func(), err error) {
+	release = func() {}
+	if tc.Tracer == "" {
+		return vm.NewStructLogger(&vm.LogConfig{
+			DisableMemory:     tc.DisableMemory,
+			DisableStack:      tc.DisableStack,
+			DisableStorage:    tc.DisableStorage,
+			DisableReturnData: tc.DisableReturnData,
+			Limit:             int(tc.Limit),
+		}), release, nil
+	}
+
+	timeout := defaultTraceTimeout
+	if tc.Timeout != "" {
+		if timeout, err = time.ParseDuration(tc.Timeout); err != nil {
+			return nil, release, fmt.Errorf("failed to parse trace timeout %s: %s", tc.Timeout, err)
+		}
+	}
+
+	jsTracer, err := tracers.New(tc.Tracer, txCtx)
+	if err != nil {
+		return nil, release, err
+	}
+
+	// abort the javascript tracer once the deadline is reached
+	timer := time.AfterFunc(timeout, func() {
+		jsTracer.Stop(errors.New("execution timeout"))
+	})
+
+	return jsTracer, func() { timer.Stop() }, nil
+}
+
+// QueryTraceParams defines the parameters of the trace query. Every raw tx in Txs except the last one
+// is replayed without tracing, then the last one is traced. If Call is set, all the txs are replayed
+// and the call message is traced instead.
+type QueryTraceParams struct {
+	Txs          [][]byte      `json:"txs"`
+	Call         *MsgEthermint `json:"call"`
+	BlockHash    ethcmn.Hash   `json:"block_hash"`
+	BlockHeight  int64         `json:"block_height"`
+	BlockTime    time.Time     `json:"block_time"`
+	ProposerAddr []byte        `json:"proposer_address"`
+	Config       TraceConfig   `json:"config"`
+}
+
+// NewQueryTraceParams creates a new instance of QueryTraceParams
+func NewQueryTraceParams(txs [][]byte, call *MsgEthermint, blockHash ethcmn.Hash, blockHeight int64,
+	blockTime time.Time, proposerAddr []byte, config TraceConfig) QueryTraceParams {
+	return QueryTraceParams{
+		Txs:          txs,
+		Call:         call,
+		BlockHash:    blockHash,
+		BlockHeight:  blockHeight,
+		BlockTime:    blockTime,
+		ProposerAddr: proposerAddr,
+		Config:       config,
+	}
+}
+
+// StructLogRes stores a structured log emitted by the EVM while replaying a
+// transaction in debug mode
+type StructLogRes struct {
+	Pc      uint64             `json:"pc"`
+	Op      string             `json:"op"`
+	Gas     uint64             `json:"gas"`
+	GasCost uint64             `json:"gasCost"`
+	Depth   int                `json:"depth"`
+	Error   string             `json:"error,omitempty"`
+	Stack   *[]string          `json:"stack,omitempty"`
+	Memory  *[]string          `json:"memory,omitempty"`
+	Storage *map[string]string `json:"storage,omitempty"`
+}
+
+// StructLogExecutionResult groups all structured logs emitted by the EVM
+// while replaying a transaction in debug mode as well as transaction
+// execution status, the amount of gas used and the return value
+type StructLogExecutionResult struct {
+	Gas         uint64         `json:"gas"`
+	Failed      bool           `json:"failed"`
+	ReturnValue string         `json:"returnValue"`
+	StructLogs  []StructLogRes `json:"structLogs"`
+}
+
+// FormatTraceResult encodes the result of the given tracer into the geth json-rpc output shape
+func FormatTraceResult(tracer vm.Tracer, gasUsed uint64, failed bool) (json.RawMessage, error) {
+	switch tracer := tracer.(type) {
+	case *vm.StructLogger:
+		return json.Marshal(StructLogExecutionResult{
+			Gas:         gasUsed,
+			Failed:      failed,
+			ReturnValue: fmt.Sprintf("%x", tracer.Output()),
+			StructLogs:  FormatLogs(tracer.StructLogs()),
+		})
+	case *tracers.Tracer:
+		return tracer.GetResult()
+	default:
+		return nil, fmt.Errorf("bad tracer type %T", tracer)
+	}
+}
+
+// FormatLogs formats EVM returned structured logs for json output
+func FormatLogs(logs []vm.StructLog) []StructLogRes {
+	formatted := make([]StructLogRes, len(logs))
+	for index, trace := range logs {
+		formatted[index] = StructLogRes{
+			Pc:      trace.Pc,
+			Op:      trace.Op.String(),
+			Gas:     trace.Gas,
+			GasCost: trace.GasCost,
+			Depth:   trace.Depth,
+		}
+		if trace.Err != nil {
+			formatted[index].Error = trace.Err.Error()
+		}
+		if trace.Stack != nil {
+			stack := make([]string, len(trace.Stack))
+			for i, stackValue := range trace.Stack {
+				stack[i] = hexutil.EncodeBig(stackValue)
+			}
+			formatted[index].Stack = &stack
+		}
+		if trace.Memory != nil {
+			memory := make([]string, 0, (len(trace.Memory)+31)/32)
+			for i := 0; i+32 <= len(trace.Memory); i += 32 {
+				memory = append(memory, fmt.Sprintf("%x", trace.Memory[i:i+32]))
+			}
+			formatted[index].Memory = &memory
+		}
+		if trace.Storage != nil {
+			storage := make(map[string]string)
+			for i, storageValue := range trace.Storage {
+				storage[fmt.Sprintf("%x", i)] = fmt.Sprintf("%x", storageValue)
+			}
+			formatted[index].Storage = &storage
+		}
+	}
+	return formatted
+}
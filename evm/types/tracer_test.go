@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func (suite *StateDBTestSuite) TestTraceStateTransition() {
+	testCases := []struct {
+		name    string
+		config  types.TraceConfig
+		expPass bool
+	}{
+		{"struct logger", types.TraceConfig{}, true},
+		{"struct logger without stack", types.TraceConfig{DisableStack: true}, true},
+		{"call tracer", types.TraceConfig{Tracer: "callTracer"}, true},
+		{"invalid timeout", types.TraceConfig{Tracer: "callTracer", Timeout: "ten seconds"}, false},
+		{"invalid tracer", types.TraceConfig{Tracer: "{"}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tracer, release, err := tc.config.NewTracer(vm.TxContext{Origin: suite.address, GasPrice: big.NewInt(1)})
+			defer release()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// PUSH1 0x01 PUSH1 0x01 SSTORE
+			st := types.StateTransition{
+				AccountNonce: 0,
+				Price:        big.NewInt(1),
+				GasLimit:     100000,
+				Amount:       big.NewInt(0),
+				Payload:      ethcmn.FromHex("0x6001600155"),
+				ChainID:      big.NewInt(1),
+				Csdb:         suite.stateDB,
+				TxHash:       &ethcmn.Hash{},
+				Sender:       suite.address,
+				Simulate:     true,
+				Tracer:       tracer,
+			}
+
+			ctx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			_, _, err = st.TransitionDb(ctx, types.DefaultChainConfig())
+			suite.Require().NoError(err)
+
+			res, err := types.FormatTraceResult(tracer, ctx.GasMeter().GasConsumed(), false)
+			suite.Require().NoError(err)
+
+			if tc.config.Tracer != "" {
+				suite.Require().True(json.Valid(res))
+				return
+			}
+
+			var result types.StructLogExecutionResult
+			suite.Require().NoError(json.Unmarshal(res, &result))
+			suite.Require().False(result.Failed)
+			suite.Require().Len(result.StructLogs, 4)
+			suite.Require().Equal("SSTORE", result.StructLogs[2].Op)
+			suite.Require().Equal(tc.config.DisableStack, result.StructLogs[0].Stack == nil)
+		})
+	}
+}
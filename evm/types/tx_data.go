@@ -24,6 +24,14 @@ type TxData struct {
 	R *big.Int `json:"r"`
 	S *big.Int `json:"s"`
 
+	// typed transaction values (EIP-2718), they are empty for legacy transactions and excluded from the legacy RLP
+	// encoding. The Price of an EIP-1559 transaction is the effective gas price derived from the fee caps
+	Type      uint8      `json:"type" rlp:"-"`
+	ChainID   *big.Int   `json:"chainId" rlp:"-"`
+	GasTipCap *big.Int   `json:"maxPriorityFeePerGas" rlp:"-"`
+	GasFeeCap *big.Int   `json:"maxFeePerGas" rlp:"-"`
+	Accesses  AccessList `json:"accessList" rlp:"-"`
+
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
 }
@@ -45,6 +53,13 @@ type encodableTxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// typed transaction values, appended so that the encoding of legacy transactions stays the same
+	Type      uint64     `json:"type"`
+	ChainID   string     `json:"chainId"`
+	GasTipCap string     `json:"maxPriorityFeePerGas"`
+	GasFeeCap string     `json:"maxFeePerGas"`
+	Accesses  AccessList `json:"accessList"`
 }
 
 func (td TxData) String() string {
@@ -57,6 +72,12 @@ func (td TxData) String() string {
 		td.AccountNonce, td.Price, td.GasLimit, td.Amount, td.Payload, td.V, td.R, td.S)
 }
 
+// hasTypedValues returns true if any of the typed transaction values is set
+func (td TxData) hasTypedValues() bool {
+	return td.Type != LegacyTxType || td.ChainID != nil || td.GasTipCap != nil || td.GasFeeCap != nil ||
+		len(td.Accesses) > 0
+}
+
 // MarshalAmino defines custom encoding scheme for TxData
 func (td TxData) MarshalAmino() ([]byte, error) {
 	gasPrice, err := utils.MarshalBigInt(td.Price)
@@ -95,6 +116,20 @@ func (td TxData) MarshalAmino() ([]byte, error) {
 		R:            r,
 		S:            s,
 		Hash:         td.Hash,
+		Type:         uint64(td.Type),
+		Accesses:     td.Accesses,
+	}
+
+	if e.ChainID, err = marshalOptionalBigInt(td.ChainID); err != nil {
+		return nil, err
+	}
+
+	if e.GasTipCap, err = marshalOptionalBigInt(td.GasTipCap); err != nil {
+		return nil, err
+	}
+
+	if e.GasFeeCap, err = marshalOptionalBigInt(td.GasFeeCap); err != nil {
+		return nil, err
 	}
 
 	return ModuleCdc.MarshalBinaryBare(e)
@@ -169,9 +204,43 @@ func (td *TxData) UnmarshalAmino(data []byte) error {
 		td.S = s
 	}
 
+	if e.Type > DynamicFeeTxType {
+		return fmt.Errorf("transaction type %d not supported", e.Type)
+	}
+	td.Type = uint8(e.Type)
+	td.Accesses = e.Accesses
+
+	if td.ChainID, err = unmarshalOptionalBigInt(e.ChainID); err != nil {
+		return err
+	}
+
+	if td.GasTipCap, err = unmarshalOptionalBigInt(e.GasTipCap); err != nil {
+		return err
+	}
+
+	if td.GasFeeCap, err = unmarshalOptionalBigInt(e.GasFeeCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// marshalOptionalBigInt marshals the big int into text string, and a nil one into an empty string
+func marshalOptionalBigInt(i *big.Int) (string, error) {
+	if i == nil {
+		return "", nil
+	}
+	return utils.MarshalBigInt(i)
+}
+
+// unmarshalOptionalBigInt unmarshals the text string into a big int, and an empty string into nil
+func unmarshalOptionalBigInt(s string) (*big.Int, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	return utils.UnmarshalBigInt(s)
+}
+
 // TODO: Implement JSON marshaling/ unmarshaling for this type
 
 // TODO: Implement YAML marshaling/ unmarshaling for this type
@@ -0,0 +1,244 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"golang.org/x/crypto/sha3"
+)
+
+// transaction types defined by EIP-2718
+const (
+	// LegacyTxType is the type of the transactions before EIP-2718
+	LegacyTxType = iota
+	// AccessListTxType is the type of the EIP-2930 transactions
+	AccessListTxType
+	// DynamicFeeTxType is the type of the EIP-1559 transactions
+	DynamicFeeTxType
+)
+
+// TypedTxHeight is the height from which the typed transactions are accepted by the chain. It's consensus-critical, so
+// it's fixed for the chain like sdk.DefaultLevelUpBlockHeight instead of being configured by each node, and it stays
+// unreachable until the upgrade of the typed transactions is scheduled
+var TypedTxHeight int64 = math.MaxInt64
+
+// IsTypedTxEnabled returns true if the typed transactions are accepted at the height
+func IsTypedTxEnabled(height int64) bool {
+	return height >= TypedTxHeight
+}
+
+// intrinsic gas of the access list defined by EIP-2930
+const (
+	// TxAccessListAddressGas is the intrinsic gas charged per address in the access list
+	TxAccessListAddressGas uint64 = 2400
+	// TxAccessListStorageKeyGas is the intrinsic gas charged per storage key in the access list
+	TxAccessListStorageKeyGas uint64 = 1900
+)
+
+var errEmptyTypedTx = errors.New("typed transaction too short")
+
+// AccessList is an EIP-2930 access list
+type AccessList []AccessTuple
+
+// AccessTuple is the element type of an access list
+type AccessTuple struct {
+	Address     ethcmn.Address `json:"address"`
+	StorageKeys []ethcmn.Hash  `json:"storageKeys"`
+}
+
+// StorageKeys returns the total number of storage keys in the access list
+func (al AccessList) StorageKeys() int {
+	sum := 0
+	for _, tuple := range al {
+		sum += len(tuple.StorageKeys)
+	}
+	return sum
+}
+
+// IntrinsicGas returns the intrinsic gas charged for the addresses and storage keys in the access list
+func (al AccessList) IntrinsicGas() uint64 {
+	return uint64(len(al))*TxAccessListAddressGas + uint64(al.StorageKeys())*TxAccessListStorageKeyGas
+}
+
+// accessListTx is the RLP payload of an EIP-2930 transaction
+type accessListTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasPrice   *big.Int
+	Gas        uint64
+	To         *ethcmn.Address `rlp:"nil"`
+	Value      *big.Int
+	Data       []byte
+	AccessList AccessList
+	V, R, S    *big.Int
+}
+
+// dynamicFeeTx is the RLP payload of an EIP-1559 transaction
+type dynamicFeeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         *ethcmn.Address `rlp:"nil"`
+	Value      *big.Int
+	Data       []byte
+	AccessList AccessList
+	V, R, S    *big.Int
+}
+
+// decodeTypedTxData decodes the EIP-2718 envelope (type byte || RLP payload) of a typed transaction
+func decodeTypedTxData(b []byte) (TxData, error) {
+	if len(b) <= 1 {
+		return TxData{}, errEmptyTypedTx
+	}
+
+	switch b[0] {
+	case AccessListTxType:
+		var inner accessListTx
+		if err := rlp.DecodeBytes(b[1:], &inner); err != nil {
+			return TxData{}, err
+		}
+		return TxData{
+			AccountNonce: inner.Nonce,
+			Price:        inner.GasPrice,
+			GasLimit:     inner.Gas,
+			Recipient:    inner.To,
+			Amount:       inner.Value,
+			Payload:      inner.Data,
+			V:            inner.V,
+			R:            inner.R,
+			S:            inner.S,
+			Type:         AccessListTxType,
+			ChainID:      inner.ChainID,
+			Accesses:     inner.AccessList,
+		}, nil
+
+	case DynamicFeeTxType:
+		var inner dynamicFeeTx
+		if err := rlp.DecodeBytes(b[1:], &inner); err != nil {
+			return TxData{}, err
+		}
+		return TxData{
+			AccountNonce: inner.Nonce,
+			Price:        EffectiveGasPrice(inner.GasTipCap, inner.GasFeeCap),
+			GasLimit:     inner.Gas,
+			Recipient:    inner.To,
+			Amount:       inner.Value,
+			Payload:      inner.Data,
+			V:            inner.V,
+			R:            inner.R,
+			S:            inner.S,
+			Type:         DynamicFeeTxType,
+			ChainID:      inner.ChainID,
+			GasTipCap:    inner.GasTipCap,
+			GasFeeCap:    inner.GasFeeCap,
+			Accesses:     inner.AccessList,
+		}, nil
+
+	default:
+		return TxData{}, fmt.Errorf("transaction type %d not supported", b[0])
+	}
+}
+
+// encodeTypedTxData encodes the typed transaction into its EIP-2718 envelope
+func encodeTypedTxData(td TxData) ([]byte, error) {
+	var inner interface{}
+	switch td.Type {
+	case AccessListTxType:
+		inner = &accessListTx{
+			ChainID:    td.ChainID,
+			Nonce:      td.AccountNonce,
+			GasPrice:   td.Price,
+			Gas:        td.GasLimit,
+			To:         td.Recipient,
+			Value:      td.Amount,
+			Data:       td.Payload,
+			AccessList: td.Accesses,
+			V:          td.V,
+			R:          td.R,
+			S:          td.S,
+		}
+	case DynamicFeeTxType:
+		inner = &dynamicFeeTx{
+			ChainID:    td.ChainID,
+			Nonce:      td.AccountNonce,
+			GasTipCap:  td.GasTipCap,
+			GasFeeCap:  td.GasFeeCap,
+			Gas:        td.GasLimit,
+			To:         td.Recipient,
+			Value:      td.Amount,
+			Data:       td.Payload,
+			AccessList: td.Accesses,
+			V:          td.V,
+			R:          td.R,
+			S:          td.S,
+		}
+	default:
+		return nil, fmt.Errorf("transaction type %d not supported", td.Type)
+	}
+
+	payload, err := rlp.EncodeToBytes(inner)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{td.Type}, payload...), nil
+}
+
+// typedSigHash returns the hash to be signed by the sender of the typed transaction
+func typedSigHash(td TxData, chainID *big.Int) ethcmn.Hash {
+	switch td.Type {
+	case AccessListTxType:
+		return prefixedRlpHash(td.Type, []interface{}{
+			chainID,
+			td.AccountNonce,
+			td.Price,
+			td.GasLimit,
+			td.Recipient,
+			td.Amount,
+			td.Payload,
+			td.Accesses,
+		})
+	case DynamicFeeTxType:
+		return prefixedRlpHash(td.Type, []interface{}{
+			chainID,
+			td.AccountNonce,
+			td.GasTipCap,
+			td.GasFeeCap,
+			td.GasLimit,
+			td.Recipient,
+			td.Amount,
+			td.Payload,
+			td.Accesses,
+		})
+	default:
+		panic(fmt.Sprintf("transaction type %d not supported", td.Type))
+	}
+}
+
+// prefixedRlpHash writes the prefix into the hasher before rlp-encoding x
+func prefixedRlpHash(prefix byte, x interface{}) (hash ethcmn.Hash) {
+	hasher := sha3.NewLegacyKeccak256()
+	_, _ = hasher.Write([]byte{prefix})
+	_ = rlp.Encode(hasher, x)
+	_ = hasher.Sum(hash[:0])
+
+	return hash
+}
+
+// EffectiveGasPrice returns the gas price paid by an EIP-1559 transaction. There is no base fee on the chain, so the
+// price is the gas tip cap bounded by the gas fee cap
+func EffectiveGasPrice(gasTipCap, gasFeeCap *big.Int) *big.Int {
+	if gasTipCap == nil || gasFeeCap == nil {
+		return new(big.Int)
+	}
+
+	if gasTipCap.Cmp(gasFeeCap) > 0 {
+		return new(big.Int).Set(gasFeeCap)
+	}
+	return new(big.Int).Set(gasTipCap)
+}
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+)
+
+func newTestTypedMsg(txType uint8) MsgEthereumTx {
+	to := ethcmn.BytesToAddress([]byte("test_address"))
+	msg := NewMsgEthereumTx(1, &to, big.NewInt(10), 100000, big.NewInt(5), []byte("test"))
+	msg.Data.Type = txType
+	msg.Data.Accesses = AccessList{
+		{Address: to, StorageKeys: []ethcmn.Hash{ethcmn.BytesToHash([]byte{1}), ethcmn.BytesToHash([]byte{2})}},
+	}
+	if txType == DynamicFeeTxType {
+		msg.Data.GasTipCap = big.NewInt(2)
+		msg.Data.GasFeeCap = big.NewInt(5)
+		msg.Data.Price = EffectiveGasPrice(msg.Data.GasTipCap, msg.Data.GasFeeCap)
+	}
+	return msg
+}
+
+func TestTypedTxSignAndDecode(t *testing.T) {
+	chainID := big.NewInt(3)
+	priv, _ := ethsecp256k1.GenerateKey()
+	addr := ethcmn.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	for _, txType := range []uint8{AccessListTxType, DynamicFeeTxType} {
+		msg := newTestTypedMsg(txType)
+		require.NoError(t, msg.Sign(chainID, priv.ToECDSA()))
+		require.True(t, msg.Data.V.Cmp(big.NewInt(1)) <= 0)
+		require.Equal(t, chainID, msg.ChainID())
+
+		raw, err := msg.MarshalBinary()
+		require.NoError(t, err)
+		require.Equal(t, txType, raw[0])
+
+		var decoded MsgEthereumTx
+		require.NoError(t, decoded.UnmarshalBinary(raw))
+		require.Equal(t, msg.Data, decoded.Data)
+		require.NoError(t, decoded.ValidateBasic())
+
+		signer, err := decoded.VerifySig(chainID)
+		require.NoError(t, err)
+		require.Equal(t, addr, signer)
+
+		// the chain id is part of the signature
+		decoded = MsgEthereumTx{}
+		require.NoError(t, decoded.UnmarshalBinary(raw))
+		_, err = decoded.VerifySig(big.NewInt(4))
+		require.Error(t, err)
+
+		// the raw transaction is accepted by the tx decoder
+		tx, err := TxDecoder(ModuleCdc)(raw)
+		require.NoError(t, err)
+		ethTx, ok := tx.(MsgEthereumTx)
+		require.True(t, ok)
+		require.Equal(t, msg.Data, ethTx.Data)
+
+		// amino encoding
+		bz, err := ModuleCdc.MarshalBinaryBare(msg)
+		require.NoError(t, err)
+		var aminoMsg MsgEthereumTx
+		require.NoError(t, ModuleCdc.UnmarshalBinaryBare(bz, &aminoMsg))
+		require.Equal(t, msg.Data, aminoMsg.Data)
+	}
+}
+
+func TestLegacyTxUnmarshalBinary(t *testing.T) {
+	raw := ethcmn.FromHex("E48080830186A0940000000000000000746573745F61646472657373808474657374808080")
+	addr := ethcmn.BytesToAddress([]byte("test_address"))
+	expectedMsg := NewMsgEthereumTx(0, &addr, nil, 100000, nil, []byte("test"))
+
+	var msg MsgEthereumTx
+	require.NoError(t, msg.UnmarshalBinary(raw))
+	require.Equal(t, expectedMsg.Data, msg.Data)
+
+	bz, err := msg.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, raw, bz)
+}
+
+func TestTypedTxInvalid(t *testing.T) {
+	var msg MsgEthereumTx
+	require.Error(t, msg.UnmarshalBinary([]byte{AccessListTxType}))
+	require.Error(t, msg.UnmarshalBinary([]byte{0x03, 0xc0}))
+
+	msg = newTestTypedMsg(DynamicFeeTxType)
+	msg.Data.GasTipCap = big.NewInt(6)
+	require.NoError(t, msg.ValidateBasic())
+	require.Error(t, msg.ValidateTypedTx(TypedTxHeight))
+}
+
+func TestValidateTypedTx(t *testing.T) {
+	defer func(height int64) { TypedTxHeight = height }(TypedTxHeight)
+	TypedTxHeight = 10
+
+	legacy := newTestTypedMsg(LegacyTxType)
+	legacy.Data.Accesses = nil
+	accessList := newTestTypedMsg(AccessListTxType)
+	dynamicFee := newTestTypedMsg(DynamicFeeTxType)
+
+	// none of the typed transaction values is accepted before the typed tx height
+	require.NoError(t, legacy.ValidateTypedTx(9))
+	require.Error(t, accessList.ValidateTypedTx(9))
+	require.Error(t, dynamicFee.ValidateTypedTx(9))
+	withAccesses := newTestTypedMsg(LegacyTxType)
+	require.Error(t, withAccesses.ValidateTypedTx(9))
+
+	require.NoError(t, legacy.ValidateTypedTx(10))
+	require.NoError(t, accessList.ValidateTypedTx(10))
+	require.NoError(t, dynamicFee.ValidateTypedTx(10))
+
+	// the unsigned access list of a legacy transaction is never accepted
+	require.Error(t, withAccesses.ValidateTypedTx(10))
+
+	accessList.Data.GasFeeCap = big.NewInt(5)
+	require.Error(t, accessList.ValidateTypedTx(10))
+
+	dynamicFee.Data.GasFeeCap = nil
+	require.Error(t, dynamicFee.ValidateTypedTx(10))
+
+	dynamicFee.Data.Type = DynamicFeeTxType + 1
+	require.Error(t, dynamicFee.ValidateTypedTx(10))
+}
+
+func TestHeightTxDecoder(t *testing.T) {
+	defer func(height int64) { TypedTxHeight = height }(TypedTxHeight)
+	TypedTxHeight = 10
+
+	cdc := codec.New()
+	cdc.RegisterInterface((*sdk.Tx)(nil), nil)
+	RegisterCodec(cdc)
+
+	msg := newTestTypedMsg(DynamicFeeTxType)
+	raw, err := msg.MarshalBinary()
+	require.NoError(t, err)
+	aminoBz := cdc.MustMarshalBinaryLengthPrefixed(msg)
+
+	legacy := newTestTypedMsg(LegacyTxType)
+	legacy.Data.Accesses = nil
+	legacyAminoBz := cdc.MustMarshalBinaryLengthPrefixed(legacy)
+
+	height := int64(9)
+	txDecoder := HeightTxDecoder(cdc, func() int64 { return height })
+
+	// the typed transactions are rejected before the typed tx height, whatever their encoding is
+	_, err = txDecoder(raw)
+	require.Error(t, err)
+	_, err = txDecoder(aminoBz)
+	require.Error(t, err)
+	tx, err := txDecoder(legacyAminoBz)
+	require.NoError(t, err)
+	require.Equal(t, legacy.Data, tx.(MsgEthereumTx).Data)
+
+	height = 10
+	tx, err = txDecoder(raw)
+	require.NoError(t, err)
+	require.Equal(t, msg.Data, tx.(MsgEthereumTx).Data)
+	tx, err = txDecoder(aminoBz)
+	require.NoError(t, err)
+	require.Equal(t, msg.Data, tx.(MsgEthereumTx).Data)
+
+	// the raw legacy transactions are never decoded, they are amino encoded only
+	legacyRaw, err := legacy.MarshalBinary()
+	require.NoError(t, err)
+	_, err = txDecoder(legacyRaw)
+	require.Error(t, err)
+}
+
+func TestAccessListIntrinsicGas(t *testing.T) {
+	require.Equal(t, uint64(0), AccessList(nil).IntrinsicGas())
+
+	msg := newTestTypedMsg(AccessListTxType)
+	require.Equal(t, 2, msg.Data.Accesses.StorageKeys())
+	require.Equal(t, TxAccessListAddressGas+2*TxAccessListStorageKeyGas, msg.Data.Accesses.IntrinsicGas())
+}
+
+func TestEffectiveGasPrice(t *testing.T) {
+	require.Equal(t, big.NewInt(2), EffectiveGasPrice(big.NewInt(2), big.NewInt(5)))
+	require.Equal(t, big.NewInt(5), EffectiveGasPrice(big.NewInt(7), big.NewInt(5)))
+	require.Equal(t, new(big.Int), EffectiveGasPrice(nil, big.NewInt(5)))
+}
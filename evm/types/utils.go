@@ -95,25 +95,64 @@ func DecodeResultData(in []byte) (ResultData, error) {
 // Auxiliary
 
 // TxDecoder returns an sdk.TxDecoder that can decode both auth.StdTx and
-// MsgEthereumTx transactions.
+// MsgEthereumTx transactions. The tx bytes are decoded by one encoding only:
+// the EIP-2718 typed transaction envelopes are decoded as raw Ethereum
+// transactions, and all the others are amino encoded.
 func TxDecoder(cdc *codec.Codec) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, error) {
-		var tx sdk.Tx
+		return decodeTx(cdc, txBytes, true)
+	}
+}
+
+// HeightTxDecoder returns the sdk.TxDecoder of the chain, which only decodes
+// the typed transactions from TypedTxHeight on. Before that height, the
+// EIP-2718 envelopes and the amino encoded transactions carrying typed
+// transaction values are rejected, so that the blocks before it are decoded
+// as they were before the typed transactions.
+func HeightTxDecoder(cdc *codec.Codec, height func() int64) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		return decodeTx(cdc, txBytes, IsTypedTxEnabled(height()))
+	}
+}
 
-		if len(txBytes) == 0 {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx bytes are empty")
+func decodeTx(cdc *codec.Codec, txBytes []byte, typedTxEnabled bool) (sdk.Tx, error) {
+	var tx sdk.Tx
+
+	if len(txBytes) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx bytes are empty")
+	}
+
+	if isTypedTxEnvelope(txBytes) {
+		if !typedTxEnabled {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "typed transactions are not enabled yet")
 		}
 
-		// sdk.Tx is an interface. The concrete message types
-		// are registered by MakeTxCodec
-		// TODO: switch to UnmarshalBinaryBare on SDK v0.40.0
-		err := cdc.UnmarshalBinaryLengthPrefixed(txBytes, &tx)
-		if err != nil {
+		var ethTx MsgEthereumTx
+		if err := ethTx.UnmarshalBinary(txBytes); err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
+		return ethTx, nil
+	}
+
+	// sdk.Tx is an interface. The concrete message types
+	// are registered by MakeTxCodec
+	// TODO: switch to UnmarshalBinaryBare on SDK v0.40.0
+	if err := cdc.UnmarshalBinaryLengthPrefixed(txBytes, &tx); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
 
-		return tx, nil
+	if ethTx, ok := tx.(MsgEthereumTx); ok && !typedTxEnabled && ethTx.Data.hasTypedValues() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "typed transactions are not enabled yet")
 	}
+
+	return tx, nil
+}
+
+// isTypedTxEnvelope returns true if the tx bytes start with an EIP-2718
+// transaction type. An amino length prefix of 1 or 2 bytes can never hold a
+// tx, so that a leading transaction type isn't ambiguous.
+func isTypedTxEnvelope(txBytes []byte) bool {
+	return txBytes[0] == AccessListTxType || txBytes[0] == DynamicFeeTxType
 }
 
 // recoverEthSig recovers a signature according to the Ethereum specification and
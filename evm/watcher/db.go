@@ -6,9 +6,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
-const FlagFastQuery = "fast-query"
+const (
+	FlagFastQuery = "fast-query"
+	// FlagRetainBlocks defines the number of recent blocks kept in the watch db, 0 keeps all the blocks
+	FlagRetainBlocks = "fast-query-retain-blocks"
+)
 
 type WatchStore struct {
 	db *leveldb.DB
@@ -32,6 +37,16 @@ func initDb() (*leveldb.DB, error) {
 	return leveldb.OpenFile(dbPath, nil)
 }
 
+// OpenWatchStore opens the watch db under the given home directory regardless of the fast query mode. It is used by
+// the offline maintenance commands, and the store must be closed by the caller.
+func OpenWatchStore(homeDir string) (*WatchStore, error) {
+	db, err := leveldb.OpenFile(filepath.Join(homeDir, "data/watch.db"), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WatchStore{db: db}, nil
+}
+
 func (w WatchStore) Set(key []byte, value []byte) {
 	w.db.Put(key, value, nil)
 }
@@ -39,3 +54,16 @@ func (w WatchStore) Set(key []byte, value []byte) {
 func (w WatchStore) Get(key []byte) ([]byte, error) {
 	return w.db.Get(key, nil)
 }
+
+func (w WatchStore) Delete(key []byte) {
+	w.db.Delete(key, nil)
+}
+
+// Compact compacts the whole key range of the watch db to reclaim the space of the deleted entries
+func (w WatchStore) Compact() error {
+	return w.db.CompactRange(util.Range{})
+}
+
+func (w WatchStore) Close() error {
+	return w.db.Close()
+}
@@ -0,0 +1,149 @@
+package watcher
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// pruneBatchSize is the number of deletions written to the db at once while pruning
+const pruneBatchSize = 10000
+
+// pruneMtx serializes the pruning, which runs in the background after each commit
+var pruneMtx sync.Mutex
+
+// RetainBlocks returns the number of recent blocks kept in the watch db, 0 means that no block is pruned
+func RetainBlocks() uint64 {
+	return viper.GetUint64(FlagRetainBlocks)
+}
+
+// GetLatestHeight returns the latest height saved in the watch db
+func (w WatchStore) GetLatestHeight() (uint64, error) {
+	return w.getHeight(prefixLatestHeight + KeyLatestHeight)
+}
+
+// GetPrunedHeight returns the height up to which the watch db has been pruned, 0 if it has never been pruned
+func (w WatchStore) GetPrunedHeight() (uint64, error) {
+	height, err := w.getHeight(prefixPrunedHeight + KeyPrunedHeight)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	return height, err
+}
+
+func (w WatchStore) getHeight(key string) (uint64, error) {
+	bz, err := w.Get([]byte(key))
+	if err != nil {
+		return 0, err
+	}
+	height, err := strconv.ParseUint(string(bz), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+// PruneTo deletes all the blocks of the heights up to the given height together with their transactions and receipts.
+// The contract codes are always kept since they are not bound to a height.
+func (w WatchStore) PruneTo(height uint64) error {
+	pruneMtx.Lock()
+	defer pruneMtx.Unlock()
+
+	pruned, err := w.GetPrunedHeight()
+	if err != nil {
+		return err
+	}
+	if height <= pruned {
+		return nil
+	}
+
+	if err := w.DeleteBlocks(pruned+1, height); err != nil {
+		return err
+	}
+	w.Set([]byte(prefixPrunedHeight+KeyPrunedHeight), []byte(strconv.FormatUint(height, 10)))
+	return nil
+}
+
+// DeleteBlocks deletes the blocks of the heights in [from, to] together with their transactions and receipts. The
+// heights which are not saved in the watch db are skipped.
+func (w WatchStore) DeleteBlocks(from, to uint64) error {
+	batch := new(leveldb.Batch)
+	for height := from; height <= to; height++ {
+		keys, err := w.blockKeys(height)
+		if err == leveldb.ErrNotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			batch.Delete(key)
+		}
+		if batch.Len() >= pruneBatchSize {
+			if err := w.db.Write(batch, nil); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	return w.db.Write(batch, nil)
+}
+
+// blockKeys returns the keys of the block info, the block, and the transactions and receipts of the block at the
+// given height
+func (w WatchStore) blockKeys(height uint64) ([][]byte, error) {
+	infoKey := []byte(prefixBlockInfo + strconv.FormatUint(height, 10))
+	hash, err := w.Get(infoKey)
+	if err != nil {
+		return nil, err
+	}
+
+	blockKey := []byte(prefixBlock + string(hash))
+	keys := [][]byte{infoKey, blockKey}
+
+	bz, err := w.Get(blockKey)
+	if err == leveldb.ErrNotFound {
+		return keys, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var block EthBlock
+	if err := json.Unmarshal(bz, &block); err != nil {
+		return nil, err
+	}
+	txs, _ := block.Transactions.([]interface{})
+	for _, tx := range txs {
+		txHash, ok := tx.(string)
+		if !ok {
+			continue
+		}
+		keys = append(keys, []byte(prefixTx+txHash), []byte(prefixReceipt+txHash))
+	}
+	return keys, nil
+}
+
+// Truncate keeps only the blocks of the heights in [start, end] and their transactions and receipts, and sets the
+// latest height to end
+func (w WatchStore) Truncate(start, end uint64) error {
+	latest, err := w.GetLatestHeight()
+	if err != nil {
+		return err
+	}
+
+	if start > 1 {
+		if err := w.PruneTo(start - 1); err != nil {
+			return err
+		}
+	}
+	if end < latest {
+		if err := w.DeleteBlocks(end+1, latest); err != nil {
+			return err
+		}
+		w.Set([]byte(prefixLatestHeight+KeyLatestHeight), []byte(strconv.FormatUint(end, 10)))
+	}
+	return nil
+}
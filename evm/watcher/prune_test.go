@@ -0,0 +1,83 @@
+package watcher
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func saveTestBlock(store *WatchStore, height uint64) common.Hash {
+	blockHash := common.BigToHash(new(big.Int).SetUint64(height))
+	txHash := common.BigToHash(new(big.Int).SetUint64(height + 1000))
+	msgs := []WatchMessage{
+		NewMsgBlock(height, ethtypes.Bloom{}, blockHash, abci.Header{Height: int64(height)}, 0, big.NewInt(0), []common.Hash{txHash}),
+		NewMsgBlockInfo(height, blockHash),
+		NewMsgLatestHeight(height),
+	}
+	for _, msg := range msgs {
+		store.Set([]byte(msg.GetKey()), []byte(msg.GetValue()))
+	}
+	store.Set([]byte(prefixTx+txHash.String()), []byte("tx"))
+	store.Set([]byte(prefixReceipt+txHash.String()), []byte("receipt"))
+	return txHash
+}
+
+func TestPruneAndTruncate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch_db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := OpenWatchStore(dir)
+	require.NoError(t, err)
+	defer store.Close()
+
+	txHashes := make(map[uint64]common.Hash)
+	for h := uint64(1); h <= 10; h++ {
+		txHashes[h] = saveTestBlock(store, h)
+	}
+
+	exists := func(height uint64) bool {
+		_, err := store.Get([]byte(prefixTx + txHashes[height].String()))
+		if err == leveldb.ErrNotFound {
+			return false
+		}
+		require.NoError(t, err)
+		_, err = store.Get([]byte(prefixBlockInfo + strconv.FormatUint(height, 10)))
+		return err == nil
+	}
+
+	require.NoError(t, store.PruneTo(4))
+	pruned, err := store.GetPrunedHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), pruned)
+	for h := uint64(1); h <= 4; h++ {
+		require.False(t, exists(h))
+		_, err := store.Get([]byte(prefixReceipt + txHashes[h].String()))
+		require.Equal(t, leveldb.ErrNotFound, err)
+	}
+	require.True(t, exists(5))
+
+	// pruning below the pruned height is a no-op
+	require.NoError(t, store.PruneTo(2))
+	pruned, err = store.GetPrunedHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), pruned)
+
+	require.NoError(t, store.Truncate(6, 8))
+	for h := uint64(1); h <= 10; h++ {
+		require.Equal(t, h >= 6 && h <= 8, exists(h), "height %d", h)
+	}
+	latest, err := store.GetLatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), latest)
+
+	require.NoError(t, store.Compact())
+}
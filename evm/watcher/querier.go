@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -181,3 +182,52 @@ func (q Querier) getTransactionByBlockAndIndex(block *EthBlock, idx uint) (*rpct
 	}
 	return nil, errors.New("no such transaction in target block")
 }
+
+// GetBlockReceipts returns the receipts of all the transactions in the block identified by hash
+func (q Querier) GetBlockReceipts(hash common.Hash) ([]*TransactionReceipt, error) {
+	if !q.enabled() {
+		return nil, errors.New(MsgFunctionDisable)
+	}
+	block, e := q.GetBlockByHash(hash, false)
+	if e != nil {
+		return nil, e
+	}
+	return q.getBlockReceipts(block)
+}
+
+// GetBlockReceiptsByNumber returns the receipts of all the transactions in the block identified by number
+func (q Querier) GetBlockReceiptsByNumber(number uint64) ([]*TransactionReceipt, error) {
+	if !q.enabled() {
+		return nil, errors.New(MsgFunctionDisable)
+	}
+	block, e := q.GetBlockByNumber(number, false)
+	if e != nil {
+		return nil, e
+	}
+	return q.getBlockReceipts(block)
+}
+
+func (q Querier) getBlockReceipts(block *EthBlock) ([]*TransactionReceipt, error) {
+	receipts := []*TransactionReceipt{}
+	if block.Transactions == nil {
+		return receipts, nil
+	}
+
+	txsHash, ok := block.Transactions.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected transactions type %T of block %s", block.Transactions, block.Hash.Hex())
+	}
+	for _, tx := range txsHash {
+		txHash, ok := tx.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected transaction hash type %T of block %s", tx, block.Hash.Hex())
+		}
+		receipt, e := q.GetTransactionReceipt(common.HexToHash(txHash))
+		if e != nil {
+			// the receipts of the block are incomplete, e.g. the receipt has been pruned
+			return nil, e
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
@@ -0,0 +1,83 @@
+package watcher
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestGetBlockReceipts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch_db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := OpenWatchStore(dir)
+	require.NoError(t, err)
+	defer store.Close()
+
+	const height = 10
+	blockHash := common.BigToHash(big.NewInt(height))
+	txHashes := []common.Hash{common.BytesToHash([]byte("tx0")), common.BytesToHash([]byte("tx1"))}
+	msgs := []WatchMessage{
+		NewMsgBlock(height, ethtypes.Bloom{}, blockHash, abci.Header{Height: height}, 0, big.NewInt(0), txHashes),
+		NewMsgBlockInfo(height, blockHash),
+		NewMsgLatestHeight(height),
+	}
+	for _, msg := range msgs {
+		store.Set([]byte(msg.GetKey()), []byte(msg.GetValue()))
+	}
+	for i, txHash := range txHashes {
+		receipt, err := json.Marshal(TransactionReceipt{
+			TransactionHash:  txHash.String(),
+			BlockHash:        blockHash.String(),
+			BlockNumber:      height,
+			TransactionIndex: hexutil.Uint64(i),
+		})
+		require.NoError(t, err)
+		store.Set([]byte(prefixReceipt+txHash.String()), receipt)
+	}
+
+	q := Querier{store: store, sw: true}
+	receipts, err := q.GetBlockReceipts(blockHash)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	for i, receipt := range receipts {
+		require.Equal(t, txHashes[i].String(), receipt.TransactionHash)
+		require.Equal(t, hexutil.Uint64(i), receipt.TransactionIndex)
+		require.Equal(t, []*ethtypes.Log{}, receipt.Logs)
+	}
+
+	receiptsByNumber, err := q.GetBlockReceiptsByNumber(height)
+	require.NoError(t, err)
+	require.Equal(t, receipts, receiptsByNumber)
+
+	// the receipts are incomplete once one of them is missing
+	store.Delete([]byte(prefixReceipt + txHashes[1].String()))
+	_, err = q.GetBlockReceipts(blockHash)
+	require.Error(t, err)
+
+	q.Enable(false)
+	_, err = q.GetBlockReceiptsByNumber(height)
+	require.Error(t, err)
+}
+
+func TestGetBlockReceiptsUnexpectedTransactions(t *testing.T) {
+	q := Querier{sw: true}
+	receipts, err := q.getBlockReceipts(&EthBlock{})
+	require.NoError(t, err)
+	require.Empty(t, receipts)
+
+	// the full transactions are not expected instead of the hashes
+	_, err = q.getBlockReceipts(&EthBlock{Transactions: []interface{}{map[string]interface{}{}}})
+	require.Error(t, err)
+	_, err = q.getBlockReceipts(&EthBlock{Transactions: "0x"})
+	require.Error(t, err)
+}
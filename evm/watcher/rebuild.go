@@ -0,0 +1,120 @@
+package watcher
+
+import (
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// CodeGetter returns the code of the contract at the given address
+type CodeGetter func(addr common.Address) []byte
+
+// GetRebuildHeight returns the last height replayed by the rebuild of the watch db, 0 if it has never been rebuilt
+func (w WatchStore) GetRebuildHeight() (uint64, error) {
+	height, err := w.getHeight(prefixRebuildHeight + KeyRebuildHeight)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	return height, err
+}
+
+// RebuildBlock replays the ethereum transactions of a committed block together with their delivery results into the
+// watch db, in the same way as the watcher does during the block execution. The height is recorded as the checkpoint
+// of the rebuild once the block is written, so that an interrupted rebuild can be resumed. The codes of the created
+// contracts are read through getCode, which may be nil.
+func RebuildBlock(store *WatchStore, block *tmtypes.Block, results []*abci.ResponseDeliverTx, txDecoder sdk.TxDecoder, getCode CodeGetter) error {
+	height := uint64(block.Height)
+	w := &Watcher{store: store, sw: true}
+	w.NewHeight(height, common.BytesToHash(block.Hash()), tmtypes.TM2PB.Header(&block.Header))
+
+	bloom := big.NewInt(0)
+	var txIndex uint64
+	for i, txBytes := range block.Txs {
+		if i >= len(results) {
+			break
+		}
+		res := results[i]
+		// the txs failing in the ante handler never reach the evm handler, which gives the tx indexes
+		if !res.IsOK() && !isHandlerFailure(res) {
+			continue
+		}
+		tx, err := txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			switch msg := msg.(type) {
+			case types.MsgEthermint:
+				// the handler takes a tx index for the executed MsgEthermint without saving it into the watcher
+				if res.IsOK() {
+					txIndex++
+				}
+
+			case types.MsgEthereumTx:
+				// cache the sender, which is recovered by the ante handler during the block execution
+				if _, err := msg.VerifySig(msg.ChainID()); err != nil {
+					continue
+				}
+
+				txHash := common.BytesToHash(txBytes.Hash())
+				w.SaveEthereumTx(msg, txHash, txIndex)
+				txIndex++
+
+				switch {
+				case res.IsOK():
+					data, err := types.DecodeResultData(res.Data)
+					if err != nil {
+						return err
+					}
+					bloom.Or(bloom, data.Bloom.Big())
+					w.SaveTransactionReceipt(TransactionSuccess, msg, txHash, txIndex-1, &data, uint64(res.GasUsed))
+					if msg.Data.Recipient == nil && getCode != nil {
+						if code := getCode(data.ContractAddress); len(code) != 0 {
+							w.SaveContractCode(data.ContractAddress, code)
+						}
+					}
+
+				case res.Codespace == types.ModuleName && res.Code == types.CodeSpaceEvmCallFailed:
+					w.SaveTransactionReceipt(TransactionFailed, msg, txHash, txIndex-1, &types.ResultData{}, uint64(res.GasUsed))
+
+				default:
+					// the evm execution ran out of gas, the handler panicked before saving the receipt
+				}
+			}
+		}
+	}
+	w.SaveBlock(ethtypes.BytesToBloom(bloom.Bytes()))
+
+	// never move the latest height backwards, which is still updated by the node for the new blocks
+	latest, err := store.GetLatestHeight()
+	if err != nil && err != leveldb.ErrNotFound {
+		return err
+	}
+	for _, msg := range w.batch {
+		if _, ok := msg.(*MsgLatestHeight); ok && latest >= height {
+			continue
+		}
+		store.Set([]byte(msg.GetKey()), []byte(msg.GetValue()))
+	}
+	store.Set([]byte(prefixRebuildHeight+KeyRebuildHeight), []byte(strconv.FormatUint(height, 10)))
+	return nil
+}
+
+// isHandlerFailure returns true if the delivered tx failed in the evm handler. The errors of the handler are wrapped
+// into CodeSpaceEvmCallFailed, and the evm execution runs out of gas with the gas meter set up by the ante handler.
+func isHandlerFailure(res *abci.ResponseDeliverTx) bool {
+	if res.Codespace == types.ModuleName && res.Code == types.CodeSpaceEvmCallFailed {
+		return true
+	}
+	return res.Codespace == sdkerrors.RootCodespace && res.Code == sdkerrors.ErrOutOfGas.ABCICode()
+}
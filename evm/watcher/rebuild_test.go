@@ -0,0 +1,98 @@
+package watcher
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func TestRebuildBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch_db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := OpenWatchStore(dir)
+	require.NoError(t, err)
+	defer store.Close()
+
+	cdc := codec.New()
+	cdc.RegisterInterface((*sdk.Tx)(nil), nil)
+	types.RegisterCodec(cdc)
+
+	// a contract creation
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	msg := types.NewMsgEthereumTxContract(0, big.NewInt(0), 100000, big.NewInt(1), []byte("init code"))
+	require.NoError(t, msg.Sign(big.NewInt(3), priv.ToECDSA()))
+	txBytes := cdc.MustMarshalBinaryLengthPrefixed(msg)
+
+	// a tx failed in the ante handler, which never reaches the evm handler
+	anteFailed := types.NewMsgEthereumTxContract(5, big.NewInt(0), 100000, big.NewInt(1), []byte("init code"))
+	require.NoError(t, anteFailed.Sign(big.NewInt(3), priv.ToECDSA()))
+	anteFailedBytes := cdc.MustMarshalBinaryLengthPrefixed(anteFailed)
+
+	contract := common.BytesToAddress([]byte("contract"))
+	resData, err := types.EncodeResultData(types.ResultData{
+		ContractAddress: contract,
+		Bloom:           ethtypes.BytesToBloom([]byte{1}),
+		Logs:            []*ethtypes.Log{},
+	})
+	require.NoError(t, err)
+
+	block := tmtypes.MakeBlock(5, []tmtypes.Tx{[]byte("not an ethereum tx"), anteFailedBytes, txBytes}, nil, nil)
+	results := []*abci.ResponseDeliverTx{
+		{Code: 1},
+		{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrInvalidSequence.ABCICode()},
+		{Data: resData, GasUsed: 50000},
+	}
+	getCode := func(addr common.Address) []byte {
+		require.Equal(t, contract, addr)
+		return []byte("runtime code")
+	}
+
+	// the latest height of a running node is not moved backwards
+	store.Set([]byte(prefixLatestHeight+KeyLatestHeight), []byte("10"))
+	require.NoError(t, RebuildBlock(store, block, results, types.TxDecoder(cdc), getCode))
+
+	checkpoint, err := store.GetRebuildHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), checkpoint)
+	latest, err := store.GetLatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), latest)
+
+	querier := &Querier{store: store, sw: true}
+	txHash := common.BytesToHash(tmtypes.Tx(txBytes).Hash())
+	receipt, err := querier.GetTransactionReceipt(txHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(TransactionSuccess), uint64(receipt.Status))
+	require.Equal(t, uint64(50000), uint64(receipt.GasUsed))
+	require.Equal(t, uint64(0), uint64(receipt.TransactionIndex))
+	require.Equal(t, &contract, receipt.ContractAddress)
+	require.Equal(t, common.BytesToAddress(priv.PubKey().Address()).Hex(), receipt.From)
+
+	_, err = querier.GetTransactionReceipt(common.BytesToHash(tmtypes.Tx(anteFailedBytes).Hash()))
+	require.Error(t, err)
+
+	ethBlock, err := querier.GetBlockByNumber(5, false)
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToHash(block.Hash()), ethBlock.Hash)
+	require.Equal(t, ethtypes.BytesToBloom([]byte{1}), ethBlock.LogsBloom)
+
+	code, err := querier.GetCode(contract, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("runtime code"), code)
+}
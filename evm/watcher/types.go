@@ -16,14 +16,18 @@ import (
 )
 
 const (
-	prefixTx           = "0x1"
-	prefixBlock        = "0x2"
-	prefixReceipt      = "0x3"
-	prefixCode         = "0x4"
-	prefixBlockInfo    = "0x5"
-	prefixLatestHeight = "0x6"
-
-	KeyLatestHeight = "LatestHeight"
+	prefixTx            = "0x1"
+	prefixBlock         = "0x2"
+	prefixReceipt       = "0x3"
+	prefixCode          = "0x4"
+	prefixBlockInfo     = "0x5"
+	prefixLatestHeight  = "0x6"
+	prefixPrunedHeight  = "0x7"
+	prefixRebuildHeight = "0x8"
+
+	KeyLatestHeight  = "LatestHeight"
+	KeyPrunedHeight  = "PrunedHeight"
+	KeyRebuildHeight = "RebuildHeight"
 
 	TransactionSuccess = 1
 	TransactionFailed  = 0
@@ -114,6 +118,8 @@ type TransactionReceipt struct {
 	TransactionIndex  hexutil.Uint64  `json:"transactionIndex"`
 	From              string          `json:"from"`
 	To                *common.Address `json:"to"`
+	Type              hexutil.Uint64  `json:"type"`
+	EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice"`
 }
 
 func NewMsgTransactionReceipt(status uint32, tx *types.MsgEthereumTx, txHash, blockHash common.Hash, txIndex, height uint64, data *types.ResultData, cumulativeGas, GasUsed uint64) *MsgTransactionReceipt {
@@ -131,6 +137,8 @@ func NewMsgTransactionReceipt(status uint32, tx *types.MsgEthereumTx, txHash, bl
 		TransactionIndex:  hexutil.Uint64(txIndex),
 		From:              common.BytesToAddress(tx.From().Bytes()).Hex(),
 		To:                tx.To(),
+		Type:              hexutil.Uint64(tx.Data.Type),
+		EffectiveGasPrice: (*hexutil.Big)(tx.Data.Price),
 	}
 
 	//contract address will be set to 0x0000000000000000000000000000000000000000 if contract deploy failed
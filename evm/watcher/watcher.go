@@ -9,6 +9,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	types2 "github.com/okex/exchain/x/evm/types"
 	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 type Watcher struct {
@@ -129,15 +130,23 @@ func (w *Watcher) SaveLatestHeight(height uint64) {
 	}
 }
 
-func (w *Watcher) Commit() {
+// Commit writes the batch of the block to the watch db asynchronously, the errors are reported to the logger
+func (w *Watcher) Commit(logger log.Logger) {
 	if !w.enabled() {
 		return
 	}
 	//hold it in temp
 	batch := w.batch
+	height := w.height
 	go func() {
 		for _, b := range batch {
 			w.store.Set([]byte(b.GetKey()), []byte(b.GetValue()))
 		}
+		// prune the blocks out of the retention window
+		if retain := RetainBlocks(); retain > 0 && height > retain {
+			if err := w.store.PruneTo(height - retain); err != nil {
+				logger.Error("failed to prune the watch db", "height", height-retain, "err", err)
+			}
+		}
 	}()
 }
@@ -0,0 +1,180 @@
+package evm_test
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/okex/exchain/app"
+	"github.com/okex/exchain/app/ante"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	ethermint "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/x/evm"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/evm/watcher"
+)
+
+// TestRebuildBlockMatchesWatcher delivers a block mixing the ethereum txs executed by the handler, failed in the ante
+// handler, failed in the handler and the MsgEthermint, and requires the rebuild of the block to write the same watch
+// db as the watcher did during the block execution
+func TestRebuildBlockMatchesWatcher(t *testing.T) {
+	home, err := ioutil.TempDir("", "watch_db")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	viper.Set(flags.FlagHome, home)
+	viper.Set(watcher.FlagFastQuery, true)
+	defer viper.Set(watcher.FlagFastQuery, false)
+
+	exApp := app.Setup(false)
+	k := exApp.EvmKeeper
+	k.Watcher = watcher.NewWatcher()
+	store := watcher.InstanceOfWatchStore()
+	require.NotNil(t, store)
+	cdc := exApp.Codec()
+	txDecoder := evm.TxDecoder(cdc)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	ethermintPriv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	ethermintSender := sdk.AccAddress(ethermintPriv.PubKey().Address())
+	recipient := ethcmn.BytesToAddress([]byte("recipient"))
+	chainID := big.NewInt(3)
+
+	signEthTx := func(msg types.MsgEthereumTx) []byte {
+		require.NoError(t, msg.Sign(chainID, priv.ToECDSA()))
+		return cdc.MustMarshalBinaryLengthPrefixed(msg)
+	}
+
+	block := tmtypes.MakeBlock(2, nil, nil, nil)
+	block.ChainID = "ethermint-3"
+	block.Time = time.Now().UTC()
+	block.LastBlockID = tmtypes.BlockID{Hash: tmhash.Sum([]byte("last block"))}
+	header := tmtypes.TM2PB.Header(&block.Header)
+	ctx := exApp.BaseApp.NewContext(false, header)
+
+	params := types.DefaultParams()
+	params.EnableCall = true
+	k.SetParams(ctx, params)
+	for _, addr := range []sdk.AccAddress{sender, ethermintSender} {
+		acc := exApp.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoinInt64(500000000))))
+		exApp.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	ethermintMsgs := []sdk.Msg{types.NewMsgEthermint(0, nil, sdk.NewInt(1), 100000, sdk.NewInt(1), nil, ethermintSender)}
+	ethermintAcc := exApp.AccountKeeper.GetAccount(ctx, ethermintSender)
+	fee := auth.NewStdFee(220000, sdk.NewCoins(ethermint.NewPhotonCoinInt64(150)))
+	signBytes := auth.StdSignBytes(block.ChainID, ethermintAcc.GetAccountNumber(), ethermintAcc.GetSequence(), fee, ethermintMsgs, "")
+	sig, err := ethermintPriv.Sign(signBytes)
+	require.NoError(t, err)
+	ethermintTx := auth.NewStdTx(ethermintMsgs, fee, []auth.StdSignature{{PubKey: ethermintPriv.PubKey(), Signature: sig}}, "")
+
+	block.Txs = tmtypes.Txs{
+		// executed by the handler
+		signEthTx(types.NewMsgEthereumTx(0, &recipient, big.NewInt(1), 100000, big.NewInt(1), nil)),
+		// failed in the ante handler by the nonce
+		signEthTx(types.NewMsgEthereumTx(5, &recipient, big.NewInt(1), 100000, big.NewInt(1), nil)),
+		// rejected by the handler out of CheckTx
+		cdc.MustMarshalBinaryLengthPrefixed(ethermintTx),
+		// failed in the handler, the contract creation is disabled
+		signEthTx(types.NewMsgEthereumTxContract(1, big.NewInt(0), 100000, big.NewInt(1), []byte("init code"))),
+		// executed by the handler
+		signEthTx(types.NewMsgEthereumTx(2, &recipient, big.NewInt(1), 100000, big.NewInt(1), nil)),
+	}
+
+	// deliver the block to the evm module as the base app does
+	anteHandler := ante.NewAnteHandler(exApp.AccountKeeper, k, exApp.SupplyKeeper, nil)
+	handler := evm.NewHandler(k)
+	deliverTx := func(txBytes []byte) *abci.ResponseDeliverTx {
+		tx, err := txDecoder(txBytes)
+		require.NoError(t, err)
+
+		txCtx := ctx.WithTxBytes(txBytes)
+		anteCtx, writeAnte := txCtx.CacheContext()
+		newCtx, err := anteHandler(anteCtx, tx, false)
+		if err == nil {
+			writeAnte()
+			runCtx, writeRun := newCtx.CacheContext()
+			var res *sdk.Result
+			if res, err = handler(runCtx, tx.GetMsgs()[0]); err == nil {
+				writeRun()
+				return &abci.ResponseDeliverTx{Data: res.Data, GasUsed: int64(newCtx.GasMeter().GasConsumed())}
+			}
+		}
+
+		codespace, code, log := sdkerrors.ABCIInfo(err, false)
+		resp := &abci.ResponseDeliverTx{Codespace: codespace, Code: code, Log: log}
+		if newCtx.GasMeter() != nil {
+			resp.GasUsed = int64(newCtx.GasMeter().GasConsumed())
+		}
+		return resp
+	}
+
+	k.BeginBlock(ctx, abci.RequestBeginBlock{Hash: block.Hash(), Header: header})
+	results := make([]*abci.ResponseDeliverTx, len(block.Txs))
+	for i, txBytes := range block.Txs {
+		results[i] = deliverTx(txBytes)
+	}
+	k.EndBlock(ctx, abci.RequestEndBlock{Height: block.Height})
+
+	require.True(t, results[0].IsOK())
+	require.Equal(t, sdkerrors.RootCodespace, results[1].Codespace)
+	require.Equal(t, types.ModuleName, results[2].Codespace)
+	require.Equal(t, types.CodeSpaceEvmCallFailed, results[3].Code)
+	require.True(t, results[4].IsOK())
+
+	// the watcher writes the block asynchronously, the latest height is the last one written
+	require.Eventually(t, func() bool {
+		latest, err := store.GetLatestHeight()
+		return err == nil && latest == uint64(block.Height)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	querier := watcher.NewQuerier()
+	type watchData struct {
+		receipts []*watcher.TransactionReceipt
+		block    *watcher.EthBlock
+	}
+	readWatchData := func() watchData {
+		var data watchData
+		for _, txBytes := range block.Txs {
+			receipt, _ := querier.GetTransactionReceipt(ethcmn.BytesToHash(txBytes.Hash()))
+			data.receipts = append(data.receipts, receipt)
+		}
+		ethBlock, err := querier.GetBlockByNumber(uint64(block.Height), true)
+		require.NoError(t, err)
+		data.block = ethBlock
+		return data
+	}
+
+	live := readWatchData()
+	// only the txs reaching the handler are indexed
+	require.Nil(t, live.receipts[1])
+	require.Nil(t, live.receipts[2])
+	for i, idx := range map[int]uint64{0: 0, 3: 1, 4: 2} {
+		require.NotNil(t, live.receipts[i])
+		require.Equal(t, idx, uint64(live.receipts[i].TransactionIndex))
+	}
+	require.Equal(t, uint64(watcher.TransactionFailed), uint64(live.receipts[3].Status))
+
+	require.NoError(t, store.DeleteBlocks(uint64(block.Height), uint64(block.Height)))
+	_, err = querier.GetBlockByNumber(uint64(block.Height), false)
+	require.Error(t, err)
+
+	require.NoError(t, watcher.RebuildBlock(store, block, results, txDecoder, nil))
+	require.Equal(t, live, readWatchData())
+}
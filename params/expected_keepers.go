@@ -12,6 +12,7 @@ type BankKeeper interface {
 // StakingKeeper shows the expected action of staking keeper
 type StakingKeeper interface {
 	IsValidator(ctx sdk.Context, addr sdk.AccAddress) bool
+	CheckParamChange(ctx sdk.Context, key, value string) error
 }
 
 // GovKeeper shows the expected action of gov keeper
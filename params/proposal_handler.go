@@ -67,6 +67,19 @@ func checkDenom(paramProposal types.ParameterChangeProposal) sdk.Error {
 	return nil
 }
 
+// checkStakingParams checks the staking params to change with the chain state, which can't be done by their validators
+func checkStakingParams(ctx sdk.Context, k *Keeper, paramProposal types.ParameterChangeProposal) sdk.Error {
+	for _, c := range paramProposal.Changes {
+		if c.Subspace != "staking" {
+			continue
+		}
+		if err := k.sk.CheckParamChange(ctx, c.Key, c.Value); err != nil {
+			return sdkerrors.Wrap(sdkparams.ErrSettingParameter, err.Error())
+		}
+	}
+	return nil
+}
+
 // GetMinDeposit implements ProposalHandler interface
 func (keeper Keeper) GetMinDeposit(ctx sdk.Context, content govtypes.Content) (minDeposit sdk.SysCoins) {
 	switch content.(type) {
@@ -124,6 +137,10 @@ func (keeper Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govtypes.MsgSub
 		return govtypes.ErrInvalidHeight(paramsChangeProposal.Height, curHeight, maxHeight)
 	}
 
+	if err := checkStakingParams(ctx, &keeper, paramsChangeProposal); err != nil {
+		return err
+	}
+
 	// run simulation with cache context
 	cacheCtx, _ := ctx.CacheContext()
 	return changeParams(cacheCtx, &keeper, paramsChangeProposal)
@@ -30,6 +30,7 @@ var (
 	NewQuerier                         = keeper.NewQuerier
 	RegisterCodec                      = types.RegisterCodec
 	NewCommission                      = types.NewCommission
+	NewCommissionRates                 = types.NewCommissionRates
 	ErrNoValidatorFound                = types.ErrNoValidatorFound
 	ErrValidatorOwnerExists            = types.ErrValidatorOwnerExists
 	ErrValidatorPubKeyExists           = types.ErrValidatorPubKeyExists
@@ -40,12 +41,17 @@ var (
 	GetValidatorsByPowerIndexKey       = types.GetValidatorsByPowerIndexKey
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
+	NewMsgEditValidatorCommissionRate  = types.NewMsgEditValidatorCommissionRate
 	NewMsgDeposit                      = types.NewMsgDeposit
 	NewMsgWithdraw                     = types.NewMsgWithdraw
 	DefaultParams                      = types.DefaultParams
+	DefaultWeightCurve                 = types.DefaultWeightCurve
+	NewWeightCurve                     = types.NewWeightCurve
 	NewValidator                       = types.NewValidator
 	NewDescription                     = types.NewDescription
 	NewMsgAddShares                    = types.NewMsgAddShares
+	NewMsgAddSharesWithWeights         = types.NewMsgAddSharesWithWeights
+	NewMsgEditProxyCommissionRate      = types.NewMsgEditProxyCommissionRate
 	NewGenesisState                    = types.NewGenesisState
 	DelegatorAddSharesInvariant        = keeper.DelegatorAddSharesInvariant
 
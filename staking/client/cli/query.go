@@ -33,6 +33,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidators(queryRoute, cdc),
 		GetCmdQueryProxy(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryWeight(queryRoute, cdc),
 		GetCmdQueryPool(queryRoute, cdc))...)
 
 	return stakingQueryCmd
@@ -252,7 +253,7 @@ $ %s query staking delegator ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
 				return fmt.Errorf("invalid address：%s", args[0])
 			}
 
-			delegator, undelegation := types.NewDelegator(delAddr), types.DefaultUndelegation()
+			delegator, undelegations := types.NewDelegator(delAddr), types.UndelegationInfos{}
 			resp, _, err := cliCtx.QueryStore(types.GetDelegatorKey(delAddr), storeName)
 			if err != nil {
 				return err
@@ -271,12 +272,12 @@ $ %s query staking delegator ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
 			res, _, err := cliCtx.QueryWithData(route, bytes)
 			// if err!= nil , we treat it as there's no undelegation of the delegator
 			if err == nil {
-				if err := cdc.UnmarshalJSON(res, &undelegation); err != nil {
+				if err := cdc.UnmarshalJSON(res, &undelegations); err != nil {
 					return err
 				}
 			}
 
-			return cliCtx.PrintOutput(convertToDelegatorResp(delegator, undelegation))
+			return cliCtx.PrintOutput(convertToDelegatorResp(delegator, undelegations))
 		},
 	}
 }
@@ -288,25 +289,32 @@ type DelegatorResponse struct {
 	Shares               sdk.Dec          `json:"shares" yaml:"shares"`
 	Tokens               sdk.Dec          `json:"tokens" yaml:"tokens"`
 	UnbondedTokens       sdk.Dec          `json:"unbonded_tokens" yaml:"unbonded_tokens"`
-	CompletionTime       time.Time        `json:"completion_time" yaml:"completion_time"`
 	IsProxy              bool             `json:"is_proxy" yaml:"is_proxy"`
 	TotalDelegatedTokens sdk.Dec          `json:"total_delegated_tokens" yaml:"total_delegated_tokens"`
 	ProxyAddress         sdk.AccAddress   `json:"proxy_address" yaml:"proxy_address"`
+	// Undelegations are the pending undelegation entries, each of which is matured at its own completion time
+	Undelegations types.UndelegationInfos `json:"undelegations" yaml:"undelegations"`
+	// Weights are the weights of the shares on ValidatorAddresses in the same order, which are empty if every validator
+	// gets all the shares
+	Weights []sdk.Dec `json:"weights,omitempty" yaml:"weights,omitempty"`
+	// ProxyCommissionRate is the rate of the rewards of the bound delegators that the proxy takes as commission
+	ProxyCommissionRate sdk.Dec `json:"proxy_commission_rate" yaml:"proxy_commission_rate"`
 }
 
 // String returns a human readable string representation of DelegatorResponse
 func (dr DelegatorResponse) String() (output string) {
 	n := len(dr.ValidatorAddresses)
 	if n > 0 {
-		output = fmt.Sprintf("%s\n", dr.ValidatorAddresses[0].String())
+		output = fmt.Sprintf("%s%s\n", dr.ValidatorAddresses[0].String(), dr.weightString(0))
 		for i := 1; i < n; i++ {
-			output = fmt.Sprintf("%s						%s\n", output, dr.ValidatorAddresses[i].String())
+			output = fmt.Sprintf("%s						%s%s\n", output, dr.ValidatorAddresses[i].String(),
+				dr.weightString(i))
 		}
 	}
 
 	proxy := "No"
 	if dr.IsProxy {
-		proxy = "Yes"
+		proxy = "Yes\n	Proxy commission rate: " + dr.ProxyCommissionRate.String()
 	}
 
 	proxied := "No"
@@ -314,32 +322,48 @@ func (dr DelegatorResponse) String() (output string) {
 		proxied = "Yes\n	Proxied by " + dr.ProxyAddress.String() + "\n"
 	}
 
+	var undelegations string
+	for _, ud := range dr.Undelegations {
+		undelegations = fmt.Sprintf("%s\n		ID: %d	Quantity: %s	CompletionTime: %s", undelegations, ud.ID,
+			ud.Quantity, ud.CompletionTime.Format(time.RFC3339))
+	}
+
 	output = fmt.Sprintf(`Delegator:
 	DelegatorAddress: 		%s
 	ValidatorAddresses:		%s	
 	Shares:					%s
 	Tokens:					%s
 	UnbondedTokens: 		%s
-	CompletionTime:			%s
+	Undelegations:			%s
 	IsProxied:				%s
 	IsProxy:				%s`,
-		dr.DelegatorAddress, output, dr.Shares, dr.Tokens, dr.UnbondedTokens, dr.CompletionTime, proxied, proxy)
+		dr.DelegatorAddress, output, dr.Shares, dr.Tokens, dr.UnbondedTokens, undelegations, proxied, proxy)
 
 	return
 }
 
-func convertToDelegatorResp(delegator types.Delegator, undelegation types.UndelegationInfo,
+// weightString returns the weight of the shares on the i-th validator for display
+func (dr DelegatorResponse) weightString(i int) string {
+	if i >= len(dr.Weights) {
+		return ""
+	}
+	return fmt.Sprintf("	Weight: %s", dr.Weights[i])
+}
+
+func convertToDelegatorResp(delegator types.Delegator, undelegations types.UndelegationInfos,
 ) DelegatorResponse {
 	return DelegatorResponse{
 		delegator.DelegatorAddress,
 		delegator.ValidatorAddresses,
 		delegator.Shares,
 		delegator.Tokens,
-		undelegation.Quantity,
-		undelegation.CompletionTime,
+		undelegations.TotalQuantity(),
 		delegator.IsProxy,
 		delegator.TotalDelegatedTokens,
 		delegator.ProxyAddress,
+		undelegations,
+		delegator.Weights,
+		delegator.GetProxyCommissionRate(),
 	}
 }
 
@@ -386,3 +410,80 @@ $ %s query staking shares-added-to exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkf
 		},
 	}
 }
+
+// GetCmdQueryWeight gets command for previewing the shares of the tokens calculated by a weight curve
+func GetCmdQueryWeight(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weight [tokens]",
+		Short: "preview the weight and the shares of the tokens",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Preview the weight and the shares of the tokens to add shares with. The current weight curve
+and the latest block time are used unless the flags are set.
+
+Example:
+$ %s query staking weight 100
+$ %s query staking weight 100 --%s 1700000000 --%s capped --%s 2
+`,
+				version.ClientName, version.ClientName, FlagTimestamp, FlagGrowthType, FlagWeightCap,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			tokens, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid tokens: %s", args[0])
+			}
+
+			timestamp, err := cmd.Flags().GetInt64(FlagTimestamp)
+			if err != nil {
+				return err
+			}
+
+			var curve *types.WeightCurve
+			if growthType, _ := cmd.Flags().GetString(FlagGrowthType); len(growthType) != 0 {
+				epochTimestamp, err := cmd.Flags().GetInt64(FlagWeightEpochTimestamp)
+				if err != nil {
+					return err
+				}
+				capStr, _ := cmd.Flags().GetString(FlagWeightCap)
+				weightCap, err := sdk.NewDecFromStr(capStr)
+				if err != nil {
+					return fmt.Errorf("invalid cap: %s", capStr)
+				}
+				wc := types.NewWeightCurve(epochTimestamp, growthType, weightCap)
+				if err := wc.Validate(); err != nil {
+					return err
+				}
+				curve = &wc
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryWeightParams(tokens, timestamp, curve))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryWeight)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var weightResp types.WeightResponse
+			if err := cdc.UnmarshalJSON(resp, &weightResp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(weightResp)
+		},
+	}
+
+	cmd.Flags().Int64(FlagTimestamp, 0, "the unix timestamp to calculate the weight at, the latest block time by default")
+	cmd.Flags().String(FlagGrowthType, "",
+		"the growth type of the weight curve to preview: linear, capped or step. the current curve is used if empty")
+	cmd.Flags().Int64(FlagWeightEpochTimestamp, types.DefaultWeightEpochTimestamp,
+		"the epoch timestamp of the weight curve to preview")
+	cmd.Flags().String(FlagWeightCap, "0", "the cap of the weight curve to preview, zero means no cap")
+	return cmd
+}
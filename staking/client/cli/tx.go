@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/okex/exchain/x/common"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/okex/exchain/x/staking/types"
@@ -40,8 +42,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 			GetCmdCreateValidator(cdc),
 			GetCmdDestroyValidator(cdc),
 			GetCmdEditValidator(cdc),
+			GetCmdEditValidatorCommissionRate(cdc),
 			GetCmdDeposit(cdc),
 			GetCmdWithdraw(cdc),
+			GetCmdCancelUndelegation(cdc),
 			GetCmdAddShares(cdc),
 		)...)
 
@@ -72,7 +76,7 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(FsPk)
 	//cmd.Flags().AddFlagSet(FsAmount)
 	cmd.Flags().AddFlagSet(fsDescriptionCreate)
-	//cmd.Flags().AddFlagSet(FsCommissionCreate)
+	cmd.Flags().AddFlagSet(FsCommissionCreate)
 	cmd.Flags().AddFlagSet(FsMinSelfDelegation)
 
 	cmd.Flags().String(FlagIP, "",
@@ -129,11 +133,42 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(fsDescriptionEdit)
-	//cmd.Flags().AddFlagSet(fsCommissionUpdate)
 
 	return cmd
 }
 
+// GetCmdEditValidatorCommissionRate gets the command for editing the commission rate of a validator
+func GetCmdEditValidatorCommissionRate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit-validator-commission-rate [commission-rate]",
+		Args:  cobra.ExactArgs(1),
+		Short: "edit the commission rate of an existing validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the commission rate of an existing validator. The rate can be changed once within 24 hours,
+by no more than the max change rate, and must be within the min commission rate param and the max rate.
+
+Example:
+$ %s tx staking edit-validator-commission-rate 0.1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			rate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid commission rate: %s", args[0])
+			}
+
+			msg := types.NewMsgEditValidatorCommissionRate(sdk.ValAddress(cliCtx.GetFromAddress()), rate)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
@@ -154,7 +189,7 @@ func CreateValidatorMsgHelpers(ipDefault string) (fs *flag.FlagSet, nodeIDFlag,
 	fsCreateValidator.String(FlagWebsite, "", "The validator's (optional) website")
 	fsCreateValidator.String(FlagDetails, "", "The validator's (optional) details")
 	fsCreateValidator.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
-	//fsCreateValidator.AddFlagSet(FsCommissionCreate)
+	fsCreateValidator.AddFlagSet(FsCommissionCreate)
 	//fsCreateValidator.AddFlagSet(FsMinSelfDelegation)
 	//fsCreateValidator.AddFlagSet(FsAmount)
 	fsCreateValidator.AddFlagSet(FsPk)
@@ -224,6 +259,12 @@ func BuildCreateValidatorMsg(cliCtx context.CLIContext, txBldr auth.TxBuilder) (
 		viper.GetString(FlagDetails),
 	)
 
+	commission, err := buildCommissionRates(viper.GetString(FlagCommissionRate),
+		viper.GetString(FlagCommissionMaxRate), viper.GetString(FlagCommissionMaxChangeRate))
+	if err != nil {
+		return txBldr, nil, err
+	}
+
 	// get the initial validator min self delegation
 	var minSelfDelegation sdk.DecCoin
 	if viper.GetString(FlagMinSelfDelegation) == "" {
@@ -235,6 +276,7 @@ func BuildCreateValidatorMsg(cliCtx context.CLIContext, txBldr auth.TxBuilder) (
 		sdk.ValAddress(valAddr),
 		pk,
 		description,
+		commission,
 		minSelfDelegation,
 	)
 
@@ -248,3 +290,33 @@ func BuildCreateValidatorMsg(cliCtx context.CLIContext, txBldr auth.TxBuilder) (
 
 	return txBldr, msg, nil
 }
+
+// buildCommissionRates builds the commission rates of the validator to create, which are left empty before the
+// commission upgrade height
+func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commission types.CommissionRates, err error) {
+	if rateStr == "" && maxRateStr == "" && maxChangeRateStr == "" {
+		return commission, nil
+	}
+	if rateStr == "" || maxRateStr == "" || maxChangeRateStr == "" {
+		return commission, fmt.Errorf("must specify all validator commission parameters")
+	}
+
+	rate, err := sdk.NewDecFromStr(rateStr)
+	if err != nil {
+		return commission, err
+	}
+	maxRate, err := sdk.NewDecFromStr(maxRateStr)
+	if err != nil {
+		return commission, err
+	}
+	maxChangeRate, err := sdk.NewDecFromStr(maxChangeRateStr)
+	if err != nil {
+		return commission, err
+	}
+
+	commission = types.NewCommissionRates(rate, maxRate, maxChangeRate)
+	if err := commission.Validate(); err != nil {
+		return commission, err
+	}
+	return commission, nil
+}
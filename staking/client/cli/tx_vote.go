@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -112,19 +113,56 @@ $ %s tx staking withdraw 1%s
 	return cmd
 }
 
+// GetCmdCancelUndelegation gets command for canceling a pending undelegation entry
+func GetCmdCancelUndelegation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-undelegation [undelegation-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: fmt.Sprintf("cancel a pending undelegation and bond its %s back", sdk.DefaultBondDenom),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a pending undelegation before its completion time, which bonds its %s back and restores the shares.
+The ids of the undelegations are listed by the delegator query.
+
+Example:
+$ %s tx staking cancel-undelegation 1
+`,
+				sdk.DefaultBondDenom, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid undelegation id: %s", args[0])
+			}
+
+			msg := types.NewMsgCancelUndelegation(cliCtx.GetFromAddress(), id)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdAddShares gets command for multi voting
 func GetCmdAddShares(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "add-shares [validator-addr1, validator-addr2, validator-addr3, ... validator-addrN] [flags]",
 		Args:  cobra.ExactArgs(1),
 		Short: fmt.Sprintf("add shares to one or more validators by all deposited %s", sdk.DefaultBondDenom),
 		Long: strings.TrimSpace(
-			fmt.Sprintf("Add shares to one or more validators by all deposited %s.\n\nExample:\n$ %s tx staking add-shares "+
+			fmt.Sprintf("Add shares to one or more validators by all deposited %s.\n\nEvery validator gets all the "+
+				"shares unless --%s is set, which splits the shares among the validators in the same order.\n\n"+
+				"Example:\n$ %s tx staking add-shares "+
 				"exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg,"+
 				"exvaloper1svzxp4ts5le2s4zugx34ajt6shz2hg42dnwst5,"+
 				"exvaloper10q0rk5qnyag7wfvvt7rtphlw589m7frshchly8,"+
-				"exvaloper1g7znsf24w4jc3xfca88pq9kmlyjdare6tr3mk6 --from mykey\n",
-				sdk.DefaultBondDenom, version.ClientName),
+				"exvaloper1g7znsf24w4jc3xfca88pq9kmlyjdare6tr3mk6 --from mykey\n"+
+				"$ %s tx staking add-shares "+
+				"exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg,"+
+				"exvaloper1svzxp4ts5le2s4zugx34ajt6shz2hg42dnwst5 --%s 0.6,0.4 --from mykey\n",
+				sdk.DefaultBondDenom, FlagWeights, version.ClientName, version.ClientName, FlagWeights),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -137,11 +175,31 @@ func GetCmdAddShares(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgAddShares(delAddr, valAddrs)
-			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			weightsStr, err := cmd.Flags().GetString(FlagWeights)
+			if err != nil {
+				return err
+			}
+			if len(strings.TrimSpace(weightsStr)) == 0 {
+				msg := types.NewMsgAddShares(delAddr, valAddrs)
+				return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			}
+
+			weights, err := getWeights(weightsStr)
+			if err != nil {
+				return err
+			}
 
+			msg := types.NewMsgAddSharesWithWeights(delAddr, valAddrs, weights)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(FlagWeights, "",
+		"comma separated weights of the shares on the validators in the same order, which must sum to 1")
+	return cmd
 }
 
 // GetCmdProxy gets subcommands for proxy voting
@@ -158,6 +216,7 @@ func GetCmdProxy(cdc *codec.Codec) *cobra.Command {
 			GetCmdUnregProxy(cdc),
 			GetCmdBindProxy(cdc),
 			GetCmdUnbindProxy(cdc),
+			GetCmdEditProxyCommissionRate(cdc),
 		)...)
 
 	return proxyCmd
@@ -275,6 +334,38 @@ $ %s tx staking proxy unbind --from mykey
 	}
 }
 
+// GetCmdEditProxyCommissionRate gets command for editing the commission rate of a proxy
+func GetCmdEditProxyCommissionRate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit-commission-rate [commission-rate]",
+		Args:  cobra.ExactArgs(1),
+		Short: "edit the commission rate that the proxy takes from the rewards of its bound delegators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the commission rate that the proxy takes from the rewards of its bound delegators. The rate
+can be changed once within 24 hours since the registration or the last change, by no more than %s, and must be
+within 0 and %s.
+
+Example:
+$ %s tx staking proxy edit-commission-rate 0.01 --from mykey
+`,
+				types.DefaultProxyCommissionMaxChangeRate, types.DefaultProxyCommissionMaxRate, version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			rate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid commission rate: %s", args[0])
+			}
+
+			msg := types.NewMsgEditProxyCommissionRate(cliCtx.GetFromAddress(), rate)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // getValsSet gets validator set from client args
 func getValsSet(address string) (valAddrs []sdk.ValAddress, err error) {
 	addrs := strings.Split(strings.TrimSpace(address), ",")
@@ -288,3 +379,15 @@ func getValsSet(address string) (valAddrs []sdk.ValAddress, err error) {
 	}
 	return
 }
+
+func getWeights(weightsStr string) (weights []sdk.Dec, err error) {
+	strs := strings.Split(strings.TrimSpace(weightsStr), ",")
+	weights = make([]sdk.Dec, len(strs))
+	for i, str := range strs {
+		weights[i], err = sdk.NewDecFromStr(strings.TrimSpace(str))
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight: %s", str)
+		}
+	}
+	return
+}
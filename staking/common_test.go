@@ -2,6 +2,7 @@ package staking
 
 import (
 	"fmt"
+	"os"
 	"runtime/debug"
 	"testing"
 	"time"
@@ -18,6 +19,12 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+// TestMain runs the tests with the commission upgraded, from which the validators set their own commission rates
+func TestMain(m *testing.M) {
+	types.CommissionUpgradeHeight = 0
+	os.Exit(m.Run())
+}
+
 // dummy addresses used for testing
 var (
 	Addrs = keeper.Addrs
@@ -954,9 +961,9 @@ func queryDelegatorCheck(dlgAddr sdk.AccAddress, expExist bool, expVAs []sdk.Val
 				fmt.Println(sdkErr.Error())
 			} else {
 				require.NoError(t, sdkErr)
-				unDelegationInfo := types.DefaultUndelegation()
-				require.NoError(t, cdc.UnmarshalJSON(res, &unDelegationInfo))
-				b5 = assert.Equal(t, *expUnbondingToken, unDelegationInfo.Quantity, unDelegationInfo.String())
+				var unDelegationInfos types.UndelegationInfos
+				require.NoError(t, cdc.UnmarshalJSON(res, &unDelegationInfos))
+				b5 = assert.Equal(t, *expUnbondingToken, unDelegationInfos.TotalQuantity(), unDelegationInfos.String())
 			}
 
 		}
@@ -1019,7 +1026,7 @@ func queryDelegatorProxyCheck(dlgAddr sdk.AccAddress, expIsProxy bool, expHasPro
 		// check if the shares correct
 		b6 := true
 		if len(dlg.GetShareAddedValidatorAddresses()) > 0 {
-			expectDlgShares, err := keeper.SimulateWeight(getGlobalContext().BlockTime().Unix(), (dlg.TotalDelegatedTokens.Add(dlg.Tokens)))
+			expectDlgShares, err := keeper.SimulateWeight(types.DefaultWeightCurve(), getGlobalContext().BlockTime().Unix(), (dlg.TotalDelegatedTokens.Add(dlg.Tokens)))
 			b6 = err == nil
 			b6 = b6 && assert.Equal(t, expectDlgShares.String(), dlg.Shares.String(), dlg)
 		} else {
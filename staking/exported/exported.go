@@ -9,6 +9,10 @@ import (
 type DelegatorI interface {
 	GetShareAddedValidatorAddresses() []sdk.ValAddress
 	GetLastAddedShares() sdk.Dec
+	GetTokens() sdk.Dec
+	GetTotalDelegatedTokens() sdk.Dec
+	GetProxyAddress() sdk.AccAddress
+	GetProxyCommissionRate() sdk.Dec
 }
 
 // ValidatorI expected validator functions
@@ -26,6 +26,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 
 	keeper.SetParams(ctx, data.Params)
+	// the shares in genesis are calculated by the exported weight curve, or the one of the params
+	if data.WeightCurve != nil {
+		keeper.SetWeightCurve(ctx, *data.WeightCurve)
+	} else {
+		keeper.SetWeightCurve(ctx, data.Params.WeightCurve)
+	}
 	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
@@ -100,7 +106,11 @@ func checkPools(ctx sdk.Context, keeper Keeper, bondedDecCoin, notBondedDecCoin
 
 func initUnbondingDelegation(ctx sdk.Context, ubd UndelegationInfo, keeper Keeper, notBondedTokens *sdk.Dec) {
 	keeper.SetUndelegating(ctx, ubd)
-	keeper.SetAddrByTimeKeyWithNilValue(ctx, ubd.CompletionTime, ubd.DelegatorAddress)
+	keeper.SetAddrByTimeKeyWithNilValue(ctx, ubd.CompletionTime, ubd.DelegatorAddress, ubd.ID)
+	// the ids of the new undelegation entries mustn't collide with the imported ones
+	if ubd.ID >= keeper.GetNextUndelegationID(ctx) {
+		keeper.SetNextUndelegationID(ctx, ubd.ID+1)
+	}
 	*notBondedTokens = notBondedTokens.Add(ubd.Quantity)
 }
 
@@ -163,6 +173,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		proxyDelegatorKeys = append(proxyDelegatorKeys, types.NewProxyDelegatorKeyExported(delAddr, proxyAddr))
 		return false
 	})
+	weightCurve := keeper.GetWeightCurve(ctx)
 
 	return types.GenesisState{
 		Params:               params,
@@ -174,6 +185,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		AllShares:            sharesExportedSlice,
 		ProxyDelegatorKeys:   proxyDelegatorKeys,
 		Exported:             true,
+		WeightCurve:          &weightCurve,
 	}
 }
 
@@ -199,6 +211,11 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	if data.WeightCurve != nil {
+		if err := data.WeightCurve.Validate(); err != nil {
+			return fmt.Errorf("weight curve in genesis state is invalid: %s", err)
+		}
+	}
 	return data.Params.Validate()
 }
 
@@ -38,12 +38,15 @@ func TestInitGenesis(t *testing.T) {
 	validators[0].Status = sdk.Bonded
 	validators[0].DelegatorShares = sdk.NewDec(valTokens)
 	validators[0].MinSelfDelegation = sdk.OneDec()
+	validators[0].Commission = types.NewCommissionWithTime(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1),
+		sdk.NewDecWithPrec(1, 2), time.Unix(100, 0).UTC())
 	validators[1].OperatorAddress = sdk.ValAddress(Addrs[1])
 	validators[1].ConsPubKey = PKs[1]
 	validators[1].Description = types.NewDescription("bloop", "", "", "")
 	validators[1].Status = sdk.Bonded
 	validators[1].DelegatorShares = sdk.NewDec(valTokens)
 	validators[1].MinSelfDelegation = sdk.OneDec()
+	validators[1].Commission = types.NewCommission(sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec())
 
 	delegators := make([]Delegator, 1)
 	delegators[0] = types.NewDelegator(Addrs[10])
@@ -115,6 +118,12 @@ func TestInitGenesis(t *testing.T) {
 	resVal, found = newKeeper.GetValidator(newCtx, actualGenesis.Validators[1].OperatorAddress)
 	require.True(t, found)
 	require.Equal(t, actualGenesis.Validators[1].Import(), resVal)
+	// the commissions are exported and imported with the validators
+	for _, val := range validators {
+		resVal, found = newKeeper.GetValidator(newCtx, val.OperatorAddress)
+		require.True(t, found)
+		require.True(t, resVal.Commission.Equal(val.Commission), resVal.Commission.String())
+	}
 	// 0x22
 	resVal, found = newKeeper.GetValidatorByConsAddr(newCtx,
 		sdk.GetConsAddress(types.MustGetConsPubKeyBech32(actualGenesis.Validators[0].ConsPubKey)))
@@ -139,15 +148,17 @@ func TestInitGenesis(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, actualGenesis.Delegators[0], delegator)
 	// 0x53
-	unbondingDelegator, ok := newKeeper.GetUndelegating(newCtx, actualGenesis.UnbondingDelegations[0].DelegatorAddress)
+	unbondingDelegator, ok := newKeeper.GetUndelegating(newCtx, actualGenesis.UnbondingDelegations[0].DelegatorAddress,
+		actualGenesis.UnbondingDelegations[0].ID)
 	require.True(t, ok)
 	require.Equal(t, actualGenesis.UnbondingDelegations[0], unbondingDelegator)
 	// 0x54
 	newKeeper.IterateKeysBeforeCurrentTime(newCtx, time.Now().Add(time.Hour),
 		func(index int64, key []byte) (stop bool) {
-			oldTime, delAddr := types.SplitCompleteTimeWithAddrKey(key)
+			oldTime, delAddr, id := types.SplitCompleteTimeWithAddrKey(key)
 			require.Equal(t, actualGenesis.UnbondingDelegations[index].CompletionTime, oldTime)
 			require.Equal(t, actualGenesis.UnbondingDelegations[index].DelegatorAddress, delAddr)
+			require.Equal(t, actualGenesis.UnbondingDelegations[index].ID, id)
 			return false
 		})
 	// 0x55
@@ -168,6 +179,9 @@ func TestInitGenesis(t *testing.T) {
 
 	exportGenesis.Validators[0].UnbondingCompletionTime = time.Now()
 	exportGenesis.Validators[0].Status = sdk.Unbonding
+	// the shares are calculated by the exported weight curve rather than the one of the params changed in the epoch
+	appliedCurve := types.NewWeightCurve(types.DefaultWeightEpochTimestamp, types.WeightGrowthCapped, sdk.OneDec())
+	exportGenesis.WeightCurve = &appliedCurve
 	newCtx, _, newMKeeper = CreateTestInput(t, false, 1000)
 	newKeeper = newMKeeper.Keeper
 	newSupplyKeeper = newMKeeper.SupplyKeeper
@@ -176,6 +190,30 @@ func TestInitGenesis(t *testing.T) {
 	InitGenesis(newCtx, newKeeper, nil, newSupplyKeeper, exportGenesis)
 	// 0x43
 	require.Equal(t, []sdk.ValAddress{exportGenesis.Validators[0].OperatorAddress}, newKeeper.GetValidatorQueueTimeSlice(newCtx, exportGenesis.Validators[0].UnbondingCompletionTime))
+	// 0x57
+	require.True(t, appliedCurve.Equal(newKeeper.GetWeightCurve(newCtx)))
+	require.True(t, exportGenesis.Params.WeightCurve.Equal(newKeeper.ParamsWeightCurve(newCtx)))
+}
+
+func TestExportWeightCurve(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000)
+	keeper := mKeeper.Keeper
+
+	// the param changed in the epoch hasn't been applied to the shares yet
+	curve := types.NewWeightCurve(types.DefaultWeightEpochTimestamp, types.WeightGrowthCapped, sdk.OneDec())
+	params := keeper.GetParams(ctx)
+	params.WeightCurve = curve
+	keeper.SetParams(ctx, params)
+
+	genesisState := ExportGenesis(ctx, keeper)
+	require.True(t, curve.Equal(genesisState.Params.WeightCurve))
+	require.NotNil(t, genesisState.WeightCurve)
+	require.True(t, types.DefaultWeightCurve().Equal(*genesisState.WeightCurve))
+	require.NoError(t, ValidateGenesis(genesisState))
+
+	invalidCurve := types.NewWeightCurve(0, types.WeightGrowthLinear, sdk.ZeroDec())
+	genesisState.WeightCurve = &invalidCurve
+	require.Error(t, ValidateGenesis(genesisState))
 }
 
 func clearNotBondedPool(t *testing.T, ctx sdk.Context, supplyKeeper supply.Keeper) {
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/exchain/x/staking/keeper"
@@ -20,18 +21,26 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgCreateValidator(ctx, msg, k)
 		case types.MsgEditValidator:
 			return handleMsgEditValidator(ctx, msg, k)
+		case types.MsgEditValidatorCommissionRate:
+			return handleMsgEditValidatorCommissionRate(ctx, msg, k)
 		case types.MsgDeposit:
 			return handleMsgDeposit(ctx, msg, k)
 		case types.MsgWithdraw:
 			return handleMsgWithdraw(ctx, msg, k)
+		case types.MsgCancelUndelegation:
+			return handleMsgCancelUndelegation(ctx, msg, k)
 		case types.MsgAddShares:
 			return handleMsgAddShares(ctx, msg, k)
+		case types.MsgAddSharesWithWeights:
+			return handleMsgAddSharesWithWeights(ctx, msg, k)
 		case types.MsgBindProxy:
 			return handleMsgBindProxy(ctx, msg, k)
 		case types.MsgUnbindProxy:
 			return handleMsgUnbindProxy(ctx, msg, k)
 		case types.MsgRegProxy:
 			return handleRegProxy(ctx, msg, k)
+		case types.MsgEditProxyCommissionRate:
+			return handleMsgEditProxyCommissionRate(ctx, msg, k)
 		case types.MsgDestroyValidator:
 			return handleMsgDestroyValidator(ctx, msg, k)
 		default:
@@ -43,6 +52,11 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 // EndBlocker is called every block, update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	// the commissions are migrated by the last block before the upgrade, whose rewards are allocated by the next block
+	if ctx.BlockHeight()+1 == types.CommissionUpgradeHeight {
+		k.MigrateCommissions(ctx)
+	}
+
 	// calculate validator set changes
 	validatorUpdates := make([]abci.ValidatorUpdate, 0)
 	if k.IsEndOfEpoch(ctx) {
@@ -51,6 +65,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 			k.SetEpoch(ctx, newEpoch)
 		}
 		k.SetTheEndOfLastEpoch(ctx)
+		// the shares are recalculated before the validator set updates once the weight curve changes
+		k.ApplyWeightCurve(ctx)
 		//ctx.Logger().Debug("validatorUpdates epoch", "old", oldEpoch, "new", newEpoch)
 		//ctx.Logger().Debug(fmt.Sprintf("old epoch end blockHeight: %d", lastEpochEndHeight))
 
@@ -68,10 +84,10 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 
 	k.IterateKeysBeforeCurrentTime(ctx, ctx.BlockHeader().Time,
 		func(index int64, key []byte) (stop bool) {
-			oldTime, delAddr := types.SplitCompleteTimeWithAddrKey(key)
-			k.DeleteAddrByTimeKey(ctx, oldTime, delAddr)
+			oldTime, delAddr, id := types.SplitCompleteTimeWithAddrKey(key)
+			k.DeleteAddrByTimeKey(ctx, oldTime, delAddr, id)
 
-			quantity, err := k.CompleteUndelegation(ctx, delAddr)
+			quantity, err := k.CompleteUndelegation(ctx, delAddr, id)
 			if err != nil {
 				ctx.Logger().Error(fmt.Sprintf("complete withdraw failed: %s", err))
 			} else {
@@ -80,6 +96,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 						types.EventTypeCompleteUnbonding,
 						sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
 						sdk.NewAttribute(sdk.AttributeKeyAmount, quantity.String()),
+						sdk.NewAttribute(types.AttributeKeyUndelegationID, strconv.FormatUint(id, 10)),
 					),
 				)
 			}
@@ -122,9 +139,13 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		}
 	}
 
+	commission, err := getInitialCommission(ctx, msg, k)
+	if err != nil {
+		return nil, err
+	}
+
 	validator := NewValidator(msg.ValidatorAddress, msg.PubKey, msg.Description, msg.MinSelfDelegation.Amount)
-	commission := NewCommission(sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(0))
-	validator, err := validator.SetInitialCommission(commission)
+	validator, err = validator.SetInitialCommission(commission)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +161,8 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(types.EventTypeCreateValidator,
 			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.MinSelfDelegation.Amount.String())),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.MinSelfDelegation.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, commission.Rate.String())),
 		sdk.NewEvent(sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String())),
@@ -177,3 +199,58 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// getInitialCommission returns the commission of the validator to create, which is set by the msg from the
+// CommissionUpgradeHeight and is fixed to 100% before it
+func getInitialCommission(ctx sdk.Context, msg types.MsgCreateValidator, k keeper.Keeper) (types.Commission, error) {
+	if !types.IsCommissionUpgraded(ctx.BlockHeight()) {
+		if !msg.Commission.IsEmpty() {
+			return types.Commission{}, types.ErrCommissionNotUpgraded(types.CommissionUpgradeHeight)
+		}
+		return NewCommission(sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(0)), nil
+	}
+
+	if msg.Commission.IsEmpty() {
+		return types.Commission{}, types.ErrCommissionNotSet()
+	}
+	if minRate := k.ParamsMinCommissionRate(ctx); msg.Commission.Rate.LT(minRate) {
+		return types.Commission{}, types.ErrCommissionLTMinRate(minRate.String())
+	}
+	return types.NewCommissionWithTime(msg.Commission.Rate, msg.Commission.MaxRate, msg.Commission.MaxChangeRate,
+		ctx.BlockHeader().Time), nil
+}
+
+func handleMsgEditValidatorCommissionRate(ctx sdk.Context, msg types.MsgEditValidatorCommissionRate,
+	k keeper.Keeper) (*sdk.Result, error) {
+	if !types.IsCommissionUpgraded(ctx.BlockHeight()) {
+		return nil, types.ErrCommissionNotUpgraded(types.CommissionUpgradeHeight)
+	}
+
+	// validator must already be registered
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return nil, ErrNoValidatorFound(msg.ValidatorAddress.String())
+	}
+
+	commission, err := k.UpdateValidatorCommission(ctx, validator, msg.CommissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	// call the before-modification hook since we're about to update the commission
+	k.BeforeValidatorModified(ctx, msg.ValidatorAddress)
+	validator.Commission = commission
+	k.SetValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeEditValidator,
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, commission.Rate.String()),
+		),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,6 +56,7 @@ func handleMsgBindProxy(ctx sdk.Context, msg types.MsgBindProxy, k keeper.Keeper
 	delegator.BindProxy(msg.ProxyAddress)
 
 	// update proxy's shares weight
+	k.BeforeProxyModified(ctx, proxyDelegator.DelegatorAddress)
 	proxyDelegator.TotalDelegatedTokens = proxyDelegator.TotalDelegatedTokens.Add(delegator.Tokens)
 
 	k.SetDelegator(ctx, delegator)
@@ -82,6 +84,7 @@ func unbindProxy(ctx sdk.Context, delAddr sdk.AccAddress, k keeper.Keeper) error
 	}
 
 	// update proxy's shares weight
+	k.BeforeProxyModified(ctx, proxyDelegator.DelegatorAddress)
 	if k.UpdateProxy(ctx, delegator, delegator.Tokens.Mul(sdk.NewDec(-1))) != nil {
 		return types.ErrInvalidDelegation(delAddr.String())
 	}
@@ -115,6 +118,9 @@ func regProxy(ctx sdk.Context, proxyAddr sdk.AccAddress, k keeper.Keeper) (*sdk.
 	}
 
 	proxy.RegProxy(true)
+	// the commission rate starts from zero and can't be changed within 24h since the registration
+	proxy.ProxyCommission = types.NewCommissionWithTime(sdk.ZeroDec(), types.DefaultProxyCommissionMaxRate,
+		types.DefaultProxyCommissionMaxChangeRate, ctx.BlockHeader().Time)
 	k.SetDelegator(ctx, proxy)
 
 	if k.UpdateShares(ctx, proxy.DelegatorAddress, proxy.Tokens) != nil {
@@ -136,6 +142,8 @@ func unregProxy(ctx sdk.Context, proxyAddr sdk.AccAddress, k keeper.Keeper) (*sd
 		return types.ErrProxyNotFound(proxyAddr.String()).Result()
 	}
 
+	// settle the rewards of the bound delegators before the proxy relationship is erased
+	k.BeforeProxyModified(ctx, proxyAddr)
 	proxy.RegProxy(false)
 	// unreg action, we need to erase all proxy relationship
 	proxy.TotalDelegatedTokens = sdk.ZeroDec()
@@ -158,18 +166,64 @@ func handleRegProxy(ctx sdk.Context, msg types.MsgRegProxy, k keeper.Keeper) (*s
 	return unregProxy(ctx, msg.ProxyAddress, k)
 }
 
+func handleMsgEditProxyCommissionRate(ctx sdk.Context, msg types.MsgEditProxyCommissionRate, k keeper.Keeper) (
+	*sdk.Result, error) {
+	proxy, found := k.GetDelegator(ctx, msg.ProxyAddress)
+	if !found || !proxy.IsProxy {
+		return types.ErrProxyNotFound(msg.ProxyAddress.String()).Result()
+	}
+
+	commission := proxy.GetProxyCommission()
+	blockTime := ctx.BlockHeader().Time
+	if err := commission.ValidateNewRate(msg.CommissionRate, blockTime); err != nil {
+		return nil, err
+	}
+
+	// settle the rewards of the bound delegators by the old commission rate
+	k.BeforeProxyModified(ctx, proxy.DelegatorAddress)
+	commission.Rate = msg.CommissionRate
+	commission.UpdateTime = blockTime
+	proxy.ProxyCommission = commission
+	k.SetDelegator(ctx, proxy)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeEditProxyCommissionRate,
+			sdk.NewAttribute(types.AttributeKeyProxy, proxy.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.CommissionRate.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper) (*sdk.Result, error) {
+	return addSharesToValidators(ctx, msg.DelAddr, msg.ValAddrs, nil, k)
+}
+
+func handleMsgAddSharesWithWeights(ctx sdk.Context, msg types.MsgAddSharesWithWeights, k keeper.Keeper) (*sdk.Result,
+	error) {
+	if len(msg.Weights) != len(msg.ValAddrs) {
+		return nil, types.ErrInvalidWeights("the number of weights doesn't match the number of validators")
+	}
+
+	return addSharesToValidators(ctx, msg.DelAddr, msg.ValAddrs, msg.Weights, k)
+}
+
+// addSharesToValidators replaces the shares that the delegator added last time with the ones on the validators this
+// time. Every validator gets the total shares of the delegator if the weights are empty
+func addSharesToValidators(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, weights []sdk.Dec,
+	k keeper.Keeper) (*sdk.Result, error) {
 	maxValsToAddShares := int(k.ParamsMaxValsToAddShares(ctx))
-	if len(msg.ValAddrs) == 0 {
+	if len(valAddrs) == 0 {
 		return nil, types.ErrEmptyValidators()
-	} else if len(msg.ValAddrs) > maxValsToAddShares {
+	} else if len(valAddrs) > maxValsToAddShares {
 		return types.ErrExceedValidatorAddrs(maxValsToAddShares).Result()
 	}
 
 	// 0. check whether the delegator has delegation
-	delegator, found := k.GetDelegator(ctx, msg.DelAddr)
+	delegator, found := k.GetDelegator(ctx, delAddr)
 	if !found || delegator.Tokens.IsZero() {
-		return types.ErrNoDelegationToAddShares(msg.DelAddr.String()).Result()
+		return types.ErrNoDelegationToAddShares(delAddr.String()).Result()
 	}
 	if delegator.HasProxy() {
 		return types.ErrAddSharesDuringProxy(delegator.DelegatorAddress.String(),
@@ -177,13 +231,13 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	}
 
 	// 1. get last validators which were added shares to and existing in the store
-	lastVals, lastShares := k.GetLastValsAddedSharesExisted(ctx, msg.DelAddr)
+	lastVals, lastShares, lastWeights := k.GetLastValsAddedSharesExisted(ctx, delAddr)
 
 	// 2. withdraw the shares last time
-	k.WithdrawLastShares(ctx, msg.DelAddr, lastVals, lastShares)
+	k.WithdrawLastShares(ctx, delAddr, lastVals, lastShares, lastWeights)
 
 	// 3. get validators to add shares this time (if the validator doesn't exist, return error)
-	vals, sdkErr := k.GetValidatorsToAddShares(ctx, msg.ValAddrs)
+	vals, sdkErr := k.GetValidatorsToAddShares(ctx, valAddrs)
 	if sdkErr != nil {
 		return nil, sdkErr
 	}
@@ -195,7 +249,7 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	totalTokens := delegator.Tokens.Add(delegator.TotalDelegatedTokens)
 
 	// 5. add shares to the vals this time
-	shares, sdkErr := k.AddSharesToValidators(ctx, msg.DelAddr, vals, totalTokens)
+	shares, sdkErr := k.AddSharesToValidatorsWithWeights(ctx, delAddr, vals, totalTokens, weights)
 	if sdkErr != nil {
 		return nil, sdkErr
 	}
@@ -203,6 +257,7 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	// 6. update the delegator entity for this time
 	delegator.ValidatorAddresses = getValsAddrs(vals)
 	delegator.Shares = shares
+	delegator.Weights = weights
 	k.SetDelegator(ctx, delegator)
 
 	ctx.EventManager().EmitEvent(buildEventForHandlerAddShares(delegator))
@@ -253,6 +308,9 @@ func buildEventForHandlerAddShares(delegator types.Delegator) sdk.Event {
 	for i := 2; i < lenAttributes; i++ {
 		attributes[i] = sdk.NewAttribute(types.AttributeKeyValidatorToAddShares, delegator.ValidatorAddresses[i-2].String())
 	}
+	for _, weight := range delegator.Weights {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyWeight, weight.String()))
+	}
 
 	return sdk.NewEvent(types.EventTypeAddShares, attributes...)
 }
@@ -299,6 +357,24 @@ func handleMsgWithdraw(ctx sdk.Context, msg types.MsgWithdraw, k keeper.Keeper)
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgCancelUndelegation(ctx sdk.Context, msg types.MsgCancelUndelegation, k keeper.Keeper) (*sdk.Result,
+	error) {
+	undelegation, err := k.CancelUndelegation(ctx, msg.DelegatorAddress, msg.UndelegationID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbonding,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, undelegation.Quantity.String()),
+			sdk.NewAttribute(types.AttributeKeyUndelegationID, strconv.FormatUint(msg.UndelegationID, 10)),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgDestroyValidator(ctx sdk.Context, msg types.MsgDestroyValidator, k keeper.Keeper) (*sdk.Result, error) {
 	valAddr := sdk.ValAddress(msg.DelAddr)
 	// 0.check to see if the validator which belongs to the delegator exists
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/exchain/x/staking/types"
@@ -82,3 +83,47 @@ func TestHandlerBadMessage(t *testing.T) {
 	r, err := handler(ctx, msg)
 	require.NotNil(t, err, r)
 }
+
+func TestHandlerEditProxyCommissionRate(t *testing.T) {
+	ctx, _, mockKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mockKeeper.Keeper
+	handler := NewHandler(keeper)
+	startTime := ctx.BlockHeader().Time
+	proxyAddr := Addrs[1]
+
+	// only a proxy can edit the commission rate
+	_, err := handler(ctx, NewMsgDeposit(proxyAddr, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(100))))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgEditProxyCommissionRate(proxyAddr, sdk.NewDecWithPrec(1, 2)))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgRegProxy(proxyAddr, true))
+	require.Nil(t, err)
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		rate      sdk.Dec
+		expPass   bool
+	}{
+		{"within 24h since the registration", startTime.Add(time.Hour), sdk.NewDecWithPrec(1, 2), false},
+		{"greater than the max change rate", startTime.Add(25 * time.Hour), sdk.NewDecWithPrec(2, 2), false},
+		{"valid change", startTime.Add(25 * time.Hour), sdk.NewDecWithPrec(1, 2), true},
+		{"within 24h since the last change", startTime.Add(48 * time.Hour), sdk.NewDecWithPrec(2, 2), false},
+		{"valid change after 24h", startTime.Add(50 * time.Hour), sdk.NewDecWithPrec(2, 2), true},
+		{"greater than the max rate", startTime.Add(100 * 24 * time.Hour), types.DefaultProxyCommissionMaxRate.Add(
+			sdk.NewDecWithPrec(1, 2)), false},
+		{"decrease to zero", startTime.Add(100 * 24 * time.Hour), sdk.ZeroDec(), true},
+	}
+	for _, tc := range testCases {
+		_, err = handler(ctx.WithBlockTime(tc.blockTime), types.NewMsgEditProxyCommissionRate(proxyAddr, tc.rate))
+		proxy, found := keeper.GetDelegator(ctx, proxyAddr)
+		require.True(t, found)
+		if tc.expPass {
+			require.Nil(t, err, tc.name)
+			require.True(t, proxy.GetProxyCommissionRate().Equal(tc.rate), tc.name)
+			require.Equal(t, tc.blockTime.UTC(), proxy.ProxyCommission.UpdateTime.UTC(), tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+}
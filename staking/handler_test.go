@@ -2,6 +2,7 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -184,3 +185,121 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	SimpleCheckValidator(t, ctx, keeper, validatorAddr, DefaultMSD, sdk.Bonded,
 		SharesFromDefaultMSD, false)
 }
+
+func TestEditValidatorCommissionRate(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	ctx, _, mKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mKeeper.Keeper
+	handler := NewHandler(keeper)
+	startTime := ctx.BlockHeader().Time
+
+	// the commission rate can't be less than the min commission rate param
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], DefaultMSD)
+	_, err := handler(ctx, msgCreateValidator)
+	require.NotNil(t, err)
+
+	msgCreateValidator.Commission = NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1),
+		sdk.NewDecWithPrec(1, 1))
+	_, err = handler(ctx, msgCreateValidator)
+	require.Nil(t, err)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, validator.GetCommission().Equal(sdk.NewDecWithPrec(1, 1)))
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		rate      sdk.Dec
+		expPass   bool
+	}{
+		{"within 24h since the creation", startTime.Add(time.Hour), sdk.NewDecWithPrec(2, 1), false},
+		{"less than the min commission rate", startTime.Add(25 * time.Hour), sdk.NewDecWithPrec(1, 2), false},
+		{"greater than the max rate", startTime.Add(25 * time.Hour), sdk.NewDecWithPrec(4, 1), false},
+		{"greater than the max change rate", startTime.Add(25 * time.Hour), sdk.NewDecWithPrec(21, 2), false},
+		{"valid change", startTime.Add(25 * time.Hour), sdk.NewDecWithPrec(2, 1), true},
+		{"within 24h since the last change", startTime.Add(48 * time.Hour), sdk.NewDecWithPrec(1, 1), false},
+		{"valid change after 24h", startTime.Add(50 * time.Hour), sdk.NewDecWithPrec(5, 2), true},
+	}
+	for _, tc := range testCases {
+		msg := NewMsgEditValidatorCommissionRate(validatorAddr, tc.rate)
+		require.Nil(t, msg.ValidateBasic(), tc.name)
+		_, err = handler(ctx.WithBlockTime(tc.blockTime), msg)
+		validator, found = keeper.GetValidator(ctx, validatorAddr)
+		require.True(t, found)
+		if tc.expPass {
+			require.Nil(t, err, tc.name)
+			require.True(t, validator.GetCommission().Equal(tc.rate), tc.name)
+			require.Equal(t, tc.blockTime.UTC(), validator.Commission.UpdateTime.UTC(), tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+}
+
+func TestCommissionBeforeUpgrade(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mKeeper.Keeper
+	handler := NewHandler(keeper)
+	ctx = ctx.WithBlockHeight(10)
+	upgradeHeight := types.CommissionUpgradeHeight
+	types.CommissionUpgradeHeight = 20
+	defer func() { types.CommissionUpgradeHeight = upgradeHeight }()
+
+	// the validator created before the commission was introduced holds the zero commission
+	legacyAddr := sdk.ValAddress(keep.Addrs[2])
+	legacyValidator := NewValidator(legacyAddr, keep.PKs[2], Description{}, DefaultMSD)
+	require.True(t, legacyValidator.Commission.Rate.IsZero())
+	keeper.SetValidator(ctx, legacyValidator)
+
+	// the commission rates can't be set before the upgrade height
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], DefaultMSD)
+	_, err := handler(ctx, msgCreateValidator)
+	require.NotNil(t, err)
+
+	// the validator created by the msg without the commission is charged the commission of 100%
+	msgCreateValidator.Commission = types.CommissionRates{}
+	require.Nil(t, msgCreateValidator.ValidateBasic())
+	_, err = handler(ctx, msgCreateValidator)
+	require.Nil(t, err)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, validator.Commission.Equal(NewCommission(sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec())))
+	_, err = handler(ctx, NewMsgEditValidatorCommissionRate(validatorAddr, sdk.NewDecWithPrec(5, 1)))
+	require.NotNil(t, err)
+
+	// all the validators are charged the commission of 100% by the last block before the upgrade height
+	EndBlocker(ctx.WithBlockHeight(18), keeper)
+	legacyValidator, found = keeper.GetValidator(ctx, legacyAddr)
+	require.True(t, found)
+	require.True(t, legacyValidator.GetCommission().IsZero())
+	EndBlocker(ctx.WithBlockHeight(19), keeper)
+	legacyValidator, found = keeper.GetValidator(ctx, legacyAddr)
+	require.True(t, found)
+	require.True(t, legacyValidator.Commission.Equal(NewCommission(sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec())))
+
+	// the commission rates must be set from the upgrade height
+	ctx = ctx.WithBlockHeight(20)
+	validatorAddr = sdk.ValAddress(keep.Addrs[1])
+	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr, keep.PKs[1], DefaultMSD)
+	msgCreateValidator.Commission = types.CommissionRates{}
+	_, err = handler(ctx, msgCreateValidator)
+	require.NotNil(t, err)
+	msgCreateValidator.Commission = NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1),
+		sdk.NewDecWithPrec(1, 2))
+	_, err = handler(ctx, msgCreateValidator)
+	require.Nil(t, err)
+	validator, found = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, validator.GetCommission().Equal(sdk.NewDecWithPrec(1, 1)))
+
+	// the migrated validator lowers its own commission rate from the upgrade height
+	_, err = handler(ctx, NewMsgEditValidatorCommissionRate(legacyAddr, sdk.NewDecWithPrec(5, 1)))
+	require.Nil(t, err)
+	legacyValidator, found = keeper.GetValidator(ctx, legacyAddr)
+	require.True(t, found)
+	require.True(t, legacyValidator.GetCommission().Equal(sdk.NewDecWithPrec(5, 1)))
+}
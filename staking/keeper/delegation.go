@@ -47,7 +47,13 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 		delegator = types.NewDelegator(delAddr)
 	}
 
+	return k.addDelegatorTokens(ctx, delegator, delQuantity)
+}
+
+// addDelegatorTokens adds the tokens bonded already to the delegator and updates the shares added by them
+func (k Keeper) addDelegatorTokens(ctx sdk.Context, delegator types.Delegator, delQuantity sdk.Dec) error {
 	// 3.update delegator
+	k.beforeProxyTokensModified(ctx, delegator)
 	delegator.Tokens = delegator.Tokens.Add(delQuantity)
 	k.SetDelegator(ctx, delegator)
 
@@ -85,6 +91,7 @@ func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 	}
 
 	// 1.some okt transfer bondPool into unbondPool
+	k.beforeProxyTokensModified(ctx, delegator)
 	k.bondedTokensToNotBonded(ctx, token)
 
 	// 2.delete delegator in store, or set back
@@ -95,8 +102,8 @@ func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 	}
 	if leftTokens.IsZero() {
 		// withdraw all shares
-		lastVals, lastShares := k.GetLastValsAddedSharesExisted(ctx, delAddr)
-		k.WithdrawLastShares(ctx, delAddr, lastVals, lastShares)
+		lastVals, lastShares, lastWeights := k.GetLastValsAddedSharesExisted(ctx, delAddr)
+		k.WithdrawLastShares(ctx, delAddr, lastVals, lastShares, lastWeights)
 		if delegator.HasProxy() {
 			k.SetProxyBinding(ctx, delegator.ProxyAddress, delAddr, true)
 		}
@@ -122,24 +129,104 @@ func (k Keeper) Withdraw(ctx sdk.Context, delAddr sdk.AccAddress, token sdk.SysC
 		completionTime = ctx.BlockHeader().Time.Add(sdk.DefaultLevelUpUnbondingTime)
 	}
 
-	undelegation, found := k.GetUndelegating(ctx, delAddr)
-	if !found {
-		undelegation = types.NewUndelegationInfo(delAddr, quantity, completionTime)
+	undelegation := types.NewUndelegationInfo(delAddr, quantity, completionTime)
+	undelegation.AddValidators(k.getValAddrsAddedSharesTo(ctx, delegator))
+	if delegator.HasProxy() {
+		undelegation.ProxyAddress = delegator.ProxyAddress
 	} else {
-		k.DeleteAddrByTimeKey(ctx, undelegation.CompletionTime, delAddr)
-		undelegation.Quantity = undelegation.Quantity.Add(quantity)
-		undelegation.CompletionTime = completionTime
+		undelegation.Weights = delegator.Weights
 	}
+	k.addUndelegation(ctx, undelegation)
+	return completionTime, nil
+}
+
+// beforeProxyTokensModified calls the hook of the proxy whose rewards are split by the tokens of the delegator, which is
+// the proxy bound by the delegator or the delegator itself as a proxy
+func (k Keeper) beforeProxyTokensModified(ctx sdk.Context, delegator types.Delegator) {
+	if delegator.HasProxy() {
+		k.BeforeProxyModified(ctx, delegator.ProxyAddress)
+	} else if delegator.IsProxy {
+		k.BeforeProxyModified(ctx, delegator.DelegatorAddress)
+	}
+}
+
+// addUndelegation stores a new undelegation entry of the delegator with the next id, which is matured at its completion
+// time
+func (k Keeper) addUndelegation(ctx sdk.Context, undelegation types.UndelegationInfo) types.UndelegationInfo {
+	undelegation.ID = k.getNextUndelegationID(ctx)
+	undelegation.CreationHeight = ctx.BlockHeight()
 	k.SetUndelegating(ctx, undelegation)
-	k.SetAddrByTimeKeyWithNilValue(ctx, completionTime, delAddr)
+	k.SetAddrByTimeKeyWithNilValue(ctx, undelegation.CompletionTime, undelegation.DelegatorAddress, undelegation.ID)
+	return undelegation
+}
 
-	return completionTime, nil
+// CancelUndelegation bonds the tokens of a pending undelegation entry back and restores the shares of the delegator
+func (k Keeper) CancelUndelegation(ctx sdk.Context, delAddr sdk.AccAddress, id uint64) (types.UndelegationInfo,
+	error) {
+	ud, found := k.GetUndelegating(ctx, delAddr, id)
+	if !found {
+		return ud, types.ErrNotInDelegating(delAddr.String())
+	}
+	if !ctx.BlockHeader().Time.Before(ud.CompletionTime) || !ud.Quantity.IsPositive() {
+		return ud, types.ErrUndelegationMatured(delAddr.String(), id)
+	}
+
+	// 1.transfer the okt of the undelegation from unbondPool back into bondPool
+	k.notBondedTokensToBonded(ctx, sdk.NewDecCoinFromDec(k.BondDenom(ctx), ud.Quantity))
+
+	// 2.remove the undelegation entry
+	k.DeleteUndelegating(ctx, delAddr, id)
+	k.DeleteAddrByTimeKey(ctx, ud.CompletionTime, delAddr, id)
+
+	// 3.add the tokens back to the delegator, which adds shares to the validators of the undelegation again when the
+	// delegator has withdrawn all of its tokens
+	delegator, found := k.GetDelegator(ctx, delAddr)
+	if !found {
+		delegator = k.restoreDelegator(ctx, ud)
+	}
+	return ud, k.addDelegatorTokens(ctx, delegator, ud.Quantity)
 }
 
-// GetUndelegating gets UndelegationInfo entity from store
-func (k Keeper) GetUndelegating(ctx sdk.Context, delAddr sdk.AccAddress) (undelegationInfo types.UndelegationInfo,
-	found bool) {
-	bytes := ctx.KVStore(k.storeKey).Get(types.GetUndelegationInfoKey(delAddr))
+// restoreDelegator recreates the delegator deleted by withdrawing all of its tokens with the validators and the weights
+// of the undelegation. The delegator bound to a proxy is
+// bound to it again when the proxy is still registered, otherwise its tokens are credited without adding any shares, as
+// the validators of the undelegation are the ones of the proxy
+func (k Keeper) restoreDelegator(ctx sdk.Context, ud types.UndelegationInfo) types.Delegator {
+	delegator := types.NewDelegator(ud.DelegatorAddress)
+	if ud.ProxyAddress != nil {
+		if proxy, found := k.GetDelegator(ctx, ud.ProxyAddress); found && proxy.IsProxy {
+			delegator.BindProxy(ud.ProxyAddress)
+			k.SetProxyBinding(ctx, ud.ProxyAddress, ud.DelegatorAddress, false)
+		}
+		return delegator
+	}
+
+	for i, valAddr := range ud.ValidatorAddresses {
+		if val, found := k.GetValidator(ctx, valAddr); found && !val.MinSelfDelegation.IsZero() {
+			delegator.ValidatorAddresses = append(delegator.ValidatorAddresses, valAddr)
+			if len(ud.Weights) != 0 {
+				delegator.Weights = append(delegator.Weights, ud.Weights[i])
+			}
+		}
+	}
+	return delegator
+}
+
+// getValAddrsAddedSharesTo returns the addresses of the validators that the tokens of the delegator added shares to
+func (k Keeper) getValAddrsAddedSharesTo(ctx sdk.Context, delegator types.Delegator) []sdk.ValAddress {
+	if delegator.HasProxy() {
+		if proxy, found := k.GetDelegator(ctx, delegator.ProxyAddress); found {
+			return proxy.ValidatorAddresses
+		}
+		return nil
+	}
+	return delegator.ValidatorAddresses
+}
+
+// GetUndelegating gets the UndelegationInfo entity with the id from store
+func (k Keeper) GetUndelegating(ctx sdk.Context, delAddr sdk.AccAddress, id uint64) (
+	undelegationInfo types.UndelegationInfo, found bool) {
+	bytes := ctx.KVStore(k.storeKey).Get(types.GetUndelegationInfoKey(delAddr, id))
 	if bytes == nil {
 		return undelegationInfo, false
 	}
@@ -148,21 +235,54 @@ func (k Keeper) GetUndelegating(ctx sdk.Context, delAddr sdk.AccAddress) (undele
 	return undelegationInfo, true
 }
 
+// GetUndelegations gets all of the UndelegationInfo entities of the delegator from store
+func (k Keeper) GetUndelegations(ctx sdk.Context, delAddr sdk.AccAddress) (undelegations types.UndelegationInfos) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetUndelegationInfosKey(delAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		undelegations = append(undelegations, types.MustUnMarshalUndelegationInfo(k.cdc, iterator.Value()))
+	}
+	return
+}
+
 // SetUndelegating sets UndelegationInfo entity to store
 func (k Keeper) SetUndelegating(ctx sdk.Context, undelegationInfo types.UndelegationInfo) {
-	key := types.GetUndelegationInfoKey(undelegationInfo.DelegatorAddress)
+	key := types.GetUndelegationInfoKey(undelegationInfo.DelegatorAddress, undelegationInfo.ID)
 	bytes := k.cdc.MustMarshalBinaryLengthPrefixed(undelegationInfo)
 	ctx.KVStore(k.storeKey).Set(key, bytes)
 }
 
 // DeleteUndelegating deletes UndelegationInfo from store
-func (k Keeper) DeleteUndelegating(ctx sdk.Context, delAddr sdk.AccAddress) {
-	ctx.KVStore(k.storeKey).Delete(types.GetUndelegationInfoKey(delAddr))
+func (k Keeper) DeleteUndelegating(ctx sdk.Context, delAddr sdk.AccAddress, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetUndelegationInfoKey(delAddr, id))
+}
+
+// getNextUndelegationID returns the id for a new undelegation entry and increases it
+func (k Keeper) getNextUndelegationID(ctx sdk.Context) uint64 {
+	id := k.GetNextUndelegationID(ctx)
+	k.SetNextUndelegationID(ctx, id+1)
+	return id
+}
+
+// GetNextUndelegationID gets the id for the next undelegation entry from store
+func (k Keeper) GetNextUndelegationID(ctx sdk.Context) uint64 {
+	bytes := ctx.KVStore(k.storeKey).Get(types.UnDelegationIDKey)
+	if bytes == nil {
+		// id 0 is reserved for the undelegation stored before the entries were introduced
+		return 1
+	}
+	return sdk.BigEndianToUint64(bytes)
+}
+
+// SetNextUndelegationID sets the id for the next undelegation entry to store
+func (k Keeper) SetNextUndelegationID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.UnDelegationIDKey, sdk.Uint64ToBigEndian(id))
 }
 
-// CompleteUndelegation handles the final process when the undelegation is completed
-func (k Keeper) CompleteUndelegation(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Dec, error) {
-	ud, found := k.GetUndelegating(ctx, delAddr)
+// CompleteUndelegation handles the final process when the undelegation entry is completed
+func (k Keeper) CompleteUndelegation(ctx sdk.Context, delAddr sdk.AccAddress, id uint64) (sdk.Dec, error) {
+	ud, found := k.GetUndelegating(ctx, delAddr, id)
 	if !found {
 		return sdk.NewDec(0), types.ErrNotInDelegating(delAddr.String())
 	}
@@ -174,7 +294,7 @@ func (k Keeper) CompleteUndelegation(ctx sdk.Context, delAddr sdk.AccAddress) (s
 		return sdk.NewDec(0), err
 	}
 
-	k.DeleteUndelegating(ctx, delAddr)
+	k.DeleteUndelegating(ctx, delAddr, id)
 	return ud.Quantity, nil
 }
 
@@ -195,14 +315,14 @@ func (k Keeper) IterateUndelegationInfo(ctx sdk.Context,
 	}
 }
 
-// SetAddrByTimeKeyWithNilValue sets the time+delAddr key into store with an empty value
-func (k Keeper) SetAddrByTimeKeyWithNilValue(ctx sdk.Context, timestamp time.Time, delAddr sdk.AccAddress) {
-	ctx.KVStore(k.storeKey).Set(types.GetCompleteTimeWithAddrKey(timestamp, delAddr), []byte{})
+// SetAddrByTimeKeyWithNilValue sets the time+delAddr+id key into store with an empty value
+func (k Keeper) SetAddrByTimeKeyWithNilValue(ctx sdk.Context, timestamp time.Time, delAddr sdk.AccAddress, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetCompleteTimeWithAddrKey(timestamp, delAddr, id), []byte{})
 }
 
-// DeleteAddrByTimeKey deletes the time+delAddr key from store
-func (k Keeper) DeleteAddrByTimeKey(ctx sdk.Context, timestamp time.Time, delAddr sdk.AccAddress) {
-	ctx.KVStore(k.storeKey).Delete(types.GetCompleteTimeWithAddrKey(timestamp, delAddr))
+// DeleteAddrByTimeKey deletes the time+delAddr+id key from store
+func (k Keeper) DeleteAddrByTimeKey(ctx sdk.Context, timestamp time.Time, delAddr sdk.AccAddress, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetCompleteTimeWithAddrKey(timestamp, delAddr, id))
 }
 
 // IterateKeysBeforeCurrentTime iterates for all keys of (time+delAddr) from time 0 until the current Blockheader time
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/exchain/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndelegationEntries(t *testing.T) {
+	ctx, k, _ := setupSlashTest(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime)
+
+	// every withdrawal gets its own entry with its own completion time
+	completionTime1, err := k.Withdraw(ctx, addrDels[1], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(300)))
+	require.Nil(t, err)
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	completionTime2, err := k.Withdraw(ctx, addrDels[1], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(200)))
+	require.Nil(t, err)
+	require.True(t, completionTime1.Before(completionTime2))
+
+	undelegations := k.GetUndelegations(ctx, addrDels[1])
+	require.Equal(t, 2, len(undelegations))
+	require.Equal(t, uint64(1), undelegations[0].ID)
+	require.True(t, undelegations[0].Quantity.Equal(sdk.NewDec(300)))
+	require.Equal(t, completionTime1, undelegations[0].CompletionTime)
+	require.Equal(t, uint64(2), undelegations[1].ID)
+	require.True(t, undelegations[1].Quantity.Equal(sdk.NewDec(200)))
+	require.Equal(t, completionTime2, undelegations[1].CompletionTime)
+
+	// only the first entry is matured at its completion time
+	var matured []uint64
+	k.IterateKeysBeforeCurrentTime(ctx, completionTime1, func(_ int64, key []byte) (stop bool) {
+		_, delAddr, id := types.SplitCompleteTimeWithAddrKey(key)
+		require.Equal(t, addrDels[1], delAddr)
+		matured = append(matured, id)
+		return false
+	})
+	require.Equal(t, []uint64{1}, matured)
+	k.DeleteAddrByTimeKey(ctx, completionTime1, addrDels[1], 1)
+	quantity, err := k.CompleteUndelegation(ctx, addrDels[1], 1)
+	require.Nil(t, err)
+	require.True(t, quantity.Equal(sdk.NewDec(300)))
+	undelegations = k.GetUndelegations(ctx, addrDels[1])
+	require.Equal(t, 1, len(undelegations))
+	require.Equal(t, uint64(2), undelegations[0].ID)
+	requireSlashInvariants(t, ctx, k)
+}
+
+func TestCancelUndelegation(t *testing.T) {
+	ctx, k, _ := setupSlashTest(t)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	delegatorBefore, found := k.GetDelegator(ctx, addrDels[1])
+	require.True(t, found)
+	validatorBefore, found := k.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+
+	// withdraw all of the tokens, which deletes the delegator
+	completionTime, err := k.Withdraw(ctx, addrDels[1], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(1000)))
+	require.Nil(t, err)
+	_, found = k.GetDelegator(ctx, addrDels[1])
+	require.False(t, found)
+
+	// the inexistent entry can't be canceled
+	_, err = k.CancelUndelegation(ctx, addrDels[1], 2)
+	require.NotNil(t, err)
+
+	// the matured entry can't be canceled
+	_, err = k.CancelUndelegation(ctx.WithBlockTime(completionTime), addrDels[1], 1)
+	require.NotNil(t, err)
+
+	// the pending entry is bonded back and the shares are restored
+	undelegation, err := k.CancelUndelegation(ctx, addrDels[1], 1)
+	require.Nil(t, err)
+	require.True(t, undelegation.Quantity.Equal(sdk.NewDec(1000)))
+	require.Equal(t, 0, len(k.GetUndelegations(ctx, addrDels[1])))
+	k.IterateKeysBeforeCurrentTime(ctx, completionTime, func(_ int64, key []byte) (stop bool) {
+		t.Fatalf("unexpected undelegation key %X", key)
+		return true
+	})
+
+	delegator, found := k.GetDelegator(ctx, addrDels[1])
+	require.True(t, found)
+	require.True(t, delegator.Tokens.Equal(delegatorBefore.Tokens))
+	require.Equal(t, delegatorBefore.ValidatorAddresses, delegator.ValidatorAddresses)
+	require.True(t, delegator.Shares.IsPositive())
+	validator, found := k.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.DelegatorShares.Sub(validatorBefore.DelegatorShares.Sub(delegatorBefore.Shares)).
+		Equal(delegator.Shares))
+	requireSlashInvariants(t, ctx, k)
+}
+
+func TestCancelUndelegationWithProxy(t *testing.T) {
+	ctx, k, _ := setupSlashTest(t)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	// the delegator 0 is registered as a proxy and the delegator 2 binds to it
+	proxy, found := k.GetDelegator(ctx, addrDels[0])
+	require.True(t, found)
+	proxy.RegProxy(true)
+	k.SetDelegator(ctx, proxy)
+	require.Nil(t, k.Delegate(ctx, addrDels[2], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(1000))))
+	delegator2, found := k.GetDelegator(ctx, addrDels[2])
+	require.True(t, found)
+	delegator2.BindProxy(addrDels[0])
+	k.SetDelegator(ctx, delegator2)
+	k.SetProxyBinding(ctx, addrDels[0], addrDels[2], false)
+	require.Nil(t, k.UpdateProxy(ctx, delegator2, delegator2.Tokens))
+	proxyBefore, found := k.GetDelegator(ctx, addrDels[0])
+	require.True(t, found)
+
+	// withdraw all of the tokens, which unbinds the proxy
+	_, err := k.Withdraw(ctx, addrDels[2], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(1000)))
+	require.Nil(t, err)
+	proxy, found = k.GetDelegator(ctx, addrDels[0])
+	require.True(t, found)
+	require.True(t, proxy.TotalDelegatedTokens.IsZero())
+	undelegations := k.GetUndelegations(ctx, addrDels[2])
+	require.Equal(t, 1, len(undelegations))
+	require.Equal(t, addrDels[0], undelegations[0].ProxyAddress)
+
+	// the proxy is bound again and its shares are restored, without any shares added by the delegator itself
+	_, err = k.CancelUndelegation(ctx, addrDels[2], undelegations[0].ID)
+	require.Nil(t, err)
+	delegator2, found = k.GetDelegator(ctx, addrDels[2])
+	require.True(t, found)
+	require.Equal(t, addrDels[0], delegator2.ProxyAddress)
+	require.True(t, delegator2.Tokens.Equal(sdk.NewDec(1000)))
+	require.True(t, delegator2.Shares.IsZero())
+	require.Equal(t, 0, len(delegator2.ValidatorAddresses))
+	proxy, found = k.GetDelegator(ctx, addrDels[0])
+	require.True(t, found)
+	require.True(t, proxy.TotalDelegatedTokens.Equal(proxyBefore.TotalDelegatedTokens))
+	require.True(t, proxy.Shares.Equal(proxyBefore.Shares))
+	var bound []sdk.AccAddress
+	k.IterateProxy(ctx, addrDels[0], false, func(_ int64, delAddr, _ sdk.AccAddress) (stop bool) {
+		bound = append(bound, delAddr)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addrDels[2]}, bound)
+	requireSlashInvariants(t, ctx, k)
+}
+
+func TestCancelUndelegationWithWeights(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, SufficientInitBalance)
+	k := mKeeper.Keeper
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	vals := createVals(ctx, 2, k)
+
+	// the delegator adds the shares to the validators by the weights
+	delAddr := addrDels[0]
+	require.Nil(t, k.Delegate(ctx, delAddr, sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(1000))))
+	weights := []sdk.Dec{sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(4, 1)}
+	shares, err := k.AddSharesToValidatorsWithWeights(ctx, delAddr, vals, sdk.NewDec(1000), weights)
+	require.Nil(t, err)
+	delegator, found := k.GetDelegator(ctx, delAddr)
+	require.True(t, found)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vals[0].OperatorAddress, vals[1].OperatorAddress}
+	delegator.Shares = shares
+	delegator.Weights = weights
+	k.SetDelegator(ctx, delegator)
+
+	// withdraw all of the tokens, whose undelegation keeps the weights
+	_, err = k.Withdraw(ctx, delAddr, sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(1000)))
+	require.Nil(t, err)
+	_, found = k.GetDelegator(ctx, delAddr)
+	require.False(t, found)
+	undelegations := k.GetUndelegations(ctx, delAddr)
+	require.Equal(t, 1, len(undelegations))
+	require.Equal(t, weights, undelegations[0].Weights)
+
+	// the weights are restored with the shares
+	_, err = k.CancelUndelegation(ctx, delAddr, undelegations[0].ID)
+	require.Nil(t, err)
+	delegator, found = k.GetDelegator(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, weights, delegator.Weights)
+	require.True(t, delegator.Shares.IsPositive())
+	for i, val := range getVals(ctx, vals, k, t) {
+		valShares, found := k.GetShares(ctx, delAddr, val.OperatorAddress)
+		require.True(t, found)
+		require.Equal(t, delegator.Shares.MulTruncate(weights[i]), valShares)
+		require.Equal(t, vals[i].DelegatorShares.Add(valShares), val.DelegatorShares)
+	}
+}
@@ -6,7 +6,11 @@ import (
 )
 
 // Implements StakingHooks interface
-var _ types.StakingHooks = Keeper{}
+var (
+	_ types.StakingHooks = Keeper{}
+	_ types.SharesHooks  = Keeper{}
+	_ types.ProxyHooks   = Keeper{}
+)
 
 // AfterValidatorCreated - call hook if registered
 func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
@@ -49,3 +53,24 @@ func (k Keeper) AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddres
 		k.hooks.AfterValidatorDestroyed(ctx, consAddr, valAddr)
 	}
 }
+
+// BeforeDelegationSharesModified - call hook if registered
+func (k Keeper) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if k.sharesHooks != nil {
+		k.sharesHooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	}
+}
+
+// AfterDelegationModified - call hook if registered
+func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if k.sharesHooks != nil {
+		k.sharesHooks.AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+}
+
+// BeforeProxyModified - call hook if registered
+func (k Keeper) BeforeProxyModified(ctx sdk.Context, proxyAddr sdk.AccAddress) {
+	if k.proxyHooks != nil {
+		k.proxyHooks.BeforeProxyModified(ctx, proxyAddr)
+	}
+}
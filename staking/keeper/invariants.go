@@ -38,7 +38,7 @@ func DelegatorAddSharesInvariant(k Keeper) sdk.Invariant {
 			if validator.MinSelfDelegation.Equal(sdk.ZeroDec()) && validator.Jailed {
 				totalShares = sdk.ZeroDec()
 			} else {
-				totalShares = k.getSharesFromDefaultMinSelfDelegation()
+				totalShares = k.GetSharesFromDefaultMinSelfDelegation()
 			}
 
 			allShares := k.GetValidatorAllShares(ctx, validator.GetOperator())
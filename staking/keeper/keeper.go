@@ -25,6 +25,8 @@ type Keeper struct {
 	cdc                *codec.Codec
 	supplyKeeper       types.SupplyKeeper
 	hooks              types.StakingHooks
+	sharesHooks        types.SharesHooks
+	proxyHooks         types.ProxyHooks
 	paramstore         params.Subspace
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
@@ -68,6 +70,24 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	return k
 }
 
+// SetSharesHooks sets the hooks on the shares that delegators add to validators
+func (k *Keeper) SetSharesHooks(sh types.SharesHooks) *Keeper {
+	if k.sharesHooks != nil {
+		panic("cannot set shares hooks twice")
+	}
+	k.sharesHooks = sh
+	return k
+}
+
+// SetProxyHooks sets the proxy hooks
+func (k *Keeper) SetProxyHooks(ph types.ProxyHooks) *Keeper {
+	if k.proxyHooks != nil {
+		panic("cannot set proxy hooks twice")
+	}
+	k.proxyHooks = ph
+	return k
+}
+
 // Codespace returns the codespace
 func (k Keeper) Codespace() string {
 	return types.ModuleName
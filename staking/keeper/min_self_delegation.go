@@ -16,7 +16,7 @@ func (k Keeper) WithdrawMinSelfDelegation(ctx sdk.Context, delAddr sdk.AccAddres
 	}
 
 	// 1.check the remained shares on the validator
-	remainShares := validator.GetDelegatorShares().Sub(k.getSharesFromDefaultMinSelfDelegation())
+	remainShares := validator.GetDelegatorShares().Sub(k.GetSharesFromDefaultMinSelfDelegation())
 	if remainShares.LT(sdk.ZeroDec()) {
 		return completionTime, types.ErrMoreMinSelfDelegation(validator.OperatorAddress.String())
 	}
@@ -27,19 +27,9 @@ func (k Keeper) WithdrawMinSelfDelegation(ctx sdk.Context, delAddr sdk.AccAddres
 	if ctx.BlockHeader().Height >= sdk.DefaultLevelUpBlockHeight {
 		completionTime = ctx.BlockHeader().Time.Add(sdk.DefaultLevelUpUnbondingTime)
 	}
-	//minSelfUndelegation := types.NewUndelegationInfo(delAddr, validator.MinSelfDelegation, completionTime)
-	undelegation, found := k.GetUndelegating(ctx, delAddr)
-	if !found {
-		undelegation = types.NewUndelegationInfo(delAddr, validator.MinSelfDelegation, completionTime)
-	} else {
-		k.DeleteAddrByTimeKey(ctx, undelegation.CompletionTime, delAddr)
-		undelegation.Quantity = undelegation.Quantity.Add(validator.MinSelfDelegation)
-		undelegation.CompletionTime = completionTime
-	}
-	k.SetUndelegating(ctx, undelegation)
-	k.SetAddrByTimeKeyWithNilValue(ctx, undelegation.CompletionTime, undelegation.DelegatorAddress)
-	//k.SetUndelegating(ctx, minSelfUndelegation)
-	//k.SetAddrByTimeKeyWithNilValue(ctx, minSelfUndelegation.CompletionTime, minSelfUndelegation.DelegatorAddress)
+	undelegation := types.NewUndelegationInfo(delAddr, validator.MinSelfDelegation, completionTime)
+	undelegation.AddValidators([]sdk.ValAddress{validator.OperatorAddress})
+	k.addUndelegation(ctx, undelegation)
 
 	// 3.clear the msd
 	validator.MinSelfDelegation = sdk.ZeroDec()
@@ -91,13 +81,14 @@ func (k Keeper) AddSharesAsMinSelfDelegation(ctx sdk.Context, delAddr sdk.AccAdd
 func (k Keeper) addSharesAsDefaultMinSelfDelegation(ctx sdk.Context, pValidator *types.Validator) {
 	k.DeleteValidatorByPowerIndex(ctx, *pValidator)
 	//TODO: current rule: any msd -> 1 shares
-	shares := k.getSharesFromDefaultMinSelfDelegation()
+	shares := k.GetSharesFromDefaultMinSelfDelegation()
 	pValidator.DelegatorShares = pValidator.GetDelegatorShares().Add(shares)
 	k.SetValidator(ctx, *pValidator)
 	k.SetValidatorByPowerIndex(ctx, *pValidator)
 }
 
+// GetSharesFromDefaultMinSelfDelegation returns the shares added by the msd of a validator
 // RULES: any msd -> 1 shares
-func (k Keeper) getSharesFromDefaultMinSelfDelegation() sdk.Dec {
+func (k Keeper) GetSharesFromDefaultMinSelfDelegation() sdk.Dec {
 	return sdk.OneDec()
 }
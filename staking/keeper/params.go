@@ -45,6 +45,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 			k.ParamsMaxValsToAddShares(ctx),
 			k.ParamsMinDelegation(ctx),
 			k.ParamsMinSelfDelegation(ctx),
+			k.ParamsMinCommissionRate(ctx),
+			k.ParamsWeightCurve(ctx),
 		)
 	} else {
 		return types.NewParams(
@@ -54,6 +56,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 			k.ParamsMaxValsToAddShares(ctx),
 			k.ParamsMinDelegation(ctx),
 			k.ParamsMinSelfDelegation(ctx),
+			k.ParamsMinCommissionRate(ctx),
+			k.ParamsWeightCurve(ctx),
 		)
 	}
 }
@@ -128,3 +132,51 @@ func (k Keeper) ParamsMinSelfDelegation(ctx sdk.Context) (num sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyMinSelfDelegation, &num)
 	return
 }
+
+// ParamsMinCommissionRate returns the param MinCommissionRate, which is zero before it's set by the governance
+func (k Keeper) ParamsMinCommissionRate(ctx sdk.Context) (rate sdk.Dec) {
+	rate = types.DefaultMinCommissionRate
+	k.paramstore.GetIfExists(ctx, types.KeyMinCommissionRate, &rate)
+	return
+}
+
+// ParamsWeightCurve returns the param WeightCurve, which is the default curve before it's set by the governance
+func (k Keeper) ParamsWeightCurve(ctx sdk.Context) (curve types.WeightCurve) {
+	curve = types.DefaultWeightCurve()
+	k.paramstore.GetIfExists(ctx, types.KeyWeightCurve, &curve)
+	return
+}
+
+// GetWeightCurve returns the weight curve that the shares in the store are calculated by. It's only updated by the
+// param WeightCurve after the last epoch ends
+func (k Keeper) GetWeightCurve(ctx sdk.Context) (curve types.WeightCurve) {
+	b := ctx.KVStore(k.storeKey).Get(types.WeightCurveKey)
+	if b == nil {
+		return types.DefaultWeightCurve()
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &curve)
+	return
+}
+
+// SetWeightCurve sets the weight curve that the shares are calculated by into keystore
+func (k Keeper) SetWeightCurve(ctx sdk.Context, curve types.WeightCurve) {
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(curve)
+	ctx.KVStore(k.storeKey).Set(types.WeightCurveKey, b)
+}
+
+// CheckParamChange checks the value of the param to change with the chain state. The epoch timestamp of the param
+// WeightCurve can't be later than the block time, before which the weight is clamped at zero
+func (k Keeper) CheckParamChange(ctx sdk.Context, key, value string) error {
+	if key != string(types.KeyWeightCurve) {
+		return nil
+	}
+
+	var curve types.WeightCurve
+	if err := k.cdc.UnmarshalJSON([]byte(value), &curve); err != nil {
+		return err
+	}
+	if blockTimestamp := ctx.BlockTime().Unix(); curve.EpochTimestamp > blockTimestamp {
+		return types.ErrFutureWeightCurveEpoch(curve.EpochTimestamp, blockTimestamp)
+	}
+	return nil
+}
@@ -26,6 +26,16 @@ func (k Keeper) bondedTokensToNotBonded(ctx sdk.Context, tokens sdk.SysCoin) {
 	}
 }
 
+// notBondedTokensToBonded unbondedPool -> bondedPool
+func (k Keeper) notBondedTokensToBonded(ctx sdk.Context, tokens sdk.SysCoin) {
+
+	coins := tokens.ToCoins()
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.NotBondedPoolName, types.BondedPoolName, coins)
+	if err != nil {
+		panic(err)
+	}
+}
+
 // TotalBondedTokens total staking tokens supply which is bonded
 // TODO:No usages found in project files,remove it later
 func (k Keeper) TotalBondedTokens(ctx sdk.Context) sdk.Dec {
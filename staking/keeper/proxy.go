@@ -52,14 +52,14 @@ func (k Keeper) IterateProxy(ctx sdk.Context, proxyAddr sdk.AccAddress, isClear
 // UpdateShares withdraws and adds shares continuously on the same validator set with different amount of shares
 func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk.Dec) error {
 	// get last validators that were added shares to and existing in the store
-	vals, lastShares := k.GetLastValsAddedSharesExisted(ctx, delAddr)
+	vals, lastShares, weights := k.GetLastValsAddedSharesExisted(ctx, delAddr)
 	if vals == nil {
 		// if the delegator never adds shares, just pass
 		return nil
 	}
 
 	lenVals := len(vals)
-	shares, sdkErr := calculateWeight(ctx.BlockTime().Unix(), tokens)
+	shares, sdkErr := k.calculateShares(ctx, tokens)
 	if sdkErr != nil {
 		return sdkErr
 	}
@@ -78,12 +78,15 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 		k.DeleteValidatorByPowerIndex(ctx, vals[i])
 
 		// 2.update shares
-		k.SetShares(ctx, delAddr, vals[i].OperatorAddress, shares)
+		valShares := sharesByWeight(shares, weights, i)
+		k.BeforeDelegationSharesModified(ctx, delAddr, vals[i].OperatorAddress)
+		k.SetShares(ctx, delAddr, vals[i].OperatorAddress, valShares)
 
 		// 3.update validator
-		vals[i].DelegatorShares = vals[i].DelegatorShares.Sub(lastShares).Add(shares)
+		vals[i].DelegatorShares = vals[i].DelegatorShares.Sub(sharesByWeight(lastShares, weights, i)).Add(valShares)
 		k.SetValidator(ctx, vals[i])
 		k.SetValidatorByPowerIndex(ctx, vals[i])
+		k.AfterDelegationModified(ctx, delAddr, vals[i].OperatorAddress)
 	}
 
 	// update the delegator struct
@@ -96,28 +99,45 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 // AddSharesToValidators adds shares to validators and return the amount of the shares
 func (k Keeper) AddSharesToValidators(ctx sdk.Context, delAddr sdk.AccAddress, vals types.Validators, tokens sdk.Dec) (
 	shares types.Shares, sdkErr error) {
+	return k.AddSharesToValidatorsWithWeights(ctx, delAddr, vals, tokens, nil)
+}
+
+// AddSharesToValidatorsWithWeights adds shares to validators by weights and return the amount of the total shares.
+// Every validator gets the total shares if the weights are empty
+func (k Keeper) AddSharesToValidatorsWithWeights(ctx sdk.Context, delAddr sdk.AccAddress, vals types.Validators,
+	tokens sdk.Dec, weights []sdk.Dec) (shares types.Shares, sdkErr error) {
 	lenVals := len(vals)
-	shares, sdkErr = calculateWeight(ctx.BlockTime().Unix(), tokens)
+	shares, sdkErr = k.calculateShares(ctx, tokens)
 	if sdkErr != nil {
 		return
 	}
 	for i := 0; i < lenVals; i++ {
-		k.addShares(ctx, delAddr, vals[i], shares)
+		k.addShares(ctx, delAddr, vals[i], sharesByWeight(shares, weights, i))
 	}
 	return
 }
 
 // WithdrawLastShares withdraws the shares last time from the validators
 func (k Keeper) WithdrawLastShares(ctx sdk.Context, delAddr sdk.AccAddress, lastValsAddedSharesTo types.Validators,
-	lastShares types.Shares) {
+	lastShares types.Shares, lastWeights []sdk.Dec) {
 	lenLastVals := len(lastValsAddedSharesTo)
 	for i := 0; i < lenLastVals; i++ {
-		k.withdrawShares(ctx, delAddr, lastValsAddedSharesTo[i], lastShares)
+		k.withdrawShares(ctx, delAddr, lastValsAddedSharesTo[i], sharesByWeight(lastShares, lastWeights, i))
+	}
+}
+
+// sharesByWeight gets the shares added to the i-th validator from the total shares of a delegator
+func sharesByWeight(shares types.Shares, weights []sdk.Dec, i int) types.Shares {
+	if len(weights) == 0 {
+		return shares
 	}
+
+	return shares.MulTruncate(weights[i])
 }
 
 func (k Keeper) withdrawShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Validator, shares types.Shares) {
 	// 1.delete shares entity
+	k.BeforeDelegationSharesModified(ctx, delAddr, val.OperatorAddress)
 	k.DeleteShares(ctx, val.OperatorAddress, delAddr)
 
 	// 2.update validator entity
@@ -138,6 +158,7 @@ func (k Keeper) withdrawShares(ctx sdk.Context, delAddr sdk.AccAddress, val type
 
 func (k Keeper) addShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Validator, shares types.Shares) {
 	// 1.update shares entity
+	k.BeforeDelegationSharesModified(ctx, delAddr, val.OperatorAddress)
 	k.SetShares(ctx, delAddr, val.OperatorAddress, shares)
 
 	// 2.update validator entity
@@ -145,30 +166,37 @@ func (k Keeper) addShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Val
 	val.DelegatorShares = val.GetDelegatorShares().Add(shares)
 	k.SetValidator(ctx, val)
 	k.SetValidatorByPowerIndex(ctx, val)
+	k.AfterDelegationModified(ctx, delAddr, val.OperatorAddress)
 }
 
-// GetLastValsAddedSharesExisted gets last validators that the delegator added shares to last time
-func (k Keeper) GetLastValsAddedSharesExisted(ctx sdk.Context, delAddr sdk.AccAddress) (types.Validators, types.Shares) {
+// GetLastValsAddedSharesExisted gets last validators that the delegator added shares to last time, with the weights
+// of the shares on them in the same order. The weights are nil if the delegator added the total shares to every validator
+func (k Keeper) GetLastValsAddedSharesExisted(ctx sdk.Context, delAddr sdk.AccAddress) (types.Validators, types.Shares,
+	[]sdk.Dec) {
 	// 1.get delegator entity
 	delegator, found := k.GetDelegator(ctx, delAddr)
 
 	// if not found
 	if !found {
-		return nil, sdk.ZeroDec()
+		return nil, sdk.ZeroDec(), nil
 	}
 
 	// 2.get validators that were added shares to and existing in the store
 	lenVals := len(delegator.ValidatorAddresses)
 	var vals types.Validators
+	var weights []sdk.Dec
 	for i := 0; i < lenVals; i++ {
 		val, found := k.GetValidator(ctx, delegator.ValidatorAddresses[i])
 		if found {
 			// the validator that were added shares to hasn't been removed
 			vals = append(vals, val)
+			if len(delegator.Weights) != 0 {
+				weights = append(weights, delegator.Weights[i])
+			}
 		}
 	}
 
-	return vals, delegator.Shares
+	return vals, delegator.Shares, weights
 }
 
 // GetValidatorsToAddShares gets the validators from their validator addresses
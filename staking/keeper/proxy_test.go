@@ -15,12 +15,13 @@ func TestAddSharesToValidatorsAndWithdraw(t *testing.T) {
 
 	// never add shares before
 	dlgAddr := addrDels[0]
-	lastVals, lastShares := keeper.GetLastValsAddedSharesExisted(ctx, dlgAddr)
+	lastVals, lastShares, lastWeights := keeper.GetLastValsAddedSharesExisted(ctx, dlgAddr)
 	require.Nil(t, lastVals)
 	require.True(t, lastShares.IsZero())
+	require.Nil(t, lastWeights)
 
 	// withdraw the shares last time
-	keeper.WithdrawLastShares(ctx, dlgAddr, lastVals, lastShares)
+	keeper.WithdrawLastShares(ctx, dlgAddr, lastVals, lastShares, lastWeights)
 
 	// add shares to validators
 	sharesOrig := sdk.NewDec(10000)
@@ -47,6 +48,58 @@ func TestAddSharesToValidatorsAndWithdraw(t *testing.T) {
 	require.Contains(t, r, "Operator Address")
 }
 
+func TestAddSharesToValidatorsWithWeights(t *testing.T) {
+	ctx, _, mkeeper := CreateTestInput(t, false, 0)
+	keeper := mkeeper.Keeper
+	valsOld := createVals(ctx, 2, keeper)
+
+	dlgAddr := addrDels[0]
+	weights := []sdk.Dec{sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(4, 1)}
+	shares, e := keeper.AddSharesToValidatorsWithWeights(ctx, dlgAddr, valsOld, sdk.NewDec(10000), weights)
+	require.Nil(t, e)
+
+	delegator := types.NewDelegator(dlgAddr)
+	delegator.ValidatorAddresses = []sdk.ValAddress{valsOld[0].OperatorAddress, valsOld[1].OperatorAddress}
+	delegator.Shares = shares
+	delegator.Weights = weights
+	keeper.SetDelegator(ctx, delegator)
+
+	// every validator gets the shares by its weight
+	valsNew := getVals(ctx, valsOld, keeper, t)
+	for i := 0; i < 2; i++ {
+		valShares, found := keeper.GetShares(ctx, dlgAddr, valsNew[i].OperatorAddress)
+		require.True(t, found)
+		require.Equal(t, shares.MulTruncate(weights[i]), valShares)
+		require.Equal(t, valsOld[i].DelegatorShares.Add(valShares), valsNew[i].DelegatorShares)
+	}
+
+	// the weights are reported with the last validators
+	lastVals, lastShares, lastWeights := keeper.GetLastValsAddedSharesExisted(ctx, dlgAddr)
+	require.Equal(t, 2, len(lastVals))
+	require.Equal(t, shares, lastShares)
+	require.Equal(t, weights, lastWeights)
+
+	// the shares are updated by the weights
+	require.NoError(t, keeper.UpdateShares(ctx, dlgAddr, sdk.NewDec(20000)))
+	delegator, found := keeper.GetDelegator(ctx, dlgAddr)
+	require.True(t, found)
+	for i, val := range getVals(ctx, valsOld, keeper, t) {
+		valShares, found := keeper.GetShares(ctx, dlgAddr, val.OperatorAddress)
+		require.True(t, found)
+		require.Equal(t, delegator.Shares.MulTruncate(weights[i]), valShares)
+		require.Equal(t, valsOld[i].DelegatorShares.Add(valShares), val.DelegatorShares)
+	}
+
+	// withdraw all the shares by the weights
+	lastVals, lastShares, lastWeights = keeper.GetLastValsAddedSharesExisted(ctx, dlgAddr)
+	keeper.WithdrawLastShares(ctx, dlgAddr, lastVals, lastShares, lastWeights)
+	for i, val := range getVals(ctx, valsOld, keeper, t) {
+		_, found := keeper.GetShares(ctx, dlgAddr, val.OperatorAddress)
+		require.False(t, found)
+		require.Equal(t, valsOld[i].DelegatorShares, val.DelegatorShares)
+	}
+}
+
 func createVals(ctx sdk.Context, num int, keeper Keeper) types.Validators {
 	vals := make(types.Validators, num)
 	for i := 0; i < num; i++ {
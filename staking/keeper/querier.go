@@ -39,6 +39,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryProxy(ctx, req, k)
 		case types.QueryDelegator:
 			return queryDelegator(ctx, req, k)
+		case types.QueryWeight:
+			return queryWeight(ctx, req, k)
 		default:
 			return nil, types.ErrUnknownStakingQueryType()
 		}
@@ -169,6 +171,50 @@ func queryProxy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error
 	return resp, nil
 }
 
+func queryWeight(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryWeightParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	curve := k.ParamsWeightCurve(ctx)
+	if params.Curve != nil {
+		curve = *params.Curve
+	}
+	if err := curve.Validate(); err != nil {
+		return nil, types.ErrInvalidWeightQuery(err.Error())
+	}
+	if params.Tokens.IsNil() || params.Tokens.IsNegative() {
+		return nil, types.ErrInvalidWeightQuery("tokens must not be negative")
+	}
+
+	timestamp := params.Timestamp
+	if timestamp == 0 {
+		timestamp = ctx.BlockTime().Unix()
+	}
+
+	weight, err := curve.Weight(timestamp)
+	if err != nil {
+		return nil, types.ErrInvalidWeightQuery(err.Error())
+	}
+	shares, err := SimulateWeight(curve, timestamp, params.Tokens)
+	if err != nil {
+		return nil, types.ErrInvalidWeightQuery(err.Error())
+	}
+
+	resp, err := codec.MarshalJSONIndent(types.ModuleCdc, types.WeightResponse{
+		Curve:     curve,
+		Timestamp: timestamp,
+		Weight:    weight,
+		Shares:    shares,
+	})
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return resp, nil
+}
+
 func queryValidatorAllShares(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
@@ -177,6 +223,13 @@ func queryValidatorAllShares(ctx sdk.Context, req abci.RequestQuery, k Keeper) (
 	}
 
 	sharesResponses := k.GetValidatorAllShares(ctx, params.ValidatorAddr)
+	for i, sr := range sharesResponses {
+		if delegator, found := k.GetDelegator(ctx, sr.DelAddr); found {
+			if weight, ok := delegator.GetWeight(params.ValidatorAddr); ok {
+				sharesResponses[i].Weight = weight
+			}
+		}
+	}
 	resp, err := codec.MarshalJSONIndent(types.ModuleCdc, sharesResponses)
 	if err != nil {
 		return nil, common.ErrMarshalJSONFailed(err.Error())
@@ -192,12 +245,12 @@ func queryUndelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 		return nil, common.ErrUnMarshalJSONFailed(err.Error())
 	}
 
-	undelegation, found := k.GetUndelegating(ctx, params.DelegatorAddr)
-	if !found {
+	undelegations := k.GetUndelegations(ctx, params.DelegatorAddr)
+	if len(undelegations) == 0 {
 		return nil, types.ErrNoUnbondingDelegation()
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, undelegation)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, undelegations)
 	if err != nil {
 		return nil, common.ErrMarshalJSONFailed(err.Error())
 	}
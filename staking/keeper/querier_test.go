@@ -209,3 +209,37 @@ func TestParams(t *testing.T) {
 	resParams = keeper.GetParams(ctx)
 	require.True(t, expParams.Equal(resParams))
 }
+
+func TestQueryWeight(t *testing.T) {
+	ctx, _, mockKeeper := CreateTestInput(t, false, SufficientInitBalance)
+	querior := NewQuerier(mockKeeper.Keeper)
+
+	secondsPerWeek := int64(60 * 60 * 24 * 7)
+	timestamp := types.DefaultWeightEpochTimestamp + 78*secondsPerWeek
+	query := func(curve *types.WeightCurve) (types.WeightResponse, error) {
+		bz, _ := amino.MarshalJSON(types.NewQueryWeightParams(types2.NewDec(100), timestamp, curve))
+		data, err := querior(ctx, []string{types.QueryWeight}, abci.RequestQuery{Data: bz})
+		var resp types.WeightResponse
+		if err == nil {
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(data, &resp))
+		}
+		return resp, err
+	}
+
+	// the param WeightCurve is used by default
+	resp, err := query(nil)
+	require.NoError(t, err)
+	require.True(t, types.DefaultWeightCurve().Equal(resp.Curve))
+	require.Equal(t, types2.NewDec(150), resp.Shares)
+
+	// preview the curve to change to
+	curve := types.NewWeightCurve(types.DefaultWeightEpochTimestamp, types.WeightGrowthCapped, types2.OneDec())
+	resp, err = query(&curve)
+	require.NoError(t, err)
+	require.Equal(t, types2.OneDec(), resp.Weight)
+	require.Equal(t, types2.NewDec(100), resp.Shares)
+
+	curve.Cap = types2.ZeroDec()
+	_, err = query(&curve)
+	require.Error(t, err)
+}
@@ -4,10 +4,171 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/exchain/x/staking/types"
 )
 
+// Slash slashes the validator and all the delegators who added shares to it by the slashFactor. The slashed tokens
+// of the delegators (including the ones bound to a proxy) and the min self delegation of the validator are burned from
+// the bonded pool, while the slashed tokens of the undelegations started from the validator since the infraction height
+// are burned from the not bonded pool. The shares of the slashed delegators are recalculated on every validator that
+// they added shares to.
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) {
+	logger := k.Logger(ctx)
+
+	if slashFactor.IsNegative() {
+		panic(fmt.Errorf("attempted to slash with a negative slash factor: %v", slashFactor))
+	}
+
+	if infractionHeight > ctx.BlockHeight() {
+		panic(fmt.Errorf("impossible attempt to slash future infraction at height %d but we are at height %d",
+			infractionHeight, ctx.BlockHeight()))
+	}
+
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		// the validator might have been removed after all of its shares were withdrawn
+		logger.Error(fmt.Sprintf("WARNING: ignored attempt to slash a nonexistent validator with address %s", consAddr))
+		return
+	}
+
+	// 1.slash the delegators who added shares to the validator
+	bondedBurned := sdk.ZeroDec()
+	sharesResps := k.GetValidatorAllShares(ctx, validator.OperatorAddress)
+	for _, sharesResp := range sharesResps {
+		delegator, found := k.GetDelegator(ctx, sharesResp.DelAddr)
+		if !found {
+			continue
+		}
+		bondedBurned = bondedBurned.Add(k.slashDelegator(ctx, delegator, slashFactor))
+	}
+
+	// 2.slash the msd of the validator
+	validator = k.mustGetValidator(ctx, validator.OperatorAddress)
+	msdBurned := validator.MinSelfDelegation.Mul(slashFactor)
+	if msdBurned.IsPositive() {
+		k.DeleteValidatorByPowerIndex(ctx, validator)
+		validator.MinSelfDelegation = validator.MinSelfDelegation.Sub(msdBurned)
+		k.SetValidator(ctx, validator)
+		k.SetValidatorByPowerIndex(ctx, validator)
+		bondedBurned = bondedBurned.Add(msdBurned)
+	}
+	k.burnTokens(ctx, types.BondedPoolName, bondedBurned)
+
+	// 3.slash the undelegations started from the validator since the infraction
+	notBondedBurned := k.slashUndelegations(ctx, validator.OperatorAddress, infractionHeight, slashFactor)
+	k.burnTokens(ctx, types.NotBondedPoolName, notBondedBurned)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", infractionHeight)),
+			sdk.NewAttribute(types.AttributeKeySlashFactor, slashFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnedTokens, bondedBurned.Add(notBondedBurned).String()),
+		),
+	)
+
+	logger.Info(fmt.Sprintf("validator %s slashed by slash factor of %s; burned %s bonded tokens and %s not bonded tokens",
+		validator.OperatorAddress, slashFactor, bondedBurned, notBondedBurned))
+}
+
+// slashDelegator slashes the tokens of the delegator by the slashFactor and updates its shares. The delegators bound to
+// the delegator are slashed as well when it's a proxy. It returns the amount of the tokens slashed
+func (k Keeper) slashDelegator(ctx sdk.Context, delegator types.Delegator, slashFactor sdk.Dec) sdk.Dec {
+	// settle the rewards of the bound delegators by their tokens before slashing
+	k.beforeProxyTokensModified(ctx, delegator)
+	slashed := delegator.Tokens.Mul(slashFactor)
+	delegator.Tokens = delegator.Tokens.Sub(slashed)
+
+	if delegator.IsProxy {
+		// the shares of the proxy are added by the tokens of the delegators bound to it as well
+		proxySlashed := sdk.ZeroDec()
+		for _, delAddr := range k.GetDelegatorsByProxy(ctx, delegator.DelegatorAddress) {
+			boundDelegator, found := k.GetDelegator(ctx, delAddr)
+			if !found {
+				continue
+			}
+			boundSlashed := boundDelegator.Tokens.Mul(slashFactor)
+			boundDelegator.Tokens = boundDelegator.Tokens.Sub(boundSlashed)
+			k.SetDelegator(ctx, boundDelegator)
+			proxySlashed = proxySlashed.Add(boundSlashed)
+		}
+		delegator.TotalDelegatedTokens = delegator.TotalDelegatedTokens.Sub(proxySlashed)
+		slashed = slashed.Add(proxySlashed)
+	}
+	k.SetDelegator(ctx, delegator)
+
+	finalTokens := delegator.Tokens
+	if delegator.IsProxy {
+		finalTokens = finalTokens.Add(delegator.TotalDelegatedTokens)
+	}
+	k.recalculateShares(ctx, delegator, finalTokens)
+
+	return slashed
+}
+
+// recalculateShares recalculates the shares of the delegator on every validator that it added shares to.
+// Different from UpdateShares, the shares on the validators that have been destroyed are updated too, because slashing
+// and the change of the weight curve are not allowed to fail
+func (k Keeper) recalculateShares(ctx sdk.Context, delegator types.Delegator, tokens sdk.Dec) {
+	vals, lastShares, weights := k.GetLastValsAddedSharesExisted(ctx, delegator.DelegatorAddress)
+	if vals == nil {
+		return
+	}
+
+	shares, sdkErr := k.calculateShares(ctx, tokens)
+	if sdkErr != nil {
+		panic(sdkErr)
+	}
+
+	for i, val := range vals {
+		valShares := sharesByWeight(shares, weights, i)
+		k.DeleteValidatorByPowerIndex(ctx, val)
+		k.BeforeDelegationSharesModified(ctx, delegator.DelegatorAddress, val.OperatorAddress)
+		k.SetShares(ctx, delegator.DelegatorAddress, val.OperatorAddress, valShares)
+		val.DelegatorShares = val.DelegatorShares.Sub(sharesByWeight(lastShares, weights, i)).Add(valShares)
+		k.SetValidator(ctx, val)
+		k.SetValidatorByPowerIndex(ctx, val)
+		k.AfterDelegationModified(ctx, delegator.DelegatorAddress, val.OperatorAddress)
+	}
+
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+}
+
+// slashUndelegations slashes the undelegations which were started from the validator at or after the infraction height
+// by the slashFactor, and returns the total amount of the tokens slashed
+func (k Keeper) slashUndelegations(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64,
+	slashFactor sdk.Dec) sdk.Dec {
+	var undelegations []types.UndelegationInfo
+	k.IterateUndelegationInfo(ctx, func(_ int64, undelegation types.UndelegationInfo) (stop bool) {
+		if undelegation.CreationHeight >= infractionHeight && undelegation.HasValidator(valAddr) {
+			undelegations = append(undelegations, undelegation)
+		}
+		return false
+	})
+
+	slashed := sdk.ZeroDec()
+	for _, undelegation := range undelegations {
+		amount := undelegation.Quantity.Mul(slashFactor)
+		undelegation.Quantity = undelegation.Quantity.Sub(amount)
+		k.SetUndelegating(ctx, undelegation)
+		slashed = slashed.Add(amount)
+	}
+
+	return slashed
+}
+
+// burnTokens burns the tokens of bond denom from the pool
+func (k Keeper) burnTokens(ctx sdk.Context, poolName string, amount sdk.Dec) {
+	if !amount.IsPositive() {
+		return
+	}
 
+	coins := sdk.SysCoins{sdk.NewDecCoinFromDec(k.BondDenom(ctx), amount)}
+	if err := k.supplyKeeper.BurnCoins(ctx, poolName, coins); err != nil {
+		panic(err)
+	}
 }
 
 // Jail sents a validator to jail
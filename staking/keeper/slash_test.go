@@ -1 +1,192 @@
 package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/exchain/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSlashTest(t *testing.T) (sdk.Context, Keeper, types.Validator) {
+	ctx, _, mKeeper := CreateTestInput(t, false, SufficientInitBalance)
+	k := mKeeper.Keeper
+	ctx = ctx.WithBlockHeight(10)
+
+	// create validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{}, types.DefaultMinSelfDelegation)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetNewValidatorByPowerIndex(ctx, validator)
+	msdToken := sdk.NewDecCoinFromDec(k.BondDenom(ctx), validator.MinSelfDelegation)
+	require.Nil(t, k.AddSharesAsMinSelfDelegation(ctx, sdk.AccAddress(addrVals[0]), &validator, msdToken))
+
+	// deposit and add shares to the validator
+	for _, delAddr := range addrDels[:2] {
+		require.Nil(t, k.Delegate(ctx, delAddr, sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(1000))))
+		vals, err := k.GetValidatorsToAddShares(ctx, []sdk.ValAddress{addrVals[0]})
+		require.Nil(t, err)
+		delegator, found := k.GetDelegator(ctx, delAddr)
+		require.True(t, found)
+		shares, err := k.AddSharesToValidators(ctx, delAddr, vals, delegator.Tokens)
+		require.Nil(t, err)
+		delegator.ValidatorAddresses = []sdk.ValAddress{addrVals[0]}
+		delegator.Shares = shares
+		k.SetDelegator(ctx, delegator)
+	}
+
+	validator, found := k.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	return ctx, k, validator
+}
+
+func requireSlashInvariants(t *testing.T, ctx sdk.Context, k Keeper) {
+	for _, invariant := range []sdk.Invariant{
+		DelegatorAddSharesInvariant(k),
+		ModuleAccountInvariantsCustom(k),
+		NonNegativePowerInvariantCustom(k),
+	} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+}
+
+func TestSlash(t *testing.T) {
+	ctx, k, validator := setupSlashTest(t)
+
+	// withdraw after the infraction
+	_, err := k.Withdraw(ctx, addrDels[1], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(500)))
+	require.Nil(t, err)
+	undelegation, found := k.GetUndelegating(ctx, addrDels[1], 1)
+	require.True(t, found)
+	require.Equal(t, int64(10), undelegation.CreationHeight)
+	require.True(t, undelegation.HasValidator(addrVals[0]))
+
+	supplyBefore := k.StakingTokenSupply(ctx)
+	validator, found = k.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	sharesBefore := validator.DelegatorShares
+
+	k.Slash(ctx, validator.GetConsAddr(), 5, 10, sdk.NewDecWithPrec(1, 1))
+
+	delegator0, found := k.GetDelegator(ctx, addrDels[0])
+	require.True(t, found)
+	require.True(t, delegator0.Tokens.Equal(sdk.NewDec(900)), delegator0.Tokens.String())
+	delegator1, found := k.GetDelegator(ctx, addrDels[1])
+	require.True(t, found)
+	require.True(t, delegator1.Tokens.Equal(sdk.NewDec(450)), delegator1.Tokens.String())
+
+	undelegation, found = k.GetUndelegating(ctx, addrDels[1], 1)
+	require.True(t, found)
+	require.True(t, undelegation.Quantity.Equal(sdk.NewDec(450)), undelegation.Quantity.String())
+
+	expMsd := types.DefaultMinSelfDelegation.Mul(sdk.NewDecWithPrec(9, 1))
+	validator, found = k.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.MinSelfDelegation.Equal(expMsd), validator.MinSelfDelegation.String())
+	require.True(t, validator.DelegatorShares.LT(sharesBefore))
+
+	expBurned := sdk.NewDec(100 + 50 + 50).Add(types.DefaultMinSelfDelegation.Sub(expMsd))
+	require.True(t, supplyBefore.Sub(k.StakingTokenSupply(ctx)).Equal(expBurned))
+	requireSlashInvariants(t, ctx, k)
+}
+
+func TestSlashUndelegationBeforeInfraction(t *testing.T) {
+	ctx, k, validator := setupSlashTest(t)
+
+	// withdraw before the infraction
+	_, err := k.Withdraw(ctx, addrDels[1], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(500)))
+	require.Nil(t, err)
+
+	ctx = ctx.WithBlockHeight(20)
+	k.Slash(ctx, validator.GetConsAddr(), 15, 10, sdk.NewDecWithPrec(1, 1))
+
+	undelegation, found := k.GetUndelegating(ctx, addrDels[1], 1)
+	require.True(t, found)
+	require.True(t, undelegation.Quantity.Equal(sdk.NewDec(500)), undelegation.Quantity.String())
+	requireSlashInvariants(t, ctx, k)
+}
+
+func TestSlashUndelegationsWithdrawnAtDifferentHeights(t *testing.T) {
+	ctx, k, validator := setupSlashTest(t)
+
+	// the withdrawals before and after the infraction are kept in separate entries with their own creation heights,
+	// so the earlier one isn't slashed
+	_, err := k.Withdraw(ctx, addrDels[1], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(200)))
+	require.Nil(t, err)
+	ctx = ctx.WithBlockHeight(20)
+	_, err = k.Withdraw(ctx, addrDels[1], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(300)))
+	require.Nil(t, err)
+
+	k.Slash(ctx, validator.GetConsAddr(), 15, 10, sdk.NewDecWithPrec(1, 1))
+
+	before, found := k.GetUndelegating(ctx, addrDels[1], 1)
+	require.True(t, found)
+	require.Equal(t, int64(10), before.CreationHeight)
+	require.True(t, before.Quantity.Equal(sdk.NewDec(200)), before.Quantity.String())
+	after, found := k.GetUndelegating(ctx, addrDels[1], 2)
+	require.True(t, found)
+	require.Equal(t, int64(20), after.CreationHeight)
+	require.True(t, after.Quantity.Equal(sdk.NewDec(270)), after.Quantity.String())
+	requireSlashInvariants(t, ctx, k)
+}
+
+// proxyHooksRecorder records the tokens of the proxies when their rewards are settled
+type proxyHooksRecorder struct {
+	k      *Keeper
+	tokens []sdk.Dec
+}
+
+func (r *proxyHooksRecorder) BeforeProxyModified(ctx sdk.Context, proxyAddr sdk.AccAddress) {
+	proxy, _ := r.k.GetDelegator(ctx, proxyAddr)
+	r.tokens = append(r.tokens, proxy.Tokens.Add(proxy.TotalDelegatedTokens))
+}
+
+func TestSlashProxy(t *testing.T) {
+	ctx, k, validator := setupSlashTest(t)
+
+	// the delegator 0 is registered as a proxy and the delegator 2 binds to it
+	proxy, found := k.GetDelegator(ctx, addrDels[0])
+	require.True(t, found)
+	proxy.RegProxy(true)
+	k.SetDelegator(ctx, proxy)
+	require.Nil(t, k.Delegate(ctx, addrDels[2], sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(1000))))
+	delegator2, found := k.GetDelegator(ctx, addrDels[2])
+	require.True(t, found)
+	delegator2.BindProxy(addrDels[0])
+	k.SetDelegator(ctx, delegator2)
+	k.SetProxyBinding(ctx, addrDels[0], addrDels[2], false)
+	require.Nil(t, k.UpdateProxy(ctx, delegator2, delegator2.Tokens))
+
+	// the rewards of the proxy are settled by the tokens before slashing
+	recorder := &proxyHooksRecorder{k: &k}
+	k.SetProxyHooks(recorder)
+	k.Slash(ctx, validator.GetConsAddr(), 5, 10, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, 1, len(recorder.tokens))
+	require.True(t, recorder.tokens[0].Equal(sdk.NewDec(2000)), recorder.tokens[0].String())
+
+	proxy, found = k.GetDelegator(ctx, addrDels[0])
+	require.True(t, found)
+	require.True(t, proxy.Tokens.Equal(sdk.NewDec(500)), proxy.Tokens.String())
+	require.True(t, proxy.TotalDelegatedTokens.Equal(sdk.NewDec(500)), proxy.TotalDelegatedTokens.String())
+	delegator2, found = k.GetDelegator(ctx, addrDels[2])
+	require.True(t, found)
+	require.True(t, delegator2.Tokens.Equal(sdk.NewDec(500)), delegator2.Tokens.String())
+	requireSlashInvariants(t, ctx, k)
+}
+
+func TestSlashInvalid(t *testing.T) {
+	ctx, k, validator := setupSlashTest(t)
+
+	// nonexistent validator is ignored
+	supplyBefore := k.StakingTokenSupply(ctx)
+	k.Slash(ctx, sdk.ConsAddress(PKs[1].Address()), 5, 10, sdk.NewDecWithPrec(1, 1))
+	require.True(t, supplyBefore.Equal(k.StakingTokenSupply(ctx)))
+
+	require.Panics(t, func() {
+		k.Slash(ctx, validator.GetConsAddr(), 5, 10, sdk.NewDecWithPrec(-1, 1))
+	})
+	require.Panics(t, func() {
+		k.Slash(ctx, validator.GetConsAddr(), 11, 10, sdk.NewDecWithPrec(1, 1))
+	})
+}
@@ -88,8 +88,11 @@ func MakeTestCodec() *codec.Codec {
 	cdc.RegisterConcrete(types.MsgCreateValidator{}, "test/staking/CreateValidator", nil)
 	cdc.RegisterConcrete(types.MsgDestroyValidator{}, "test/staking/DestroyValidator", nil)
 	cdc.RegisterConcrete(types.MsgEditValidator{}, "test/staking/EditValidator", nil)
+	cdc.RegisterConcrete(types.MsgEditValidatorCommissionRate{}, "test/staking/EditValidatorCommissionRate", nil)
 	cdc.RegisterConcrete(types.MsgWithdraw{}, "test/staking/MsgWithdraw", nil)
+	cdc.RegisterConcrete(types.MsgCancelUndelegation{}, "test/staking/MsgCancelUndelegation", nil)
 	cdc.RegisterConcrete(types.MsgAddShares{}, "test/staking/MsgAddShares", nil)
+	cdc.RegisterConcrete(types.MsgEditProxyCommissionRate{}, "test/staking/MsgEditProxyCommissionRate", nil)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
@@ -281,7 +284,8 @@ func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, msd
 	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, msdAmt)
 
 	return types.NewMsgCreateValidator(address, pubKey,
-		types.NewDescription("my moniker", "my identity", "my website", "my details"), msd,
+		types.NewDescription("my moniker", "my identity", "my website", "my details"),
+		types.NewCommissionRates(sdk.ZeroDec(), sdk.OneDec(), sdk.OneDec()), msd,
 	)
 }
 
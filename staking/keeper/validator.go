@@ -318,3 +318,32 @@ func (k Keeper) UnbondAllMatureValidatorQueue(ctx sdk.Context) {
 		store.Delete(validatorTimesliceIterator.Key())
 	}
 }
+
+// MigrateCommissions sets the commission of 100%, which the validators were charged before the CommissionUpgradeHeight,
+// to all the validators before the upgrade, so that they start from it to set their own commission rates
+func (k Keeper) MigrateCommissions(ctx sdk.Context) {
+	for _, validator := range k.GetAllValidators(ctx) {
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+		validator.Commission = types.NewCommission(sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec())
+		k.SetValidator(ctx, validator)
+	}
+}
+
+// UpdateValidatorCommission attempts to update a validator's commission rate. An error is returned if the new
+// commission rate is invalid.
+func (k Keeper) UpdateValidatorCommission(ctx sdk.Context, validator types.Validator, newRate sdk.Dec) (
+	types.Commission, error) {
+	commission := validator.Commission
+	blockTime := ctx.BlockHeader().Time
+
+	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+		return commission, err
+	}
+	if minRate := k.ParamsMinCommissionRate(ctx); newRate.LT(minRate) {
+		return commission, types.ErrCommissionLTMinRate(minRate.String())
+	}
+
+	commission.Rate = newRate
+	commission.UpdateTime = blockTime
+	return commission, nil
+}
@@ -1,31 +1,56 @@
 package keeper
 
 import (
-	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/exchain/x/staking/types"
 )
 
-const (
-	// UTC+8 Time: 2021-07-05 07:05:02
-	blockTimestampEpoch = int64(1625439902)
-	secondsPerWeek      = int64(60 * 60 * 24 * 7)
-	weeksPerYear        = float64(52)
-)
-
-func calculateWeight(nowTime int64, tokens sdk.Dec) (shares types.Shares, sdkErr error) {
-	nowWeek := (nowTime - blockTimestampEpoch) / secondsPerWeek
-	rate := float64(nowWeek) / weeksPerYear
-	weight := rate
-	precision := fmt.Sprintf("%d", sdk.Precision)
-
-	weightByDec, sdkErr := sdk.NewDecFromStr(fmt.Sprintf("%."+precision+"f", weight))
+func calculateWeight(curve types.WeightCurve, nowTime int64, tokens sdk.Dec) (shares types.Shares, sdkErr error) {
+	weightByDec, sdkErr := curve.Weight(nowTime)
 	if sdkErr == nil {
 		shares = tokens.Mul(weightByDec)
 	}
 	return
 }
 
-func SimulateWeight(nowTime int64, tokens sdk.Dec) (votes types.Shares, sdkErr error) {
-	return calculateWeight(nowTime, tokens)
+// calculateShares calculates the shares of the tokens at the block time by the weight curve in effect
+func (k Keeper) calculateShares(ctx sdk.Context, tokens sdk.Dec) (types.Shares, error) {
+	return calculateWeight(k.GetWeightCurve(ctx), ctx.BlockTime().Unix(), tokens)
+}
+
+// SimulateWeight calculates the shares of the tokens at the time by the weight curve
+func SimulateWeight(curve types.WeightCurve, nowTime int64, tokens sdk.Dec) (votes types.Shares, sdkErr error) {
+	return calculateWeight(curve, nowTime, tokens)
+}
+
+// ApplyWeightCurve makes the param WeightCurve take effect if it has been changed, and recalculates the shares of all
+// the delegators by the new curve. It's called at the end of an epoch and rewrites the shares of every delegator on
+// every validator that it added shares to, so the cost of the block grows linearly with the number of the delegators
+// times the validators they added shares to. See BenchmarkApplyWeightCurve
+func (k Keeper) ApplyWeightCurve(ctx sdk.Context) {
+	curve := k.ParamsWeightCurve(ctx)
+	if curve.Equal(k.GetWeightCurve(ctx)) {
+		return
+	}
+	k.SetWeightCurve(ctx, curve)
+
+	// collect the delegators first to avoid writing the store during the iteration
+	var delegators []types.Delegator
+	k.IterateDelegator(ctx, func(_ int64, delegator types.Delegator) (stop bool) {
+		if len(delegator.ValidatorAddresses) != 0 {
+			delegators = append(delegators, delegator)
+		}
+		return false
+	})
+
+	for _, delegator := range delegators {
+		tokens := delegator.Tokens
+		if delegator.IsProxy {
+			tokens = tokens.Add(delegator.TotalDelegatedTokens)
+		}
+		k.recalculateShares(ctx, delegator, tokens)
+	}
+
+	k.Logger(ctx).Info("weight curve changed", "growth type", curve.GrowthType, "cap", curve.Cap,
+		"epoch timestamp", curve.EpochTimestamp, "delegators", len(delegators))
 }
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/exchain/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	//"github.com/stretchr/testify/require"
@@ -15,9 +18,136 @@ func TestDecay(t *testing.T) {
 	after := time.Now().AddDate(0, 0, 52*7).Unix()
 
 	tokens := sdk.NewDec(1000)
-	nowDec, err := calculateWeight(now, tokens)
+	nowDec, err := calculateWeight(types.DefaultWeightCurve(), now, tokens)
 	require.NoError(t, err)
-	afterDec, err := calculateWeight(after, tokens)
+	afterDec, err := calculateWeight(types.DefaultWeightCurve(), after, tokens)
 	require.NoError(t, err)
 	require.Equal(t, sdk.NewDec(2), afterDec.Quo(nowDec))
 }
+
+func TestWeightCurve(t *testing.T) {
+	epoch := types.DefaultWeightEpochTimestamp
+	secondsPerWeek := int64(60 * 60 * 24 * 7)
+	nowTime := epoch + 78*secondsPerWeek
+
+	weight, err := types.DefaultWeightCurve().Weight(nowTime)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(15, 1), weight)
+
+	weight, err = types.NewWeightCurve(epoch, types.WeightGrowthCapped, sdk.OneDec()).Weight(nowTime)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), weight)
+
+	weight, err = types.NewWeightCurve(epoch, types.WeightGrowthStep, sdk.ZeroDec()).Weight(nowTime)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), weight)
+
+	require.Error(t, types.NewWeightCurve(epoch, types.WeightGrowthCapped, sdk.ZeroDec()).Validate())
+	require.Error(t, types.NewWeightCurve(epoch, "exponential", sdk.ZeroDec()).Validate())
+	require.Error(t, types.NewWeightCurve(0, types.WeightGrowthLinear, sdk.ZeroDec()).Validate())
+}
+
+func TestApplyWeightCurve(t *testing.T) {
+	ctx, _, mkeeper := CreateTestInput(t, false, 0)
+	keeper := mkeeper.Keeper
+	ctx = ctx.WithBlockTime(time.Now())
+	vals := createVals(ctx, 2, keeper)
+
+	dlgAddr := addrDels[0]
+	tokens := sdk.NewDec(10000)
+	shares, err := keeper.AddSharesToValidators(ctx, dlgAddr, vals, tokens)
+	require.NoError(t, err)
+	delegator := types.NewDelegator(dlgAddr)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vals[0].OperatorAddress, vals[1].OperatorAddress}
+	delegator.Tokens = tokens
+	delegator.Shares = shares
+	keeper.SetDelegator(ctx, delegator)
+
+	// nothing changes without the param changed
+	keeper.ApplyWeightCurve(ctx)
+	delegator, found := keeper.GetDelegator(ctx, dlgAddr)
+	require.True(t, found)
+	require.Equal(t, shares, delegator.Shares)
+
+	// the shares are recalculated by the new curve
+	params := keeper.GetParams(ctx)
+	params.WeightCurve = types.NewWeightCurve(types.DefaultWeightEpochTimestamp, types.WeightGrowthCapped, sdk.OneDec())
+	keeper.SetParams(ctx, params)
+	keeper.ApplyWeightCurve(ctx)
+	require.True(t, params.WeightCurve.Equal(keeper.GetWeightCurve(ctx)))
+
+	delegator, found = keeper.GetDelegator(ctx, dlgAddr)
+	require.True(t, found)
+	require.Equal(t, tokens, delegator.Shares)
+	for i, val := range getVals(ctx, vals, keeper, t) {
+		valShares, found := keeper.GetShares(ctx, dlgAddr, val.OperatorAddress)
+		require.True(t, found)
+		require.Equal(t, tokens, valShares)
+		require.Equal(t, vals[i].DelegatorShares.Add(tokens), val.DelegatorShares)
+	}
+}
+
+func TestWeightBeforeEpoch(t *testing.T) {
+	epoch := types.DefaultWeightEpochTimestamp
+	for _, growthType := range []string{types.WeightGrowthLinear, types.WeightGrowthStep} {
+		weight, err := types.NewWeightCurve(epoch, growthType, sdk.ZeroDec()).Weight(epoch - 53*60*60*24*7)
+		require.NoError(t, err)
+		require.True(t, weight.IsZero(), growthType)
+	}
+}
+
+func TestCheckWeightCurveChange(t *testing.T) {
+	ctx, _, mkeeper := CreateTestInput(t, false, 0)
+	keeper := mkeeper.Keeper
+	now := time.Now()
+	ctx = ctx.WithBlockTime(now)
+
+	check := func(epoch int64) error {
+		curve := types.NewWeightCurve(epoch, types.WeightGrowthLinear, sdk.ZeroDec())
+		return keeper.CheckParamChange(ctx, string(types.KeyWeightCurve), string(types.ModuleCdc.MustMarshalJSON(curve)))
+	}
+	require.NoError(t, check(types.DefaultWeightEpochTimestamp))
+	require.NoError(t, check(now.Unix()))
+	require.Error(t, check(now.Unix()+1))
+	require.Error(t, keeper.CheckParamChange(ctx, string(types.KeyWeightCurve), "{"))
+	require.NoError(t, keeper.CheckParamChange(ctx, string(types.KeyEpoch), "{"))
+}
+
+func BenchmarkApplyWeightCurve(b *testing.B) {
+	ctx, _, mkeeper := CreateTestInput(&testing.T{}, false, 0)
+	keeper := mkeeper.Keeper
+	ctx = ctx.WithBlockTime(time.Now())
+	vals := createVals(ctx, 2, keeper)
+	valAddrs := []sdk.ValAddress{vals[0].OperatorAddress, vals[1].OperatorAddress}
+
+	// every delegator added shares to all the validators
+	tokens := sdk.NewDec(10000)
+	for i := 0; i < 1000; i++ {
+		dlgAddr := sdk.AccAddress(fmt.Sprintf("delegator%011d", i))
+		var currentVals types.Validators
+		for _, valAddr := range valAddrs {
+			val, _ := keeper.GetValidator(ctx, valAddr)
+			currentVals = append(currentVals, val)
+		}
+		shares, err := keeper.AddSharesToValidators(ctx, dlgAddr, currentVals, tokens)
+		require.NoError(b, err)
+		delegator := types.NewDelegator(dlgAddr)
+		delegator.ValidatorAddresses = valAddrs
+		delegator.Tokens = tokens
+		delegator.Shares = shares
+		keeper.SetDelegator(ctx, delegator)
+	}
+
+	// switch the curve back and forth to recalculate the shares every time
+	curves := []types.WeightCurve{
+		types.NewWeightCurve(types.DefaultWeightEpochTimestamp, types.WeightGrowthCapped, sdk.OneDec()),
+		types.DefaultWeightCurve(),
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		params := keeper.GetParams(ctx)
+		params.WeightCurve = curves[i%2]
+		keeper.SetParams(ctx, params)
+		keeper.ApplyWeightCurve(ctx)
+	}
+}
@@ -8,13 +8,17 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateValidator{}, "filechain/staking/MsgCreateValidator", nil)
 	cdc.RegisterConcrete(MsgEditValidator{}, "filechain/staking/MsgEditValidator", nil)
+	cdc.RegisterConcrete(MsgEditValidatorCommissionRate{}, "filechain/staking/MsgEditValidatorCommissionRate", nil)
 	cdc.RegisterConcrete(MsgDestroyValidator{}, "filechain/staking/MsgDestroyValidator", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "filechain/staking/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgWithdraw{}, "filechain/staking/MsgWithdraw", nil)
+	cdc.RegisterConcrete(MsgCancelUndelegation{}, "filechain/staking/MsgCancelUndelegation", nil)
 	cdc.RegisterConcrete(MsgAddShares{}, "filechain/staking/MsgAddShares", nil)
+	cdc.RegisterConcrete(MsgAddSharesWithWeights{}, "filechain/staking/MsgAddSharesWithWeights", nil)
 	cdc.RegisterConcrete(MsgRegProxy{}, "filechain/staking/MsgRegProxy", nil)
 	cdc.RegisterConcrete(MsgBindProxy{}, "filechain/staking/MsgBindProxy", nil)
 	cdc.RegisterConcrete(MsgUnbindProxy{}, "filechain/staking/MsgUnbindProxy", nil)
+	cdc.RegisterConcrete(MsgEditProxyCommissionRate{}, "filechain/staking/MsgEditProxyCommissionRate", nil)
 }
 
 // ModuleCdc is generic sealed codec to be used throughout this module
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,6 +26,16 @@ type (
 	}
 )
 
+// CommissionUpgradeHeight is the height from which the validators set their own commission rates. The validators created
+// before it are charged the fixed commission of 100% as they used to be, which keeps the blocks before it replayable.
+// It's unreachable until the upgrade is scheduled for the chain.
+var CommissionUpgradeHeight int64 = math.MaxInt64
+
+// IsCommissionUpgraded returns true if the validators set their own commission rates at the height
+func IsCommissionUpgraded(height int64) bool {
+	return height >= CommissionUpgradeHeight
+}
+
 // NewCommissionRates returns an initialized validator commission rates
 func NewCommissionRates(rate, maxRate, maxChangeRate sdk.Dec) CommissionRates {
 	return CommissionRates{
@@ -66,6 +77,11 @@ func (c Commission) String() string {
 	)
 }
 
+// IsEmpty returns true if none of the commission rates is set
+func (c CommissionRates) IsEmpty() bool {
+	return c.Rate.IsNil() && c.MaxRate.IsNil() && c.MaxChangeRate.IsNil()
+}
+
 // Validate performs basic sanity validation checks of initial commission parameters
 // If validation fails, an SDK error is returned
 func (c CommissionRates) Validate() sdk.Error {
@@ -2,17 +2,31 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// UndelegationInfo is the struct of the undelegation info
+// UndelegationInfo is the struct of an undelegation entry. A delegator has an entry per withdrawal, each of which is
+// matured independently at its own completion time.
 type UndelegationInfo struct {
 	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 	Quantity         sdk.Dec        `json:"quantity" yaml:"quantity"`
 	CompletionTime   time.Time      `json:"completion_time"`
+	// CreationHeight is the block height of the withdrawal
+	CreationHeight int64 `json:"creation_height" yaml:"creation_height"`
+	// ValidatorAddresses are the validators which the undelegated tokens added shares to
+	ValidatorAddresses []sdk.ValAddress `json:"validator_addresses" yaml:"validator_addresses"`
+	// ID identifies the entry among the ones of the delegator, 0 being the entry stored before the entries were
+	// introduced
+	ID uint64 `json:"id" yaml:"id"`
+	// ProxyAddress is the proxy bound by the delegator at the withdrawal, which is bound again on the cancellation
+	ProxyAddress sdk.AccAddress `json:"proxy_address" yaml:"proxy_address"`
+	// Weights are the weights of the shares added to ValidatorAddresses in the same order, which are restored on the
+	// cancellation. Every validator gets the full shares when it's empty
+	Weights []sdk.Dec `json:"weights,omitempty" yaml:"weights,omitempty"`
 }
 
 // NewUndelegationInfo creates a new delegation object
@@ -24,6 +38,25 @@ func NewUndelegationInfo(delegatorAddr sdk.AccAddress, sharesQuantity Shares, co
 	}
 }
 
+// HasValidator returns true if the undelegated tokens added shares to the validator
+func (ud UndelegationInfo) HasValidator(valAddr sdk.ValAddress) bool {
+	for _, addr := range ud.ValidatorAddresses {
+		if addr.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddValidators records the validators that the undelegated tokens added shares to
+func (ud *UndelegationInfo) AddValidators(valAddrs []sdk.ValAddress) {
+	for _, valAddr := range valAddrs {
+		if !ud.HasValidator(valAddr) {
+			ud.ValidatorAddresses = append(ud.ValidatorAddresses, valAddr)
+		}
+	}
+}
+
 // MustUnMarshalUndelegationInfo must return the UndelegationInfo object by unmarshaling
 func MustUnMarshalUndelegationInfo(cdc *codec.Codec, value []byte) UndelegationInfo {
 	undelegationInfo, err := UnmarshalUndelegationInfo(cdc, value)
@@ -42,15 +75,41 @@ func UnmarshalUndelegationInfo(cdc *codec.Codec, value []byte) (undelegationInfo
 // String returns a human readable string representation of UndelegationInfo
 func (ud UndelegationInfo) String() string {
 	return fmt.Sprintf(`UnDelegation:
+  ID:    %d
   Delegator: %s
   Quantity:    %s
-  CompletionTime:    %s`,
-		ud.DelegatorAddress, ud.Quantity, ud.CompletionTime.Format(time.RFC3339))
+  CompletionTime:    %s
+  CreationHeight:    %d
+  Validators:    %v
+  Proxy:    %s
+  Weights:    %v`,
+		ud.ID, ud.DelegatorAddress, ud.Quantity, ud.CompletionTime.Format(time.RFC3339), ud.CreationHeight,
+		ud.ValidatorAddresses, ud.ProxyAddress, ud.Weights)
 }
 
 // DefaultUndelegation returns default entity for UndelegationInfo
 func DefaultUndelegation() UndelegationInfo {
 	return UndelegationInfo{
-		nil, sdk.ZeroDec(), time.Unix(0, 0).UTC(),
+		nil, sdk.ZeroDec(), time.Unix(0, 0).UTC(), 0, nil, 0,
+	}
+}
+
+// UndelegationInfos is a collection of the undelegation entries
+type UndelegationInfos []UndelegationInfo
+
+// String returns a human readable string representation of UndelegationInfos
+func (uds UndelegationInfos) String() (out string) {
+	for _, ud := range uds {
+		out += ud.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// TotalQuantity returns the sum of the quantities of the entries
+func (uds UndelegationInfos) TotalQuantity() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, ud := range uds {
+		total = total.Add(ud.Quantity)
 	}
+	return total
 }
@@ -17,8 +17,21 @@ type Delegator struct {
 	IsProxy              bool             `json:"is_proxy" yaml:"is_proxy"`
 	TotalDelegatedTokens sdk.Dec          `json:"total_delegated_tokens" yaml:"total_delegated_tokens"` // total tokens delegated by other delegators
 	ProxyAddress         sdk.AccAddress   `json:"proxy_address" yaml:"proxy_address"`
+	// Weights are the weights of the shares added to ValidatorAddresses in the same order. Every validator gets the
+	// full shares when it's empty
+	Weights []sdk.Dec `json:"weights,omitempty" yaml:"weights,omitempty"`
+	// ProxyCommission is the commission that the proxy takes from the rewards for the tokens delegated by the bound
+	// delegators, whose rate is limited in the same way as the commission of a validator
+	ProxyCommission Commission `json:"proxy_commission" yaml:"proxy_commission"`
 }
 
+var (
+	// DefaultProxyCommissionMaxRate is the max commission rate that a proxy can ever charge its bound delegators
+	DefaultProxyCommissionMaxRate = sdk.NewDecWithPrec(2, 1)
+	// DefaultProxyCommissionMaxChangeRate is the max daily increase of the commission rate of a proxy
+	DefaultProxyCommissionMaxChangeRate = sdk.NewDecWithPrec(1, 2)
+)
+
 // NewDelegator creates a new Delegator object
 func NewDelegator(delAddr sdk.AccAddress) Delegator {
 	return Delegator{
@@ -29,6 +42,8 @@ func NewDelegator(delAddr sdk.AccAddress) Delegator {
 		false,
 		sdk.ZeroDec(),
 		nil,
+		nil,
+		NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
 	}
 }
 
@@ -42,6 +57,54 @@ func (d Delegator) GetLastAddedShares() sdk.Dec {
 	return d.Shares
 }
 
+// GetTokens gets the self-delegated tokens of a delegator for other module
+func (d Delegator) GetTokens() sdk.Dec {
+	return d.Tokens
+}
+
+// GetTotalDelegatedTokens gets the total tokens delegated by the delegators bound to a proxy for other module
+func (d Delegator) GetTotalDelegatedTokens() sdk.Dec {
+	if d.TotalDelegatedTokens.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d.TotalDelegatedTokens
+}
+
+// GetProxyAddress gets the address of the proxy that the delegator has bound for other module
+func (d Delegator) GetProxyAddress() sdk.AccAddress {
+	return d.ProxyAddress
+}
+
+// GetProxyCommissionRate gets the commission rate of a proxy, which is zero if it has never been set
+func (d Delegator) GetProxyCommissionRate() sdk.Dec {
+	return d.GetProxyCommission().Rate
+}
+
+// GetProxyCommission gets the commission of a proxy. The commission which has never been set, e.g. the one of the proxy
+// registered before the commission was introduced, gets the zero rate with the default limits
+func (d Delegator) GetProxyCommission() Commission {
+	if d.ProxyCommission.MaxRate.IsNil() || d.ProxyCommission.MaxRate.IsZero() {
+		return NewCommission(sdk.ZeroDec(), DefaultProxyCommissionMaxRate, DefaultProxyCommissionMaxChangeRate)
+	}
+	return d.ProxyCommission
+}
+
+// GetWeight gets the weight of the shares added to the validator, which is one if the delegator added the full shares
+// to every validator
+func (d Delegator) GetWeight(valAddr sdk.ValAddress) (sdk.Dec, bool) {
+	for i, addr := range d.ValidatorAddresses {
+		if !addr.Equals(valAddr) {
+			continue
+		}
+		if len(d.Weights) == 0 {
+			return sdk.OneDec(), true
+		}
+		return d.Weights[i], true
+	}
+
+	return sdk.ZeroDec(), false
+}
+
 // RegProxy registers or deregisters the identity of proxy
 func (d *Delegator) RegProxy(reg bool) {
 	d.IsProxy = reg
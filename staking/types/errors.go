@@ -58,6 +58,13 @@ const (
 	CodeNoDelegatorExisted              uint32 = 67044
 	CodeTargetValsDuplicate             uint32 = 67045
 	CodeAlreadyBound                    uint32 = 67046
+	CodeUndelegationMatured             uint32 = 67047
+	CodeCommissionLTMinRate             uint32 = 67048
+	CodeCommissionNotSet                uint32 = 67049
+	CodeInvalidWeights                  uint32 = 67050
+	CodeInvalidWeightQuery              uint32 = 67051
+	CodeCommissionNotUpgraded           uint32 = 67052
+	CodeFutureWeightCurveEpoch          uint32 = 67053
 )
 
 // ErrNoValidatorFound returns an error when a validator doesn't exist
@@ -312,3 +319,47 @@ func ErrAlreadyBound(delAddr string) sdk.EnvelopedErr {
 		fmt.Sprintf("failed. %s has already bound a proxy. it's necessary to unbind before proxy register",
 			delAddr))}
 }
+
+// ErrUndelegationMatured returns an error when an undelegation entry to cancel has already matured
+func ErrUndelegationMatured(delAddr string, id uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUndelegationMatured,
+		fmt.Sprintf("failed. the undelegation %d of %s has matured and can't be canceled", id, delAddr))}
+}
+
+// ErrCommissionLTMinRate returns an error when the commission rate is less than the min commission rate param
+func ErrCommissionLTMinRate(minRate string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeCommissionLTMinRate,
+		fmt.Sprintf("commission cannot be less than the min commission rate %s", minRate))
+}
+
+// ErrCommissionNotSet returns an error when any of the commission rates isn't set
+func ErrCommissionNotSet() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeCommissionNotSet,
+		"commission rate, max rate and max change rate must be set")
+}
+
+// ErrInvalidWeights returns an error when the weights of the validators to add shares to are invalid
+func ErrInvalidWeights(reason string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidWeights,
+		fmt.Sprintf("failed. invalid weights: %s", reason))
+}
+
+// ErrInvalidWeightQuery returns an error when the params of the weight query are invalid
+func ErrInvalidWeightQuery(reason string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidWeightQuery,
+		fmt.Sprintf("failed. invalid weight query: %s", reason))
+}
+
+// ErrCommissionNotUpgraded returns an error when the commission rates are set before the CommissionUpgradeHeight
+func ErrCommissionNotUpgraded(upgradeHeight int64) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeCommissionNotUpgraded,
+		fmt.Sprintf("failed. commission rates can't be set before the height %d", upgradeHeight))
+}
+
+// ErrFutureWeightCurveEpoch returns an error when the epoch timestamp of the weight curve to change to is later than the
+// block time
+func ErrFutureWeightCurveEpoch(epochTimestamp, blockTimestamp int64) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeFutureWeightCurveEpoch,
+		fmt.Sprintf("failed. epoch timestamp %d of weight curve is later than the block time %d", epochTimestamp,
+			blockTimestamp))
+}
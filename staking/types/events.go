@@ -3,20 +3,32 @@ package types
 // staking module event types
 const (
 	EventTypeCompleteUnbonding = "complete_unbonding"
+	EventTypeCancelUnbonding   = "cancel_unbonding"
 	EventTypeCreateValidator   = "create_validator"
 	EventTypeEditValidator     = "edit_validator"
 	EventTypeDelegate          = "delegate"
 	EventTypeUnbond            = "unbond"
+	EventTypeSlash             = "slash"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyPower             = "power"
+	AttributeKeyInfractionHeight  = "infraction_height"
+	AttributeKeySlashFactor       = "slash_factor"
+	AttributeKeyBurnedTokens      = "burned_tokens"
+	AttributeKeyUndelegationID    = "undelegation_id"
 	AttributeValueCategory        = ModuleName
 
 	EventTypeAddShares = "add_shares"
 
 	AttributeKeyValidatorToAddShares = "validator_to_add_shares"
 	AttributeKeyShares              = "shares"
+	AttributeKeyWeight              = "weight"
+
+	EventTypeEditProxyCommissionRate = "edit_proxy_commission_rate"
+
+	AttributeKeyProxy = "proxy"
 )
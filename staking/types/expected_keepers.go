@@ -54,6 +54,7 @@ type ValidatorSet interface {
 	StakingTokenSupply(sdk.Context) sdk.Dec
 
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
+	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
 	// jail a validator
 	Jail(sdk.Context, sdk.ConsAddress)
 	// unjail a validator
@@ -87,3 +88,19 @@ type StakingHooks interface {
 	// Must be called when a validator is destroyed by tx
 	AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)
 }
+
+// SharesHooks event hooks for the shares that delegators add to validators (noalias). They are registered on the staking
+// keeper by SetSharesHooks
+type SharesHooks interface {
+	// Must be called before the shares of a delegator on a validator are modified or withdrawn
+	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
+	// Must be called after the shares of a delegator on a validator are added or modified
+	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
+}
+
+// ProxyHooks event hooks for the proxies (noalias). They are registered on the staking keeper by SetProxyHooks
+type ProxyHooks interface {
+	// Must be called before the tokens of a proxy or its bound delegators, the bound delegators or the commission rate
+	// of the proxy are modified
+	BeforeProxyModified(ctx sdk.Context, proxyAddr sdk.AccAddress)
+}
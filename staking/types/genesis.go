@@ -18,6 +18,9 @@ type GenesisState struct {
 	AllShares            []SharesExported            `json:"all_shares" yaml:"all_shares"`
 	ProxyDelegatorKeys   []ProxyDelegatorKeyExported `json:"proxy_delegator_keys" yaml:"proxy_delegator_keys"`
 	Exported             bool                        `json:"exported" yaml:"exported"`
+	// WeightCurve is the weight curve that the shares are calculated by, which differs from the param WeightCurve
+	// changed in the epoch of the export. The param is used when it's missing
+	WeightCurve *WeightCurve `json:"weight_curve,omitempty" yaml:"weight_curve,omitempty"`
 }
 
 // LastValidatorPower is needed for validator set update logic
@@ -61,15 +64,23 @@ type ValidatorExported struct {
 	UnbondingHeight         int64          `json:"unbonding_height"`
 	UnbondingCompletionTime time.Time      `json:"unbonding_time"`
 	MinSelfDelegation       sdk.Dec        `json:"min_self_delegation"`
+	Commission              *Commission    `json:"commission,omitempty"`
 }
 
-// Import converts validator exported format to inner one by filling the zero-value of Tokens and Commission
+// Import converts validator exported format to inner one by filling the zero-value of Tokens. The Commission missing
+// in the genesis exported before the validators set their own rates is filled as the one set by the create-validator
+// then.
 func (ve ValidatorExported) Import() Validator {
 	consPk, err := GetConsPubKeyBech32(ve.ConsPubKey)
 	if err != nil {
 		panic(fmt.Sprintf("failed. consensus pubkey is parsed error: %s", err.Error()))
 	}
 
+	commission := NewCommission(sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(0))
+	if ve.Commission != nil {
+		commission = *ve.Commission
+	}
+
 	return Validator{
 		ve.OperatorAddress,
 		consPk,
@@ -80,7 +91,7 @@ func (ve ValidatorExported) Import() Validator {
 		ve.Description,
 		ve.UnbondingHeight,
 		ve.UnbondingCompletionTime,
-		NewCommission(sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(0)),
+		commission,
 		ve.MinSelfDelegation,
 	}
 }
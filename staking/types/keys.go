@@ -48,6 +48,8 @@ var (
 	UnDelegationInfoKey = []byte{0x53}
 	UnDelegateQueueKey  = []byte{0x54}
 	ProxyKey            = []byte{0x55}
+	UnDelegationIDKey   = []byte{0x56} // key for the id of the next undelegation entry
+	WeightCurveKey      = []byte{0x57} // key for the weight curve that the shares are calculated by
 
 	// prefix key for vals info to enforce the update of validator-set
 	ValidatorAbandonedKey = []byte{0x60}
@@ -136,33 +138,53 @@ func GetSharesToValidatorsKey(valAddr sdk.ValAddress) []byte {
 	return append(SharesKey, valAddr.Bytes()...)
 }
 
-// GetUndelegationInfoKey gets the key for UndelegationInfo
-func GetUndelegationInfoKey(delAddr sdk.AccAddress) []byte {
+// GetUndelegationInfosKey gets the prefix of the undelegation entries of the delegator
+func GetUndelegationInfosKey(delAddr sdk.AccAddress) []byte {
 	return append(UnDelegationInfoKey, delAddr.Bytes()...)
 }
 
+// GetUndelegationInfoKey gets the key for the undelegation entry. The entry with id 0 is the one stored by the single
+// undelegation per delegator before, so it keeps the key without the id.
+func GetUndelegationInfoKey(delAddr sdk.AccAddress, id uint64) []byte {
+	if id == 0 {
+		return GetUndelegationInfosKey(delAddr)
+	}
+	return append(GetUndelegationInfosKey(delAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
 // GetCompleteTimeKey get the key for the prefix of time
 func GetCompleteTimeKey(timestamp time.Time) []byte {
 	bz := sdk.FormatTimeBytes(timestamp)
 	return append(UnDelegateQueueKey, bz...)
 }
 
-// GetCompleteTimeWithAddrKey get the key for the complete time with delegator address
-func GetCompleteTimeWithAddrKey(timestamp time.Time, delAddr sdk.AccAddress) []byte {
-	return append(GetCompleteTimeKey(timestamp), delAddr.Bytes()...)
-}
-
-// SplitCompleteTimeWithAddrKey splits the key and returns the endtime and delegator address
-func SplitCompleteTimeWithAddrKey(key []byte) (time.Time, sdk.AccAddress) {
-	if len(key[1:]) != lenTime+sdk.AddrLen {
+// GetCompleteTimeWithAddrKey get the key for the complete time with delegator address and the id of the undelegation
+// entry, which is omitted for the entry with id 0 as GetUndelegationInfoKey does
+func GetCompleteTimeWithAddrKey(timestamp time.Time, delAddr sdk.AccAddress, id uint64) []byte {
+	key := append(GetCompleteTimeKey(timestamp), delAddr.Bytes()...)
+	if id == 0 {
+		return key
+	}
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitCompleteTimeWithAddrKey splits the key and returns the endtime, the delegator address and the id of the
+// undelegation entry
+func SplitCompleteTimeWithAddrKey(key []byte) (time.Time, sdk.AccAddress, uint64) {
+	var id uint64
+	switch len(key[1:]) {
+	case lenTime + sdk.AddrLen:
+	case lenTime + sdk.AddrLen + 8:
+		id = sdk.BigEndianToUint64(key[1+lenTime+sdk.AddrLen:])
+	default:
 		panic(fmt.Sprintf("unexpected key length (%d ≠ %d)", len(key[1:]), lenTime+sdk.AddrLen))
 	}
 	endTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
 	if err != nil {
 		panic(err)
 	}
-	delAddr := sdk.AccAddress(key[1+lenTime:])
-	return endTime, delAddr
+	delAddr := sdk.AccAddress(key[1+lenTime : 1+lenTime+sdk.AddrLen])
+	return endTime, delAddr, id
 }
 
 // Bech32ifyConsPub returns a Bech32 encoded string containing the
@@ -12,38 +12,42 @@ import (
 var (
 	_ sdk.Msg = &MsgCreateValidator{}
 	_ sdk.Msg = &MsgEditValidator{}
+	_ sdk.Msg = &MsgEditValidatorCommissionRate{}
 )
 
 //______________________________________________________________________
 
 // MsgCreateValidator - struct for bonding transactions
 type MsgCreateValidator struct {
-	Description Description `json:"description" yaml:"description"`
-	//Commission        CommissionRates `json:"commission" yaml:"commission"`
+	Description       Description    `json:"description" yaml:"description"`
 	MinSelfDelegation sdk.SysCoin    `json:"min_self_delegation" yaml:"min_self_delegation"`
 	DelegatorAddress  sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
 	ValidatorAddress  sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
 	PubKey            crypto.PubKey  `json:"pubkey" yaml:"pubkey"`
+	// Commission is the last field to keep the msgs encoded before it decodable, which are sent before the
+	// CommissionUpgradeHeight without it
+	Commission CommissionRates `json:"commission" yaml:"commission"`
 }
 
 type msgCreateValidatorJSON struct {
-	Description Description `json:"description" yaml:"description"`
-	//Commission        CommissionRates `json:"commission" yaml:"commission"`
-	MinSelfDelegation sdk.SysCoin    `json:"min_self_delegation" yaml:"min_self_delegation"`
-	DelegatorAddress  sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
-	ValidatorAddress  sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
-	PubKey            string         `json:"pubkey" yaml:"pubkey"`
+	Description       Description     `json:"description" yaml:"description"`
+	MinSelfDelegation sdk.SysCoin     `json:"min_self_delegation" yaml:"min_self_delegation"`
+	DelegatorAddress  sdk.AccAddress  `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress  sdk.ValAddress  `json:"validator_address" yaml:"validator_address"`
+	PubKey            string          `json:"pubkey" yaml:"pubkey"`
+	Commission        CommissionRates `json:"commission" yaml:"commission"`
 }
 
 // NewMsgCreateValidator creates a msg of create-validator
 // Delegator address and validator address are the same
 func NewMsgCreateValidator(
 	valAddr sdk.ValAddress, pubKey crypto.PubKey,
-	description Description, minSelfDelegation sdk.SysCoin,
+	description Description, commission CommissionRates, minSelfDelegation sdk.SysCoin,
 ) MsgCreateValidator {
 
 	return MsgCreateValidator{
 		Description:       description,
+		Commission:        commission,
 		DelegatorAddress:  sdk.AccAddress(valAddr),
 		ValidatorAddress:  valAddr,
 		PubKey:            pubKey,
@@ -73,6 +77,7 @@ func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
 func (msg MsgCreateValidator) MarshalJSON() ([]byte, error) {
 	return json.Marshal(msgCreateValidatorJSON{
 		Description:       msg.Description,
+		Commission:        msg.Commission,
 		DelegatorAddress:  msg.DelegatorAddress,
 		ValidatorAddress:  msg.ValidatorAddress,
 		PubKey:            MustBech32ifyConsPub(msg.PubKey),
@@ -88,6 +93,7 @@ func (msg *MsgCreateValidator) UnmarshalJSON(bz []byte) error {
 	}
 
 	msg.Description = msgCreateValJSON.Description
+	msg.Commission = msgCreateValJSON.Commission
 	msg.DelegatorAddress = msgCreateValJSON.DelegatorAddress
 	msg.ValidatorAddress = msgCreateValJSON.ValidatorAddress
 	var err error
@@ -124,6 +130,16 @@ func (msg MsgCreateValidator) ValidateBasic() error {
 	if msg.Description == (Description{}) {
 		return ErrDescriptionIsEmpty()
 	}
+	// the commission is left empty by the msgs sent before the CommissionUpgradeHeight, which is checked by the handler
+	if msg.Commission.IsEmpty() {
+		return nil
+	}
+	if msg.Commission.Rate.IsNil() || msg.Commission.MaxRate.IsNil() || msg.Commission.MaxChangeRate.IsNil() {
+		return ErrCommissionNotSet()
+	}
+	if err := msg.Commission.Validate(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -167,3 +183,49 @@ func (msg MsgEditValidator) ValidateBasic() error {
 
 	return nil
 }
+
+// MsgEditValidatorCommissionRate - struct for editing the commission rate of a validator
+type MsgEditValidatorCommissionRate struct {
+	CommissionRate   sdk.Dec        `json:"commission_rate" yaml:"commission_rate"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// NewMsgEditValidatorCommissionRate creates a msg of edit-validator-commission-rate
+func NewMsgEditValidatorCommissionRate(valAddr sdk.ValAddress, newRate sdk.Dec) MsgEditValidatorCommissionRate {
+	return MsgEditValidatorCommissionRate{
+		CommissionRate:   newRate,
+		ValidatorAddress: valAddr,
+	}
+}
+
+// nolint
+func (msg MsgEditValidatorCommissionRate) Route() string { return RouterKey }
+func (msg MsgEditValidatorCommissionRate) Type() string  { return "edit_validator_commission_rate" }
+func (msg MsgEditValidatorCommissionRate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgEditValidatorCommissionRate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgEditValidatorCommissionRate) ValidateBasic() error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr()
+	}
+
+	if msg.CommissionRate.IsNil() {
+		return ErrCommissionNotSet()
+	}
+	if msg.CommissionRate.IsNegative() {
+		return ErrCommissionNegative()
+	}
+	if msg.CommissionRate.GT(sdk.OneDec()) {
+		return ErrCommissionHuge()
+	}
+
+	return nil
+}
@@ -1,13 +1,17 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ensure Msg interface compliance at compile time
 var (
 	_ sdk.Msg = (*MsgAddShares)(nil)
+	_ sdk.Msg = (*MsgAddSharesWithWeights)(nil)
 	_ sdk.Msg = (*MsgDestroyValidator)(nil)
+	_ sdk.Msg = (*MsgEditProxyCommissionRate)(nil)
 )
 
 // MsgDestroyValidator - struct for transactions to deregister a validator
@@ -113,6 +117,53 @@ func (msg MsgRegProxy) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bytes)
 }
 
+// MsgEditProxyCommissionRate - struct for editing the commission rate that a proxy takes from the rewards of its bound
+// delegators
+type MsgEditProxyCommissionRate struct {
+	ProxyAddress   sdk.AccAddress `json:"proxy_address" yaml:"proxy_address"`
+	CommissionRate sdk.Dec        `json:"commission_rate" yaml:"commission_rate"`
+}
+
+// NewMsgEditProxyCommissionRate creates a msg of edit-proxy-commission-rate
+func NewMsgEditProxyCommissionRate(proxyAddress sdk.AccAddress, rate sdk.Dec) MsgEditProxyCommissionRate {
+	return MsgEditProxyCommissionRate{
+		ProxyAddress:   proxyAddress,
+		CommissionRate: rate,
+	}
+}
+
+// nolint
+func (MsgEditProxyCommissionRate) Route() string { return RouterKey }
+func (MsgEditProxyCommissionRate) Type() string  { return "edit_proxy_commission_rate" }
+func (msg MsgEditProxyCommissionRate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ProxyAddress}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgEditProxyCommissionRate) ValidateBasic() error {
+	if msg.ProxyAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+
+	if msg.CommissionRate.IsNil() {
+		return ErrCommissionNotSet()
+	}
+	if msg.CommissionRate.IsNegative() {
+		return ErrCommissionNegative()
+	}
+	if msg.CommissionRate.GT(sdk.OneDec()) {
+		return ErrCommissionHuge()
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgEditProxyCommissionRate) GetSignBytes() []byte {
+	bytes := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bytes)
+}
+
 // MsgBindProxy - structure for bind proxy relationship between the delegator and the proxy
 type MsgBindProxy struct {
 	DelAddr      sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
@@ -197,6 +248,66 @@ func (msg MsgAddShares) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bytes)
 }
 
+// MsgAddSharesWithWeights - struct for adding-shares transaction with a weight on every validator
+type MsgAddSharesWithWeights struct {
+	DelAddr  sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
+	ValAddrs []sdk.ValAddress `json:"validator_addresses" yaml:"validator_addresses"`
+	Weights  []sdk.Dec        `json:"weights" yaml:"weights"`
+}
+
+// NewMsgAddSharesWithWeights creates a msg of adding shares to vals by weights
+func NewMsgAddSharesWithWeights(delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, weights []sdk.Dec,
+) MsgAddSharesWithWeights {
+	return MsgAddSharesWithWeights{
+		DelAddr:  delAddr,
+		ValAddrs: valAddrs,
+		Weights:  weights,
+	}
+}
+
+// nolint
+func (MsgAddSharesWithWeights) Route() string { return RouterKey }
+func (MsgAddSharesWithWeights) Type() string  { return "add_shares_to_validators_with_weights" }
+func (msg MsgAddSharesWithWeights) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelAddr}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgAddSharesWithWeights) ValidateBasic() error {
+	if err := NewMsgAddShares(msg.DelAddr, msg.ValAddrs).ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(msg.Weights) != len(msg.ValAddrs) {
+		return ErrInvalidWeights("the number of weights doesn't match the number of validators")
+	}
+
+	return ValidateWeights(msg.Weights)
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgAddSharesWithWeights) GetSignBytes() []byte {
+	bytes := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bytes)
+}
+
+// ValidateWeights checks that every weight is positive and all of them sum to one
+func ValidateWeights(weights []sdk.Dec) error {
+	sum := sdk.ZeroDec()
+	for _, weight := range weights {
+		if weight.IsNil() || !weight.IsPositive() {
+			return ErrInvalidWeights("weight must be positive")
+		}
+		sum = sum.Add(weight)
+	}
+
+	if !sum.Equal(sdk.OneDec()) {
+		return ErrInvalidWeights(fmt.Sprintf("weights sum to %s rather than 1", sum))
+	}
+
+	return nil
+}
+
 func isValsDuplicate(valAddrs []sdk.ValAddress) bool {
 	lenAddrs := len(valAddrs)
 	filter := make(map[string]struct{}, lenAddrs)
@@ -286,3 +397,38 @@ func (msg MsgWithdraw) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// MsgCancelUndelegation - structure for canceling a pending undelegation entry, whose tokens are bonded back
+type MsgCancelUndelegation struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	UndelegationID   uint64         `json:"undelegation_id" yaml:"undelegation_id"`
+}
+
+// NewMsgCancelUndelegation creates a new instance of MsgCancelUndelegation
+func NewMsgCancelUndelegation(delAddr sdk.AccAddress, id uint64) MsgCancelUndelegation {
+	return MsgCancelUndelegation{
+		DelegatorAddress: delAddr,
+		UndelegationID:   id,
+	}
+}
+
+// nolint
+func (msg MsgCancelUndelegation) Route() string { return RouterKey }
+func (msg MsgCancelUndelegation) Type() string  { return "cancel_undelegation" }
+func (msg MsgCancelUndelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgCancelUndelegation) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgCancelUndelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
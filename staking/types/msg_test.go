@@ -44,6 +44,7 @@ func TestMsgCreateValidator(t *testing.T) {
 
 		msg := MsgCreateValidator{
 			Description:       description,
+			Commission:        NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
 			DelegatorAddress:  tc.delegatorAddr,
 			ValidatorAddress:  tc.validatorAddr,
 			PubKey:            tc.pubkey,
@@ -86,7 +87,8 @@ func TestMsgCreateValidator_Smoke(t *testing.T) {
 	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(2000))
 
 	msg := NewMsgCreateValidator(valAddr1, pk1,
-		NewDescription("my moniker", "my identity", "my website", "my details"), msd,
+		NewDescription("my moniker", "my identity", "my website", "my details"),
+		NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)), msd,
 	)
 	require.Contains(t, msg.Route(), RouterKey)
 	require.Contains(t, msg.Type(), "create_validator")
@@ -265,6 +267,33 @@ func TestMsgRegProxy(t *testing.T) {
 
 }
 
+func TestMsgEditProxyCommissionRate(t *testing.T) {
+	tests := []struct {
+		name       string
+		proxyAddr  sdk.AccAddress
+		rate       sdk.Dec
+		expectPass bool
+	}{
+		{"success", dlgAddr1, sdk.NewDecWithPrec(1, 1), true},
+		{"zero rate", dlgAddr1, sdk.ZeroDec(), true},
+		{"full rate", dlgAddr1, sdk.OneDec(), true},
+		{"empty proxy", sdk.AccAddress(emptyAddr), sdk.NewDecWithPrec(1, 1), false},
+		{"nil rate", dlgAddr1, sdk.Dec{}, false},
+		{"negative rate", dlgAddr1, sdk.NewDecWithPrec(-1, 1), false},
+		{"rate greater than one", dlgAddr1, sdk.NewDecWithPrec(11, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgEditProxyCommissionRate(tc.proxyAddr, tc.rate)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, msg, "edit_proxy_commission_rate")
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 func TestMsgAddShares(t *testing.T) {
 
 	tests := []struct {
@@ -292,6 +321,37 @@ func TestMsgAddShares(t *testing.T) {
 
 }
 
+func TestMsgAddSharesWithWeights(t *testing.T) {
+	tests := []struct {
+		name       string
+		valAddrs   []sdk.ValAddress
+		weights    []sdk.Dec
+		expectPass bool
+	}{
+		{"basic good", []sdk.ValAddress{valAddr1}, []sdk.Dec{sdk.OneDec()}, true},
+		{"basic good2", []sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.Dec{sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(4, 1)}, true},
+		{"sum less than one", []sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.Dec{sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(3, 1)}, false},
+		{"zero weight", []sdk.ValAddress{valAddr1, valAddr2}, []sdk.Dec{sdk.OneDec(), sdk.ZeroDec()}, false},
+		{"negative weight", []sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.Dec{sdk.NewDecWithPrec(15, 1), sdk.NewDecWithPrec(-5, 1)}, false},
+		{"weights mismatch", []sdk.ValAddress{valAddr1, valAddr2}, []sdk.Dec{sdk.OneDec()}, false},
+		{"duplicate", []sdk.ValAddress{valAddr1, valAddr1},
+			[]sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgAddSharesWithWeights(dlgAddr1, tc.valAddrs, tc.weights)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, msg, "add_shares_to_validators_with_weights")
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 //// test ValidateBasic for MsgUnbond
 //func TestMsgBeginRedelegate(t *testing.T) {
 //	tests := []struct {
@@ -39,6 +39,8 @@ var (
 	DefaultMinDelegation = sdk.NewDecWithPrec(1, 4)
 	// DefaultMinSelfDelegation is the default value of each validator's msd (hard code)
 	DefaultMinSelfDelegation = sdk.NewDec(500)
+	// DefaultMinCommissionRate is the default min commission rate of the validators
+	DefaultMinCommissionRate = sdk.ZeroDec()
 )
 
 // nolint - Keys for parameter access
@@ -51,6 +53,8 @@ var (
 	KeyMaxValsToAddShares = []byte("MaxValsToAddShares")
 	KeyMinDelegation      = []byte("MinDelegation")
 	KeyMinSelfDelegation  = []byte("MinSelfDelegation")
+	KeyMinCommissionRate  = []byte("MinCommissionRate")
+	KeyWeightCurve        = []byte("WeightCurve")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -69,11 +73,15 @@ type Params struct {
 	MinDelegation sdk.Dec `json:"min_delegation" yaml:"min_delegation"`
 	// validator's self declared minimum self delegation
 	MinSelfDelegation sdk.Dec `json:"min_self_delegation" yaml:"min_self_delegation"`
+	// the minimum commission rate that the validators are allowed to charge
+	MinCommissionRate sdk.Dec `json:"min_commission_rate" yaml:"min_commission_rate"`
+	// the curve that the weight of the tokens to add shares grows over time by
+	WeightCurve WeightCurve `json:"weight_curve" yaml:"weight_curve"`
 }
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration, maxValidators uint16, epoch uint16, maxValsToAddShares uint16, minDelegation sdk.Dec,
-	minSelfDelegation sdk.Dec) Params {
+	minSelfDelegation sdk.Dec, minCommissionRate sdk.Dec, weightCurve WeightCurve) Params {
 	return Params{
 		UnbondingTime:      unbondingTime,
 		MaxValidators:      maxValidators,
@@ -81,6 +89,8 @@ func NewParams(unbondingTime time.Duration, maxValidators uint16, epoch uint16,
 		MaxValsToAddShares: maxValsToAddShares,
 		MinDelegation:      minDelegation,
 		MinSelfDelegation:  minSelfDelegation,
+		MinCommissionRate:  minCommissionRate,
+		WeightCurve:        weightCurve,
 	}
 }
 
@@ -89,6 +99,15 @@ func validateParams(value interface{}) error {
 	return nil
 }
 
+func validateWeightCurve(value interface{}) error {
+	v, ok := value.(WeightCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	return v.Validate()
+}
+
 // ParamSetPairs is the implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
@@ -98,6 +117,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMaxValsToAddShares, Value: &p.MaxValsToAddShares, ValidatorFn: common.ValidateUint16Positive("max vals to add shares")},
 		{Key: KeyMinDelegation, Value: &p.MinDelegation, ValidatorFn: common.ValidateDecPositive("min delegation")},
 		{Key: KeyMinSelfDelegation, Value: &p.MinSelfDelegation, ValidatorFn: common.ValidateDecPositive("min self delegation")},
+		{Key: KeyMinCommissionRate, Value: &p.MinCommissionRate, ValidatorFn: common.ValidateRateNotNeg("min commission rate")},
+		{Key: KeyWeightCurve, Value: &p.WeightCurve, ValidatorFn: validateWeightCurve},
 	}
 }
 
@@ -118,6 +139,8 @@ func DefaultParams() Params {
 		DefaultMaxValsToAddShares,
 		DefaultMinDelegation,
 		DefaultMinSelfDelegation,
+		DefaultMinCommissionRate,
+		DefaultWeightCurve(),
 	)
 }
 
@@ -129,8 +152,11 @@ func (p Params) String() string {
   Epoch: 					%d
   MaxValsToAddShares:       %d
   MinDelegation				%d
-  MinSelfDelegation         %d`,
-		p.UnbondingTime, p.MaxValidators, p.Epoch, p.MaxValsToAddShares, p.MinDelegation, p.MinSelfDelegation)
+  MinSelfDelegation         %d
+  MinCommissionRate         %s
+  WeightCurve               %s %s %d`,
+		p.UnbondingTime, p.MaxValidators, p.Epoch, p.MaxValsToAddShares, p.MinDelegation, p.MinSelfDelegation,
+		p.MinCommissionRate, p.WeightCurve.GrowthType, p.WeightCurve.Cap, p.WeightCurve.EpochTimestamp)
 }
 
 // Validate gives a quick validity check for a set of params
@@ -144,6 +170,12 @@ func (p Params) Validate() error {
 	if p.MaxValsToAddShares == 0 {
 		return fmt.Errorf("staking parameter MaxValsToAddShares must be a positive integer")
 	}
+	if p.MinCommissionRate.IsNil() || p.MinCommissionRate.IsNegative() || p.MinCommissionRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MinCommissionRate must be within [0, 1]")
+	}
+	if err := p.WeightCurve.Validate(); err != nil {
+		return fmt.Errorf("staking parameter WeightCurve is invalid: %s", err)
+	}
 
 	return nil
 }
@@ -17,6 +17,7 @@ const (
 	QueryProxy               = "proxy"
 	QueryValidatorAllShares  = "validatorAllShares"
 	QueryDelegator           = "delegator"
+	QueryWeight              = "weight"
 )
 
 // QueryDelegatorParams defines the params for the following queries:
@@ -67,6 +68,25 @@ func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams
 //	}
 //}
 
+// QueryWeightParams defines the params for the following queries:
+// - 'custom/staking/weight'
+type QueryWeightParams struct {
+	Tokens sdk.Dec
+	// the time to calculate the weight at, which is the block time if it's zero
+	Timestamp int64
+	// the curve to calculate the weight by, which is the param WeightCurve if it's nil
+	Curve *WeightCurve
+}
+
+// NewQueryWeightParams creates a new instance of QueryWeightParams
+func NewQueryWeightParams(tokens sdk.Dec, timestamp int64, curve *WeightCurve) QueryWeightParams {
+	return QueryWeightParams{
+		Tokens:    tokens,
+		Timestamp: timestamp,
+		Curve:     curve,
+	}
+}
+
 // QueryValidatorsParams defines the params for the following queries:
 // - 'custom/staking/validators'
 type QueryValidatorsParams struct {
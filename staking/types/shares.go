@@ -20,6 +20,8 @@ func MustUnmarshalShares(cdc *codec.Codec, bytes []byte) Shares {
 type SharesResponse struct {
 	DelAddr sdk.AccAddress `json:"delegator_address"`
 	Shares  sdk.Dec        `json:"shares"`
+	// Weight is the weight of the shares among all the shares that the delegator added
+	Weight sdk.Dec `json:"weight"`
 }
 
 // NewSharesResponse creates a new instance of sharesResponse
@@ -27,12 +29,13 @@ func NewSharesResponse(delAddr sdk.AccAddress, shares Shares) SharesResponse {
 	return SharesResponse{
 		delAddr,
 		shares,
+		sdk.OneDec(),
 	}
 }
 
 // String returns a human readable string representation of SharesResponse
 func (sr SharesResponse) String() string {
-	return fmt.Sprintf("%s\n  Shares:   %s", sr.DelAddr.String(), sr.Shares)
+	return fmt.Sprintf("%s\n  Shares:   %s\n  Weight:   %s", sr.DelAddr.String(), sr.Shares, sr.Weight)
 }
 
 // SharesResponses is the type alias of SharesResponse slice
@@ -41,7 +44,7 @@ type SharesResponses []SharesResponse
 // String returns a human readable string representation of SharesResponses
 func (srs SharesResponses) String() (strFormat string) {
 	for _, sr := range srs {
-		strFormat = fmt.Sprintf("%s %s:%s", strFormat, sr.DelAddr.String(), sr.Shares.String())
+		strFormat = fmt.Sprintf("%s %s:%s(%s)", strFormat, sr.DelAddr.String(), sr.Shares.String(), sr.Weight.String())
 	}
 
 	return strFormat
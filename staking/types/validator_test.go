@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/okex/exchain/x/common"
 
@@ -139,6 +140,29 @@ func TestValidatorMarshalUnmarshalJSON(t *testing.T) {
 	assert.Equal(t, validator, *got)
 }
 
+func TestValidatorExportImport(t *testing.T) {
+	common.InitConfig()
+	validator := NewValidator(valAddr1, pk1, NewDescription("moniker", "", "", ""), DefaultMinSelfDelegation)
+	validator.DelegatorShares = sdk.NewDec(100)
+	validator.Commission = NewCommissionWithTime(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1),
+		sdk.NewDecWithPrec(1, 2), time.Unix(100, 0).UTC())
+
+	// the commission is exported and imported with the validator
+	js, err := ModuleCdc.MarshalJSON(validator.Export())
+	require.NoError(t, err)
+	var exported ValidatorExported
+	require.NoError(t, ModuleCdc.UnmarshalJSON(js, &exported))
+	got := exported.Import()
+	require.True(t, validator.Commission.Equal(got.Commission), got.Commission.String())
+	require.Equal(t, validator.OperatorAddress, got.OperatorAddress)
+	require.True(t, validator.DelegatorShares.Equal(got.DelegatorShares))
+
+	// the validator exported without the commission is imported with the fixed commission of 100%
+	exported.Commission = nil
+	got = exported.Import()
+	require.True(t, got.Commission.Equal(NewCommission(sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec())))
+}
+
 func TestValidatorSetInitialCommission(t *testing.T) {
 	val := NewValidator(valAddr1, pk1, Description{}, DefaultMinSelfDelegation)
 	testCases := []struct {
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// growth types of the weight curve
+const (
+	// WeightGrowthLinear grows the weight by 1/52 every week since the epoch timestamp
+	WeightGrowthLinear = "linear"
+	// WeightGrowthCapped grows the weight in the same way as the linear one until it reaches the cap
+	WeightGrowthCapped = "capped"
+	// WeightGrowthStep grows the weight by one every 52 weeks since the epoch timestamp
+	WeightGrowthStep = "step"
+
+	// DefaultWeightEpochTimestamp is the epoch timestamp of the weight curve. UTC+8 Time: 2021-07-05 07:05:02
+	DefaultWeightEpochTimestamp = int64(1625439902)
+
+	secondsPerWeek = int64(60 * 60 * 24 * 7)
+	weeksPerYear   = int64(52)
+)
+
+// WeightCurve defines how the weight of the tokens to add shares grows over time. The shares of the tokens are the
+// product of the tokens and the weight
+type WeightCurve struct {
+	// the timestamp that the weight starts growing from
+	EpochTimestamp int64 `json:"epoch_timestamp" yaml:"epoch_timestamp"`
+	// one of linear, capped and step
+	GrowthType string `json:"growth_type" yaml:"growth_type"`
+	// the max weight, which is required by the capped growth and optional for the others. zero means no cap
+	Cap sdk.Dec `json:"cap" yaml:"cap"`
+}
+
+// NewWeightCurve creates a new instance of WeightCurve
+func NewWeightCurve(epochTimestamp int64, growthType string, cap sdk.Dec) WeightCurve {
+	return WeightCurve{
+		EpochTimestamp: epochTimestamp,
+		GrowthType:     growthType,
+		Cap:            cap,
+	}
+}
+
+// DefaultWeightCurve returns the linear weight curve without cap, which shares have been calculated by since genesis
+func DefaultWeightCurve() WeightCurve {
+	return NewWeightCurve(DefaultWeightEpochTimestamp, WeightGrowthLinear, sdk.ZeroDec())
+}
+
+// Validate gives a quick validity check for the weight curve
+func (wc WeightCurve) Validate() error {
+	if wc.EpochTimestamp <= 0 {
+		return fmt.Errorf("epoch timestamp of weight curve must be positive: %d", wc.EpochTimestamp)
+	}
+	if wc.Cap.IsNil() || wc.Cap.IsNegative() {
+		return fmt.Errorf("cap of weight curve must not be negative: %s", wc.Cap)
+	}
+
+	switch wc.GrowthType {
+	case WeightGrowthLinear, WeightGrowthStep:
+	case WeightGrowthCapped:
+		if !wc.Cap.IsPositive() {
+			return fmt.Errorf("cap of the capped weight curve must be positive: %s", wc.Cap)
+		}
+	default:
+		return fmt.Errorf("unknown growth type of weight curve: %s", wc.GrowthType)
+	}
+
+	return nil
+}
+
+// Equal returns a boolean determining if two weight curves are identical
+func (wc WeightCurve) Equal(wc2 WeightCurve) bool {
+	return wc.EpochTimestamp == wc2.EpochTimestamp && wc.GrowthType == wc2.GrowthType && wc.Cap.Equal(wc2.Cap)
+}
+
+// Weight calculates the weight at the time, which is zero before the epoch timestamp
+func (wc WeightCurve) Weight(nowTime int64) (weight sdk.Dec, err error) {
+	if nowTime < wc.EpochTimestamp {
+		return sdk.ZeroDec(), nil
+	}
+
+	nowWeek := (nowTime - wc.EpochTimestamp) / secondsPerWeek
+	switch wc.GrowthType {
+	case WeightGrowthStep:
+		weight = sdk.NewDec(nowWeek / weeksPerYear)
+	default:
+		// keep the float calculation that the shares have been calculated by since genesis
+		rate := float64(nowWeek) / float64(weeksPerYear)
+		precision := fmt.Sprintf("%d", sdk.Precision)
+		weight, err = sdk.NewDecFromStr(fmt.Sprintf("%."+precision+"f", rate))
+		if err != nil {
+			return
+		}
+	}
+
+	if wc.Cap.IsPositive() && weight.GT(wc.Cap) {
+		weight = wc.Cap
+	}
+	return
+}
+
+// String returns a human readable string representation of WeightCurve
+func (wc WeightCurve) String() string {
+	return fmt.Sprintf(`WeightCurve:
+  EpochTimestamp:    %d
+  GrowthType:        %s
+  Cap:               %s`, wc.EpochTimestamp, wc.GrowthType, wc.Cap)
+}
+
+// WeightResponse is the result of simulating the weight curve
+type WeightResponse struct {
+	Curve     WeightCurve `json:"curve" yaml:"curve"`
+	Timestamp int64       `json:"timestamp" yaml:"timestamp"`
+	Weight    sdk.Dec     `json:"weight" yaml:"weight"`
+	Shares    Shares      `json:"shares" yaml:"shares"`
+}
+
+// String returns a human readable string representation of WeightResponse
+func (wr WeightResponse) String() string {
+	return fmt.Sprintf(`%s
+Timestamp:           %d
+Weight:              %s
+Shares:              %s`, wr.Curve, wr.Timestamp, wr.Weight, wr.Shares)
+}
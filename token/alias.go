@@ -37,6 +37,8 @@ type (
 	FeeDetail = types.FeeDetail
 	CoinsInfo = types.CoinsInfo
 	Token     = types.Token
+	// TokenAccount account frozen or blacklisted for a token
+	TokenAccount = types.TokenAccount
 )
 
 var (
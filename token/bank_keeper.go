@@ -0,0 +1,63 @@
+package token
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+var _ bank.Keeper = (*BankKeeper)(nil)
+
+// BankKeeper is the bank keeper whose sends are checked against the issuer controls of the tokens, so that a paused
+// token or a frozen or blacklisted account can't be bypassed through the bank msgs or the transfers of other modules
+type BankKeeper struct {
+	bank.Keeper
+	tokenKeeper *Keeper
+}
+
+// NewBankKeeper wraps the bank keeper with the checks of the issuer controls of the tokens
+func NewBankKeeper(bankKeeper bank.Keeper) *BankKeeper {
+	return &BankKeeper{Keeper: bankKeeper}
+}
+
+// SetTokenKeeper sets the token keeper checking the sends. The token keeper is created upon the bank keeper, so the
+// sends are left unchecked until it's set
+func (bk *BankKeeper) SetTokenKeeper(tokenKeeper Keeper) {
+	bk.tokenKeeper = &tokenKeeper
+}
+
+// SendCoins checks the issuer controls of the tokens before sending the coins
+func (bk *BankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if bk.tokenKeeper != nil {
+		if err := bk.tokenKeeper.CheckTokenTransfer(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+	return bk.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// SubtractCoins checks the issuer controls of the tokens before subtracting the coins from the account
+func (bk *BankKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+	if bk.tokenKeeper != nil {
+		if err := bk.tokenKeeper.CheckTokenTransfer(ctx, addr, nil, amt); err != nil {
+			return nil, err
+		}
+	}
+	return bk.Keeper.SubtractCoins(ctx, addr, amt)
+}
+
+// InputOutputCoins checks the issuer controls of the tokens for the inputs and the outputs before the multi-send
+func (bk *BankKeeper) InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) error {
+	if bk.tokenKeeper != nil {
+		for _, input := range inputs {
+			if err := bk.tokenKeeper.CheckTokenTransfer(ctx, input.Address, nil, input.Coins); err != nil {
+				return err
+			}
+		}
+		for _, output := range outputs {
+			if err := bk.tokenKeeper.CheckTokenTransfer(ctx, nil, output.Address, output.Coins); err != nil {
+				return err
+			}
+		}
+	}
+	return bk.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
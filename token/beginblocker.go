@@ -1,6 +1,8 @@
 package token
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/exchain/x/common/perf"
 	"github.com/okex/exchain/x/token/types"
@@ -12,4 +14,39 @@ func beginBlocker(ctx sdk.Context, keeper Keeper) {
 	defer perf.GetPerf().OnBeginBlockExit(ctx, types.ModuleName, seq)
 
 	keeper.ResetCache(ctx)
+
+	releaseVestingSchedules(ctx, keeper)
+}
+
+// releaseVestingSchedules unlocks the coins matured of the vesting schedules queued to be released by the block time,
+// so the schedules not matured are never iterated. The queue is collected first as it's updated while releasing, and
+// at most MaxVestingReleasesPerBlock schedules are released in a block.
+func releaseVestingSchedules(ctx sdk.Context, keeper Keeper) {
+	type queued struct {
+		releaseTime time.Time
+		id          uint64
+	}
+	var matured []queued
+	iter := keeper.vestingQueueIterator(ctx, ctx.BlockTime())
+	for ; iter.Valid() && len(matured) < types.MaxVestingReleasesPerBlock; iter.Next() {
+		releaseTime, id := types.SplitVestingQueueKey(iter.Key())
+		matured = append(matured, queued{releaseTime: releaseTime, id: id})
+	}
+	iter.Close()
+
+	logger := ctx.Logger().With("module", types.ModuleName)
+	for _, entry := range matured {
+		cacheCtx, write := ctx.CacheContext()
+		keeper.deleteVestingQueue(cacheCtx, entry.id, entry.releaseTime)
+		if schedule, found := keeper.GetVestingSchedule(cacheCtx, entry.id); found {
+			if err := keeper.releaseVestedCoins(cacheCtx, schedule); err != nil {
+				// the state of the failed release is discarded, and the schedule is queued to be retried later
+				logger.Debug("failed to release the vesting schedule", "id", entry.id, "err", err)
+				keeper.deleteVestingQueue(ctx, entry.id, entry.releaseTime)
+				keeper.insertVestingQueue(ctx, entry.id, ctx.BlockTime().Add(types.VestingReleaseRetryInterval))
+				continue
+			}
+		}
+		write()
+	}
 }
@@ -1,9 +1,136 @@
 package token
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/exchain/x/token/types"
+	"github.com/stretchr/testify/require"
+)
 
 func TestBeginBlocker(t *testing.T) {
 	ctx, kpr, _, _ := CreateParam(t, false)
 
 	beginBlocker(ctx, kpr)
 }
+
+func TestBeginBlockerReleaseVesting(t *testing.T) {
+	ctx, kpr, _, _ := CreateParam(t, false)
+	creator, beneficiary := sdk.AccAddress([]byte("creator_____________")), sdk.AccAddress([]byte("beneficiary_________"))
+	NewTestToken(t, ctx, kpr, kpr.bankKeeper, "usdk", []sdk.AccAddress{creator})
+	coins := func(amount int64) sdk.SysCoins {
+		return sdk.SysCoins{sdk.NewDecCoinFromDec("usdk", sdk.NewDec(amount))}
+	}
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	linear, err := kpr.CreateVestingSchedule(ctx, creator, beneficiary, coins(1000),
+		start, start.Add(100*time.Second), start.Add(1000*time.Second), 0)
+	require.NoError(t, err)
+	step, err := kpr.CreateVestingSchedule(ctx, creator, beneficiary, coins(1000),
+		start, start, start.Add(1000*time.Second), 4)
+	require.NoError(t, err)
+	require.Equal(t, linear.ID+1, step.ID)
+	require.Equal(t, coins(2000), kpr.GetLockedVestingCoins(ctx, beneficiary))
+	require.Len(t, kpr.GetAccountVestingSchedules(ctx, beneficiary), 2)
+
+	// only the schedules matured are iterated, which is the step one before the cliff of the linear one
+	maturedIDs := func(blockTime time.Time) (ids []uint64) {
+		iter := kpr.vestingQueueIterator(ctx, blockTime)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			_, id := types.SplitVestingQueueKey(iter.Key())
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	require.Equal(t, []uint64{step.ID}, maturedIDs(start.Add(50*time.Second)))
+	require.Equal(t, []uint64{step.ID, linear.ID}, maturedIDs(start.Add(100*time.Second)))
+
+	testCases := []struct {
+		elapsed time.Duration
+		balance int64
+	}{
+		// nothing before the cliff of the linear one and the first step
+		{50 * time.Second, 0},
+		// the linear one releases the vested since the start at the cliff
+		{100 * time.Second, 100},
+		{250 * time.Second, 250 + 250},
+		{600 * time.Second, 600 + 500},
+		{1000 * time.Second, 1000 + 1000},
+		{2000 * time.Second, 1000 + 1000},
+	}
+	for _, tc := range testCases {
+		beginBlocker(ctx.WithBlockTime(start.Add(tc.elapsed)), kpr)
+		require.Equal(t, coins(tc.balance).String(), kpr.GetCoins(ctx, beneficiary).String(), tc.elapsed)
+		require.Equal(t, coins(2000-tc.balance).String(), kpr.GetLockedVestingCoins(ctx, beneficiary).String())
+	}
+
+	// the finished schedules are deleted along with their queue entries
+	require.Empty(t, kpr.GetAccountVestingSchedules(ctx, beneficiary))
+	require.Empty(t, maturedIDs(start.Add(10000*time.Second)))
+	_, found := kpr.GetVestingSchedule(ctx, linear.ID)
+	require.False(t, found)
+}
+
+func TestBeginBlockerReleaseVestingPaused(t *testing.T) {
+	ctx, kpr, _, _ := CreateParam(t, false)
+	creator, beneficiary := sdk.AccAddress([]byte("creator_____________")), sdk.AccAddress([]byte("beneficiary_________"))
+	NewTestToken(t, ctx, kpr, kpr.bankKeeper, "usdk", []sdk.AccAddress{creator})
+	amount := sdk.SysCoins{sdk.NewDecCoinFromDec("usdk", sdk.NewDec(10))}
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule, err := kpr.CreateVestingSchedule(ctx, creator, beneficiary, amount, start, start, start.Add(time.Second), 0)
+	require.NoError(t, err)
+
+	// the release is postponed while the token is paused
+	token := kpr.GetTokenInfo(ctx, "usdk")
+	token.Pausable, token.Paused = true, true
+	kpr.UpdateToken(ctx, token)
+	failedTime := start.Add(time.Hour)
+	beginBlocker(ctx.WithBlockTime(failedTime), kpr)
+	require.True(t, kpr.GetCoins(ctx, beneficiary).IsZero())
+	_, found := kpr.GetVestingSchedule(ctx, schedule.ID)
+	require.True(t, found)
+
+	// the failed release is queued to be retried later, not in the next blocks of the same time
+	retryTime := failedTime.Add(types.VestingReleaseRetryInterval)
+	iter := kpr.vestingQueueIterator(ctx, retryTime)
+	require.True(t, iter.Valid())
+	releaseTime, id := types.SplitVestingQueueKey(iter.Key())
+	require.Equal(t, schedule.ID, id)
+	require.True(t, releaseTime.Equal(retryTime))
+	iter.Next()
+	require.False(t, iter.Valid())
+	iter.Close()
+
+	token.Paused = false
+	kpr.UpdateToken(ctx, token)
+	beginBlocker(ctx.WithBlockTime(failedTime), kpr)
+	require.True(t, kpr.GetCoins(ctx, beneficiary).IsZero())
+	beginBlocker(ctx.WithBlockTime(retryTime), kpr)
+	require.Equal(t, amount.String(), kpr.GetCoins(ctx, beneficiary).String())
+	require.Equal(t, types.VestingSchedules(nil), kpr.GetAccountVestingSchedules(ctx, beneficiary))
+}
+
+func TestBeginBlockerReleaseVestingLimit(t *testing.T) {
+	ctx, kpr, _, _ := CreateParam(t, false)
+	creator, beneficiary := sdk.AccAddress([]byte("creator_____________")), sdk.AccAddress([]byte("beneficiary_________"))
+	NewTestToken(t, ctx, kpr, kpr.bankKeeper, "usdk", []sdk.AccAddress{creator})
+	amount := sdk.SysCoins{sdk.NewDecCoinFromDec("usdk", sdk.NewDec(1))}
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	num := types.MaxVestingReleasesPerBlock + 10
+	for i := 0; i < num; i++ {
+		_, err := kpr.CreateVestingSchedule(ctx, creator, beneficiary, amount, start, start, start.Add(time.Second), 0)
+		require.NoError(t, err)
+	}
+
+	// the schedules matured beyond the limit are released in the next block
+	blockTime := start.Add(time.Hour)
+	beginBlocker(ctx.WithBlockTime(blockTime), kpr)
+	require.Len(t, kpr.GetAccountVestingSchedules(ctx, beneficiary), num-types.MaxVestingReleasesPerBlock)
+	beginBlocker(ctx.WithBlockTime(blockTime), kpr)
+	require.Empty(t, kpr.GetAccountVestingSchedules(ctx, beneficiary))
+	require.Equal(t, sdk.NewDec(int64(num)), kpr.GetCoins(ctx, beneficiary).AmountOf("usdk"))
+}
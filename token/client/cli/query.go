@@ -26,6 +26,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	queryCmd.AddCommand(flags.GetCommands(
 		getCmdQueryParams(queryRoute, cdc),
 		getCmdTokenInfo(queryRoute, cdc),
+		getCmdQueryControls(queryRoute, cdc),
+		getCmdQueryVesting(queryRoute, cdc),
 		//getAccountCmd(queryRoute, cdc),
 	)...)
 
@@ -105,6 +107,50 @@ $ exchaincli query token params
 	}
 }
 
+// getCmdQueryControls implements the query controls command.
+func getCmdQueryControls(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "controls [symbol]",
+		Short: "Query the paused state and the frozen and blacklisted accounts of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryControls, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var controls types.TokenControls
+			cdc.MustUnmarshalJSON(bz, &controls)
+			return cliCtx.PrintOutput(controls)
+		},
+	}
+}
+
+// getCmdQueryVesting implements the query vesting command.
+func getCmdQueryVesting(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting [address]",
+		Short: "Query the unfinished vesting schedules of a beneficiary",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryVesting, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var schedules types.VestingSchedules
+			cdc.MustUnmarshalJSON(bz, &schedules)
+			return cliCtx.PrintOutput(schedules)
+		},
+	}
+}
+
 // just for the object of []string could be inputted into cliCtx.PrintOutput(...)
 type Strings []string
 
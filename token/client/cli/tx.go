@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -31,6 +32,16 @@ const (
 	Mintable      = "mintable"
 	Transfers     = "transfers"
 	TransfersFile = "transfers-file"
+	Pausable      = "pausable"
+	Freezable     = "freezable"
+	Blacklistable = "blacklistable"
+	Addresses     = "addresses"
+	Revoke        = "revoke"
+	Beneficiary   = "beneficiary"
+	StartTime     = "start-time"
+	CliffTime     = "cliff-time"
+	EndTime       = "end-time"
+	Steps         = "steps"
 )
 
 const (
@@ -45,6 +56,9 @@ var (
 	errTokenDescNotValid      = errors.New("token-desc not valid")
 	errTokenWholeNameNotValid = errors.New("token whole name not valid")
 	errMintableNotValid       = errors.New("mintable not valid")
+	errControlsNotValid       = errors.New("pausable, freezable or blacklistable not valid")
+	errAddressesNotValid      = errors.New("addresses not valid")
+	errVestingTimeNotValid    = errors.New("vesting time not valid, RFC3339 format is required")
 	errTransfersNotValid      = errors.New("transfers not valid")
 	errTransfersFileNotValid  = errors.New("transfers file not valid")
 	errSign                   = errors.New("sign not succeed")
@@ -69,6 +83,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		getCmdTransferOwnership(cdc),
 		getCmdConfirmOwnership(cdc),
 		getCmdTokenEdit(cdc),
+		getCmdTokenPause(cdc),
+		getCmdTokenFreeze(cdc),
+		getCmdTokenBlacklist(cdc),
+		getCmdCreateVestingSchedule(cdc),
 	)...)
 
 	return distTxCmd
@@ -127,10 +145,18 @@ func getCmdTokenIssue(cdc *codec.Codec) *cobra.Command {
 				return errMintableNotValid
 			}
 
+			pausable, err1 := flags.GetBool(Pausable)
+			freezable, err2 := flags.GetBool(Freezable)
+			blacklistable, err3 := flags.GetBool(Blacklistable)
+			if err1 != nil || err2 != nil || err3 != nil {
+				return errControlsNotValid
+			}
+
 			var symbol string
 
 			// totalSupply int64 ,coins bigint
 			msg := types.NewMsgTokenIssue(tokenDesc, symbol, originalSymbol, wholeName, totalSupply, cliCtx.FromAddress, mintable)
+			msg.Pausable, msg.Freezable, msg.Blacklistable = pausable, freezable, blacklistable
 
 			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
 		},
@@ -141,6 +167,9 @@ func getCmdTokenIssue(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(TokenDesc, "", "describe of the token")
 	cmd.Flags().StringP(TotalSupply, "n", "0", "total supply of the new token")
 	cmd.Flags().Bool(Mintable, false, "whether the token can be minted")
+	cmd.Flags().Bool(Pausable, false, "whether all the transfers of the token can be paused by the owner")
+	cmd.Flags().Bool(Freezable, false, "whether the accounts can be frozen for the token by the owner")
+	cmd.Flags().Bool(Blacklistable, false, "whether the accounts can be blacklisted for the token by the owner")
 
 	return cmd
 }
@@ -460,3 +489,174 @@ func getCmdConfirmOwnership(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP("symbol", "s", "", "symbol of the token to be transferred")
 	return cmd
 }
+
+// getCmdTokenPause is the CLI command for sending a PauseToken transaction
+func getCmdTokenPause(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "pause or resume all the transfers of a pausable token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+			flags := cmd.Flags()
+
+			symbol, err := flags.GetString(Symbol)
+			if err != nil {
+				return errSymbolNotValid
+			}
+			revoke, err := flags.GetBool(Revoke)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenPause(symbol, cliCtx.GetFromAddress(), !revoke)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
+	cmd.Flags().Bool(Revoke, false, "resume the transfers of the token instead")
+	return cmd
+}
+
+// getCmdTokenFreeze is the CLI command for sending a FreezeToken transaction
+func getCmdTokenFreeze(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "freeze or unfreeze the accounts for a freezable token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+
+			symbol, addrs, revoke, err := getControlFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenFreeze(symbol, cliCtx.GetFromAddress(), addrs, !revoke)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
+	cmd.Flags().String(Addresses, "", "comma separated addresses of the accounts")
+	cmd.Flags().Bool(Revoke, false, "unfreeze the accounts instead")
+	return cmd
+}
+
+// getCmdTokenBlacklist is the CLI command for sending a BlacklistToken transaction
+func getCmdTokenBlacklist(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blacklist",
+		Short: "add the accounts to or remove them from the blacklist of a blacklistable token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+
+			symbol, addrs, revoke, err := getControlFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenBlacklist(symbol, cliCtx.GetFromAddress(), addrs, !revoke)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
+	cmd.Flags().String(Addresses, "", "comma separated addresses of the accounts")
+	cmd.Flags().Bool(Revoke, false, "remove the accounts from the blacklist instead")
+	return cmd
+}
+
+func getControlFlags(cmd *cobra.Command) (symbol string, addrs []sdk.AccAddress, revoke bool, err error) {
+	flags := cmd.Flags()
+	if symbol, err = flags.GetString(Symbol); err != nil {
+		return "", nil, false, errSymbolNotValid
+	}
+	if revoke, err = flags.GetBool(Revoke); err != nil {
+		return "", nil, false, err
+	}
+
+	addrsStr, err := flags.GetString(Addresses)
+	if err != nil {
+		return "", nil, false, errAddressesNotValid
+	}
+	for _, addrStr := range strings.Split(addrsStr, ",") {
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(addrStr))
+		if err != nil {
+			return "", nil, false, errAddressesNotValid
+		}
+		addrs = append(addrs, addr)
+	}
+	return symbol, addrs, revoke, nil
+}
+
+// getCmdCreateVestingSchedule is the CLI command for sending a CreateVestingSchedule transaction
+func getCmdCreateVestingSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting",
+		Short: "lock tokens for a beneficiary, released linearly or in steps between the cliff time and the end time",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+			flags := cmd.Flags()
+
+			beneficiaryStr, err := flags.GetString(Beneficiary)
+			if err != nil {
+				return errAddressesNotValid
+			}
+			beneficiary, err := sdk.AccAddressFromBech32(beneficiaryStr)
+			if err != nil {
+				return errAddressesNotValid
+			}
+			amountStr, err := flags.GetString(Amount)
+			if err != nil {
+				return errAmountNotValid
+			}
+			amount, err := sdk.ParseDecCoins(amountStr)
+			if err != nil {
+				return errAmountNotValid
+			}
+
+			var times [3]time.Time
+			for i, flag := range []string{StartTime, CliffTime, EndTime} {
+				timeStr, err := flags.GetString(flag)
+				if err != nil {
+					return errVestingTimeNotValid
+				}
+				if times[i], err = time.Parse(time.RFC3339, timeStr); err != nil {
+					return errVestingTimeNotValid
+				}
+			}
+			steps, err := flags.GetInt64(Steps)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingSchedule(cliCtx.GetFromAddress(), beneficiary, amount,
+				times[0], times[1], times[2], steps)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(Beneficiary, "", "the account to receive the tokens")
+	cmd.Flags().String(Amount, "", "the tokens to be locked, e.g. 100usdk-000")
+	cmd.Flags().String(StartTime, "", "the time the vesting starts in RFC3339 format, e.g. 2021-01-01T00:00:00Z")
+	cmd.Flags().String(CliffTime, "", "the time before which nothing is released in RFC3339 format")
+	cmd.Flags().String(EndTime, "", "the time all the tokens are released in RFC3339 format")
+	cmd.Flags().Int64(Steps, 0, "the number of the equal step releases, 0 for the linear release")
+	return cmd
+}
@@ -18,6 +18,11 @@ type GenesisState struct {
 	Tokens       []types.Token    `json:"tokens"`
 	LockedAssets []types.AccCoins `json:"locked_assets"`
 	LockedFees   []types.AccCoins `json:"locked_fees"`
+
+	FrozenAccounts      []types.TokenAccount `json:"frozen_accounts"`
+	BlacklistedAccounts []types.TokenAccount `json:"blacklisted_accounts"`
+
+	VestingSchedules []types.VestingSchedule `json:"vesting_schedules"`
 }
 
 // default GenesisState used by Cosmos Hub
@@ -64,6 +69,38 @@ func validateGenesis(data GenesisState) error {
 			return errors.New(err.Error())
 		}
 	}
+
+	tokens := make(map[string]types.Token, len(data.Tokens))
+	for _, token := range data.Tokens {
+		tokens[token.Symbol] = token
+	}
+	for _, account := range data.FrozenAccounts {
+		if !tokens[account.Symbol].Freezable || account.Address.Empty() {
+			return fmt.Errorf("invalid frozen account %s of token %s", account.Address, account.Symbol)
+		}
+	}
+	for _, account := range data.BlacklistedAccounts {
+		if !tokens[account.Symbol].Blacklistable || account.Address.Empty() {
+			return fmt.Errorf("invalid blacklisted account %s of token %s", account.Address, account.Symbol)
+		}
+	}
+
+	ids := make(map[uint64]bool, len(data.VestingSchedules))
+	for _, schedule := range data.VestingSchedules {
+		if ids[schedule.ID] {
+			return fmt.Errorf("duplicated vesting schedule %d", schedule.ID)
+		}
+		ids[schedule.ID] = true
+
+		msg := types.NewMsgCreateVestingSchedule(schedule.Creator, schedule.Beneficiary, schedule.Amount,
+			schedule.StartTime, schedule.CliffTime, schedule.EndTime, schedule.Steps)
+		if err := msg.ValidateBasic(); err != nil {
+			return errors.New(err.Error())
+		}
+		if _, isNegative := schedule.Amount.SafeSub(schedule.Released); isNegative {
+			return fmt.Errorf("vesting schedule %d released more than its amount", schedule.ID)
+		}
+	}
 	return nil
 }
 
@@ -93,6 +130,29 @@ func initGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 			panic(err)
 		}
 	}
+
+	for _, account := range data.FrozenAccounts {
+		keeper.SetAccountFrozen(ctx, account.Symbol, account.Address, true)
+	}
+	for _, account := range data.BlacklistedAccounts {
+		keeper.SetAccountBlacklisted(ctx, account.Symbol, account.Address, true)
+	}
+
+	// the coins unreleased are held by the module account already, so only the locks are restored
+	var nextVestingID uint64 = 1
+	for _, schedule := range data.VestingSchedules {
+		keeper.SetVestingSchedule(ctx, schedule)
+		// the schedule is queued at its cliff, being released from the first block if the cliff has passed
+		keeper.insertVestingQueue(ctx, schedule.ID, schedule.CliffTime)
+		if err := keeper.updateLockedCoins(ctx, schedule.Beneficiary, schedule.Unreleased(), true,
+			types.LockCoinsTypeVesting); err != nil {
+			panic(err)
+		}
+		if schedule.ID >= nextVestingID {
+			nextVestingID = schedule.ID + 1
+		}
+	}
+	keeper.setNextVestingScheduleID(ctx, nextVestingID)
 }
 
 // ExportGenesis writes the current store values
@@ -113,10 +173,21 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
+	var vestingSchedules []types.VestingSchedule
+	keeper.IterateVestingSchedules(ctx, func(schedule types.VestingSchedule) bool {
+		vestingSchedules = append(vestingSchedules, schedule)
+		return false
+	})
+
 	return GenesisState{
 		Params:       params,
 		Tokens:       tokens,
 		LockedAssets: lockedAsset,
 		LockedFees:   lockedFees,
+
+		FrozenAccounts:      keeper.GetAllFrozenAccounts(ctx),
+		BlacklistedAccounts: keeper.GetAllBlacklistedAccounts(ctx),
+
+		VestingSchedules: vestingSchedules,
 	}
 }
@@ -68,6 +68,30 @@ func NewTokenHandler(keeper Keeper, protocolVersion version.ProtocolVersionType)
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgTokenModify(ctx, keeper, msg, logger)
 			}
+
+		case types.MsgTokenPause:
+			name = "handleMsgTokenPause"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenPause(ctx, keeper, msg, logger)
+			}
+
+		case types.MsgTokenFreeze:
+			name = "handleMsgTokenFreeze"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenFreeze(ctx, keeper, msg, logger)
+			}
+
+		case types.MsgTokenBlacklist:
+			name = "handleMsgTokenBlacklist"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenBlacklist(ctx, keeper, msg, logger)
+			}
+
+		case types.MsgCreateVestingSchedule:
+			name = "handleMsgCreateVestingSchedule"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCreateVestingSchedule(ctx, keeper, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("Unrecognized token Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -99,6 +123,9 @@ func handleMsgTokenIssue(ctx sdk.Context, keeper Keeper, msg types.MsgTokenIssue
 		OriginalTotalSupply: totalSupply,
 		Owner:               msg.Owner,
 		Mintable:            msg.Mintable,
+		Pausable:            msg.Pausable,
+		Freezable:           msg.Freezable,
+		Blacklistable:       msg.Blacklistable,
 	}
 
 	// generate a random symbol
@@ -456,3 +483,134 @@ func handleMsgTokenModify(ctx sdk.Context, keeper Keeper, msg types.MsgTokenModi
 	)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgTokenPause(ctx sdk.Context, keeper Keeper, msg types.MsgTokenPause, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+	if !token.Pausable {
+		return types.ErrTokenControlNotEnabled(msg.Symbol, "pausable").Result()
+	}
+
+	token.Paused = msg.Paused
+	keeper.UpdateToken(ctx, token)
+
+	name := "handleMsgTokenPause"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Paused:%v>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Paused))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute("symbol", msg.Symbol),
+			sdk.NewAttribute("paused", fmt.Sprintf("%v", msg.Paused)),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTokenFreeze(ctx sdk.Context, keeper Keeper, msg types.MsgTokenFreeze, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+	if !token.Freezable {
+		return types.ErrTokenControlNotEnabled(msg.Symbol, "freezable").Result()
+	}
+
+	for _, addr := range msg.Addresses {
+		keeper.SetAccountFrozen(ctx, msg.Symbol, addr, msg.Frozen)
+	}
+
+	name := "handleMsgTokenFreeze"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Addresses:%v,Frozen:%v>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Addresses, msg.Frozen))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute("symbol", msg.Symbol),
+			sdk.NewAttribute("frozen", fmt.Sprintf("%v", msg.Frozen)),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTokenBlacklist(ctx sdk.Context, keeper Keeper, msg types.MsgTokenBlacklist, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+	if !token.Blacklistable {
+		return types.ErrTokenControlNotEnabled(msg.Symbol, "blacklistable").Result()
+	}
+
+	for _, addr := range msg.Addresses {
+		keeper.SetAccountBlacklisted(ctx, msg.Symbol, addr, msg.Blacklisted)
+	}
+
+	name := "handleMsgTokenBlacklist"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Addresses:%v,Blacklisted:%v>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Addresses, msg.Blacklisted))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute("symbol", msg.Symbol),
+			sdk.NewAttribute("blacklisted", fmt.Sprintf("%v", msg.Blacklisted)),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCreateVestingSchedule(ctx sdk.Context, keeper Keeper, msg types.MsgCreateVestingSchedule, logger log.Logger) (*sdk.Result, error) {
+	if !keeper.bankKeeper.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled().Result()
+	}
+
+	schedule, err := keeper.CreateVestingSchedule(ctx, msg.Creator, msg.Beneficiary, msg.Amount,
+		msg.StartTime, msg.CliffTime, msg.EndTime, msg.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "handleMsgCreateVestingSchedule"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Creator:%s,Beneficiary:%s,Amount:%s,Start:%s,Cliff:%s,End:%s,Steps:%d>\n"+
+			"                           result<vesting schedule %d created>\n",
+			ctx.BlockHeight(), name,
+			msg.Creator, msg.Beneficiary, msg.Amount, msg.StartTime, msg.CliffTime, msg.EndTime, msg.Steps,
+			schedule.ID))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)),
+		sdk.NewEvent(
+			types.EventTypeCreateVesting,
+			sdk.NewAttribute(types.AttributeKeyVestingID, fmt.Sprintf("%d", schedule.ID)),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.Beneficiary.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
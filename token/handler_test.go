@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	okexchain "github.com/okex/exchain/app"
@@ -72,6 +73,96 @@ func TestHandlerBlockedContractAddrSend(t *testing.T) {
 	}
 }
 
+func TestHandlerTokenControls(t *testing.T) {
+	okexapp := initApp(true)
+	ctx := okexapp.BaseApp.NewContext(true, abci.Header{Height: 1})
+	symbol := "usdk-000"
+	coins := func(amount int64) sdk.SysCoins {
+		return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, sdk.NewDec(amount))}
+	}
+	gAcc := CreateEthAccounts(3, coins(100))
+	for _, acc := range gAcc {
+		okexapp.AccountKeeper.SetAccount(ctx, acc)
+	}
+	owner, holder, other := gAcc[0].Address, gAcc[1].Address, gAcc[2].Address
+
+	okexapp.TokenKeeper.NewToken(ctx, types.Token{
+		Symbol:        symbol,
+		Owner:         owner,
+		Pausable:      true,
+		Freezable:     true,
+		Blacklistable: true,
+	})
+	okexapp.TokenKeeper.NewToken(ctx, types.Token{Symbol: "plain-000", Owner: owner})
+	okexapp.BankKeeper.SetSendEnabled(ctx, true)
+
+	handler := token.NewTokenHandler(okexapp.TokenKeeper, version.CurrentProtocolVersion)
+	bankHandler := bank.NewHandler(okexapp.BankKeeper)
+	send := func(from, to sdk.AccAddress) error {
+		_, err := handler(ctx, types.NewMsgTokenSend(from, to, coins(1)))
+		return err
+	}
+	multiSend := func(from, to sdk.AccAddress) error {
+		_, err := handler(ctx, types.NewMsgMultiSend(from, []types.TransferUnit{{To: to, Coins: coins(1)}}))
+		return err
+	}
+	bankSend := func(from, to sdk.AccAddress) error {
+		_, err := bankHandler(ctx, bank.NewMsgSend(from, to, coins(1)))
+		return err
+	}
+	sends := []func(from, to sdk.AccAddress) error{send, multiSend, bankSend}
+
+	// only the owner controls the token, and only with the controls enabled at the issue
+	_, err := handler(ctx, types.NewMsgTokenPause(symbol, holder, true))
+	require.Error(t, err)
+	_, err = handler(ctx, types.NewMsgTokenPause("plain-000", owner, true))
+	require.Error(t, err)
+
+	// pause
+	_, err = handler(ctx, types.NewMsgTokenPause(symbol, owner, true))
+	require.NoError(t, err)
+	require.True(t, okexapp.TokenKeeper.GetTokenInfo(ctx, symbol).Paused)
+	for _, send := range sends {
+		require.Error(t, send(owner, holder))
+	}
+	_, err = handler(ctx, types.NewMsgTokenPause(symbol, owner, false))
+	require.NoError(t, err)
+	for _, send := range sends {
+		require.NoError(t, send(owner, holder))
+	}
+
+	// freeze
+	_, err = handler(ctx, types.NewMsgTokenFreeze(symbol, owner, []sdk.AccAddress{holder}, true))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{holder}, okexapp.TokenKeeper.GetFrozenAccounts(ctx, symbol))
+	for _, send := range sends {
+		require.Error(t, send(holder, other))
+		// the frozen account is still able to receive the token
+		require.NoError(t, send(other, holder))
+	}
+	// the transfers of the other modules go through the checks of the bank keeper as well
+	require.Error(t, okexapp.SupplyKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins(1)))
+	require.NoError(t, okexapp.SupplyKeeper.SendCoinsFromAccountToModule(ctx, other, types.ModuleName, coins(1)))
+	_, err = handler(ctx, types.NewMsgTokenFreeze(symbol, owner, []sdk.AccAddress{holder}, false))
+	require.NoError(t, err)
+	require.NoError(t, send(holder, other))
+
+	// blacklist
+	_, err = handler(ctx, types.NewMsgTokenBlacklist(symbol, owner, []sdk.AccAddress{holder}, true))
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenAccount{{Symbol: symbol, Address: holder}},
+		okexapp.TokenKeeper.GetAllBlacklistedAccounts(ctx))
+	for _, send := range sends {
+		require.Error(t, send(holder, other))
+		require.Error(t, send(other, holder))
+	}
+	_, err = handler(ctx, types.NewMsgTokenBlacklist(symbol, owner, []sdk.AccAddress{holder}, false))
+	require.NoError(t, err)
+	for _, send := range sends {
+		require.NoError(t, send(other, holder))
+	}
+}
+
 // Setup initializes a new OKExChainApp. A Nop logger is set in OKExChainApp.
 func initApp(isCheckTx bool) *okexchain.OKExChainApp {
 	db := dbm.NewMemDB()
@@ -190,6 +190,16 @@ func (k Keeper) SendCoinsFromAccountToAccount(ctx sdk.Context, from, to sdk.AccA
 	return k.bankKeeper.SendCoins(ctx, from, to, amt)
 }
 
+// SendCoinsFromEVM - send token from one account to another account through the ERC-20 contract of the token in the evm,
+// where contracts are allowed to hold the tokens
+func (k Keeper) SendCoinsFromEVM(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.SysCoins) error {
+	if k.bankKeeper.BlacklistedAddr(to) {
+		return types.ErrBlockedRecipient(to.String())
+	}
+
+	return k.bankKeeper.SendCoins(ctx, from, to, amt)
+}
+
 // nolint
 func (k Keeper) LockCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.SysCoins, lockCoinsType int) error {
 	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins); err != nil {
@@ -207,6 +217,8 @@ func (k Keeper) updateLockedCoins(ctx sdk.Context, addr sdk.AccAddress, coins sd
 		key = types.GetLockAddress(addr.Bytes())
 	case types.LockCoinsTypeFee:
 		key = types.GetLockFeeAddress(addr.Bytes())
+	case types.LockCoinsTypeVesting:
+		key = types.GetLockVestingAddress(addr.Bytes())
 	default:
 		return types.ErrUnrecognizedLockCoinsType(lockCoinsType)
 	}
@@ -447,3 +459,104 @@ func (k Keeper) DeleteConfirmOwnership(ctx sdk.Context, symbol string) {
 	key := types.GetConfirmOwnershipKey(symbol)
 	store.Delete(key)
 }
+
+// CheckTokenTransfer checks the transfer of the coins against the issuer controls of the tokens: no transfer of a
+// paused token, no sending by a frozen account and neither sending nor receiving by a blacklisted account. An empty
+// sender or recipient is left unchecked, e.g. for the inputs and the outputs of a multi-send checked separately.
+// The native token has no issuer controls, so it's never checked.
+func (k Keeper) CheckTokenTransfer(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.SysCoins) error {
+	for _, coin := range amt {
+		if coin.Denom == sdk.DefaultBondDenom {
+			continue
+		}
+		token := k.GetTokenInfo(ctx, coin.Denom)
+		if token.Paused {
+			return types.ErrTokenPaused(coin.Denom)
+		}
+		if token.Blacklistable {
+			for _, addr := range []sdk.AccAddress{from, to} {
+				if !addr.Empty() && k.IsAccountBlacklisted(ctx, coin.Denom, addr) {
+					return types.ErrAccountBlacklisted(coin.Denom, addr)
+				}
+			}
+		}
+		if token.Freezable && !from.Empty() && k.IsAccountFrozen(ctx, coin.Denom, from) {
+			return types.ErrAccountFrozen(coin.Denom, from)
+		}
+	}
+	return nil
+}
+
+// SetAccountFrozen freezes or unfreezes the account for the token
+func (k Keeper) SetAccountFrozen(ctx sdk.Context, symbol string, addr sdk.AccAddress, frozen bool) {
+	k.setControlAccount(ctx, types.GetFrozenAccountKey(symbol, addr), frozen)
+}
+
+// IsAccountFrozen returns whether the account is frozen for the token
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.tokenStoreKey).Has(types.GetFrozenAccountKey(symbol, addr))
+}
+
+// GetFrozenAccounts returns the accounts frozen for the token
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, symbol string) (addrs []sdk.AccAddress) {
+	k.iterateControlAccounts(ctx, types.GetFrozenAccountPrefix(symbol), func(_ string, addr sdk.AccAddress) {
+		addrs = append(addrs, addr)
+	})
+	return addrs
+}
+
+// GetAllFrozenAccounts returns the frozen accounts of all the tokens
+func (k Keeper) GetAllFrozenAccounts(ctx sdk.Context) (accounts []types.TokenAccount) {
+	k.iterateControlAccounts(ctx, types.PrefixFrozenAccountKey, func(symbol string, addr sdk.AccAddress) {
+		accounts = append(accounts, types.TokenAccount{Symbol: symbol, Address: addr})
+	})
+	return accounts
+}
+
+// SetAccountBlacklisted adds the account to or removes it from the blacklist of the token
+func (k Keeper) SetAccountBlacklisted(ctx sdk.Context, symbol string, addr sdk.AccAddress, blacklisted bool) {
+	k.setControlAccount(ctx, types.GetBlacklistedAccountKey(symbol, addr), blacklisted)
+}
+
+// IsAccountBlacklisted returns whether the account is blacklisted for the token
+func (k Keeper) IsAccountBlacklisted(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.tokenStoreKey).Has(types.GetBlacklistedAccountKey(symbol, addr))
+}
+
+// GetBlacklistedAccounts returns the accounts blacklisted for the token
+func (k Keeper) GetBlacklistedAccounts(ctx sdk.Context, symbol string) (addrs []sdk.AccAddress) {
+	k.iterateControlAccounts(ctx, types.GetBlacklistedAccountPrefix(symbol), func(_ string, addr sdk.AccAddress) {
+		addrs = append(addrs, addr)
+	})
+	return addrs
+}
+
+// GetAllBlacklistedAccounts returns the blacklisted accounts of all the tokens
+func (k Keeper) GetAllBlacklistedAccounts(ctx sdk.Context) (accounts []types.TokenAccount) {
+	k.iterateControlAccounts(ctx, types.PrefixBlacklistedKey, func(symbol string, addr sdk.AccAddress) {
+		accounts = append(accounts, types.TokenAccount{Symbol: symbol, Address: addr})
+	})
+	return accounts
+}
+
+func (k Keeper) setControlAccount(ctx sdk.Context, key []byte, enabled bool) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	if enabled {
+		store.Set(key, []byte{1})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// iterateControlAccounts iterates the accounts under the prefix, whose keys are built as
+// prefix | len(symbol) | symbol | address
+func (k Keeper) iterateControlAccounts(ctx sdk.Context, prefix []byte, cb func(symbol string, addr sdk.AccAddress)) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[1:]
+		symbolLen := int(key[0])
+		cb(string(key[1:1+symbolLen]), sdk.AccAddress(key[1+symbolLen:]))
+	}
+}
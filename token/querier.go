@@ -34,6 +34,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryTokenV2(ctx, path[1:], req, keeper)
 		case types.UploadAccount:
 			return uploadAccount(ctx, keeper)
+		case types.QueryControls:
+			return queryControls(ctx, path[1:], keeper)
+		case types.QueryVesting:
+			return queryVesting(ctx, path[1:], keeper)
 		default:
 			return nil, types.ErrUnknownTokenQueryType()
 		}
@@ -184,3 +188,46 @@ func uploadAccount(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
 
 	return []byte("Complete the Export account data and Upload it to oss"), nil
 }
+
+func queryControls(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || path[0] == "" {
+		return nil, types.ErrMsgSymbolIsEmpty()
+	}
+	symbol := path[0]
+	token := keeper.GetTokenInfo(ctx, symbol)
+	if token.Symbol == "" {
+		return nil, types.ErrInvalidCoins(symbol)
+	}
+
+	controls := types.TokenControls{
+		Symbol:              symbol,
+		Paused:              token.Paused,
+		FrozenAccounts:      keeper.GetFrozenAccounts(ctx, symbol),
+		BlacklistedAccounts: keeper.GetBlacklistedAccounts(ctx, symbol),
+	}
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, controls)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+func queryVesting(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || path[0] == "" {
+		return nil, types.ErrAddressIsRequired()
+	}
+	beneficiary, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, common.ErrCreateAddrFromBech32Failed(path[0], err.Error())
+	}
+
+	schedules := keeper.GetAccountVestingSchedules(ctx, beneficiary)
+	if schedules == nil {
+		schedules = types.VestingSchedules{}
+	}
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, schedules)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
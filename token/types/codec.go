@@ -14,6 +14,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgTransferOwnership{}, "filechain/token/MsgTransferOwnership", nil)
 	cdc.RegisterConcrete(MsgConfirmOwnership{}, "filechain/token/MsgConfirmOwnership", nil)
 	cdc.RegisterConcrete(MsgTokenModify{}, "filechain/token/MsgModify", nil)
+	cdc.RegisterConcrete(MsgTokenPause{}, "filechain/token/MsgPause", nil)
+	cdc.RegisterConcrete(MsgTokenFreeze{}, "filechain/token/MsgFreeze", nil)
+	cdc.RegisterConcrete(MsgTokenBlacklist{}, "filechain/token/MsgBlacklist", nil)
+	cdc.RegisterConcrete(MsgCreateVestingSchedule{}, "filechain/token/MsgCreateVestingSchedule", nil)
 
 	// for test
 	//cdc.RegisterConcrete(MsgTokenDestroy{}, "filechain/token/MsgDestroy", nil)
@@ -3,4 +3,14 @@ package types
 const (
 	LockCoinsTypeQuantity = 1
 	LockCoinsTypeFee      = 2
+	LockCoinsTypeVesting  = 3
+)
+
+// events of the vesting schedules
+const (
+	EventTypeCreateVesting  = "create_vesting"
+	EventTypeReleaseVesting = "release_vesting"
+
+	AttributeKeyVestingID   = "vesting_id"
+	AttributeKeyBeneficiary = "beneficiary"
 )
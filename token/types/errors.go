@@ -43,6 +43,11 @@ const (
 	CodeTotalsupplyExceedsTheUpperLimit            uint32 = 61032
 	CodeBlockedContractRecipient                   uint32 = 61033
 	CodeSendCoinsFromAccountToAccountFailed        uint32 = 61034
+	CodeTokenPaused                                uint32 = 61035
+	CodeAccountFrozen                              uint32 = 61036
+	CodeAccountBlacklisted                         uint32 = 61037
+	CodeTokenControlNotEnabled                     uint32 = 61038
+	CodeInvalidVestingSchedule                     uint32 = 61039
 )
 
 var (
@@ -81,6 +86,11 @@ var (
 	errCodeConfirmOwnershipAddressNotEqualsMsgAddress = sdkerrors.Register(DefaultCodespace, CodeConfirmOwnershipAddressNotEqualsMsgAddress, "input address is not equal confirm ownership address")
 	errCodeGetDecimalFromDecimalStringFailed          = sdkerrors.Register(DefaultCodespace, CodeGetDecimalFromDecimalStringFailed, "create a decimal from an input decimal string failed")
 	errCodeTotalsupplyExceedsTheUpperLimit            = sdkerrors.Register(DefaultCodespace, CodeTotalsupplyExceedsTheUpperLimit, "total-supply exceeds the upper limit")
+	errCodeTokenPaused                                = sdkerrors.Register(DefaultCodespace, CodeTokenPaused, "token paused")
+	errCodeAccountFrozen                              = sdkerrors.Register(DefaultCodespace, CodeAccountFrozen, "account frozen")
+	errCodeAccountBlacklisted                         = sdkerrors.Register(DefaultCodespace, CodeAccountBlacklisted, "account blacklisted")
+	errCodeTokenControlNotEnabled                     = sdkerrors.Register(DefaultCodespace, CodeTokenControlNotEnabled, "token control not enabled")
+	errCodeInvalidVestingSchedule                     = sdkerrors.Register(DefaultCodespace, CodeInvalidVestingSchedule, "invalid vesting schedule")
 )
 
 // ErrBlockedContractRecipient returns an error when a transfer is tried on a blocked contract recipient
@@ -213,3 +223,28 @@ func ErrGetDecimalFromDecimalStringFailed(msg string) sdk.EnvelopedErr {
 func ErrCodeTotalsupplyExceedsTheUpperLimit(totalSupplyAfterMint sdk.Dec, TotalSupplyUpperbound int64) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTotalsupplyExceedsTheUpperLimit, fmt.Sprintf("total-supply(%s) exceeds the upper limit(%d)", totalSupplyAfterMint, TotalSupplyUpperbound))}
 }
+
+// ErrTokenPaused returns an error when the transfers of a paused token are tried
+func ErrTokenPaused(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTokenPaused, "failed. the transfers of token %s are paused", symbol)}
+}
+
+// ErrAccountFrozen returns an error when a frozen account tries to send the token
+func ErrAccountFrozen(symbol string, address sdk.AccAddress) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeAccountFrozen, "failed. account %s is frozen for token %s", address, symbol)}
+}
+
+// ErrAccountBlacklisted returns an error when a blacklisted account tries to send or receive the token
+func ErrAccountBlacklisted(symbol string, address sdk.AccAddress) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeAccountBlacklisted, "failed. account %s is blacklisted for token %s", address, symbol)}
+}
+
+// ErrTokenControlNotEnabled returns an error when the issuer control is not enabled at the issue of the token
+func ErrTokenControlNotEnabled(symbol, control string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTokenControlNotEnabled, "failed. token %s is not %s", symbol, control)}
+}
+
+// ErrInvalidVestingSchedule returns an error when the vesting schedule is invalid
+func ErrInvalidVestingSchedule(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidVestingSchedule, "invalid vesting schedule: %s", msg)}
+}
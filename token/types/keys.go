@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -35,6 +37,9 @@ const (
 	QueryTokensV2  = "tokensV2"
 	QueryTokenV2   = "tokenV2"
 
+	QueryControls = "controls"
+	QueryVesting  = "vesting"
+
 	UploadAccount = "upload"
 )
 
@@ -45,6 +50,13 @@ var (
 	PrefixUserTokenKey        = []byte{0x03} // the address prefix of the user-token relationship
 	LockedFeeKey              = []byte{0x04} // the address prefix of the locked order fee coins
 	PrefixConfirmOwnershipKey = []byte{0x05} // the prefix of the confirm ownership key
+	PrefixFrozenAccountKey    = []byte{0x06} // the prefix of the accounts frozen for a token
+	PrefixBlacklistedKey      = []byte{0x07} // the prefix of the accounts blacklisted for a token
+	LockedVestingKey          = []byte{0x08} // the address prefix of the coins locked by the vesting schedules
+	VestingScheduleKey        = []byte{0x09} // the prefix of the vesting schedules
+	PrefixAccountVestingKey   = []byte{0x0A} // the prefix of the beneficiary-vesting schedule relationship
+	VestingScheduleIDKey      = []byte{0x0B} // key for the id of the next vesting schedule
+	VestingQueueKey           = []byte{0x0C} // the prefix of the vesting schedules queued by their next release time
 )
 
 func GetUserTokenPrefix(owner sdk.AccAddress) []byte {
@@ -71,3 +83,71 @@ func GetLockFeeAddress(addr sdk.AccAddress) []byte {
 func GetConfirmOwnershipKey(symbol string) []byte {
 	return append(PrefixConfirmOwnershipKey, []byte(symbol)...)
 }
+
+// GetFrozenAccountPrefix gets the prefix of the accounts frozen for the token. The symbol is length-prefixed so that
+// the accounts of a symbol are never iterated with the ones of the symbols it prefixes.
+func GetFrozenAccountPrefix(symbol string) []byte {
+	return getSymbolPrefix(PrefixFrozenAccountKey, symbol)
+}
+
+// GetFrozenAccountKey gets the key of the account frozen for the token
+func GetFrozenAccountKey(symbol string, addr sdk.AccAddress) []byte {
+	return append(GetFrozenAccountPrefix(symbol), addr.Bytes()...)
+}
+
+// GetBlacklistedAccountPrefix gets the prefix of the accounts blacklisted for the token
+func GetBlacklistedAccountPrefix(symbol string) []byte {
+	return getSymbolPrefix(PrefixBlacklistedKey, symbol)
+}
+
+// GetBlacklistedAccountKey gets the key of the account blacklisted for the token
+func GetBlacklistedAccountKey(symbol string, addr sdk.AccAddress) []byte {
+	return append(GetBlacklistedAccountPrefix(symbol), addr.Bytes()...)
+}
+
+func getSymbolPrefix(prefix []byte, symbol string) []byte {
+	key := make([]byte, 0, len(prefix)+1+len(symbol))
+	key = append(key, prefix...)
+	key = append(key, byte(len(symbol)))
+	return append(key, []byte(symbol)...)
+}
+
+// GetLockVestingAddress gets the key of the coins locked by the vesting schedules of the beneficiary
+func GetLockVestingAddress(addr sdk.AccAddress) []byte {
+	return append(LockedVestingKey, addr.Bytes()...)
+}
+
+// GetVestingScheduleKey gets the key of the vesting schedule
+func GetVestingScheduleKey(id uint64) []byte {
+	return append(VestingScheduleKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAccountVestingPrefix gets the prefix of the vesting schedules of the beneficiary
+func GetAccountVestingPrefix(beneficiary sdk.AccAddress) []byte {
+	return append(PrefixAccountVestingKey, beneficiary.Bytes()...)
+}
+
+// GetAccountVestingKey gets the key of the vesting schedule of the beneficiary
+func GetAccountVestingKey(beneficiary sdk.AccAddress, id uint64) []byte {
+	return append(GetAccountVestingPrefix(beneficiary), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetVestingQueueTimeKey gets the prefix of the vesting schedules queued to be released at the time
+func GetVestingQueueTimeKey(releaseTime time.Time) []byte {
+	return append(VestingQueueKey, sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// GetVestingQueueKey gets the key of the vesting schedule queued to be released at the time
+func GetVestingQueueKey(releaseTime time.Time, id uint64) []byte {
+	return append(GetVestingQueueTimeKey(releaseTime), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitVestingQueueKey splits the key of the vesting queue into the release time and the id of the vesting schedule
+func SplitVestingQueueKey(key []byte) (releaseTime time.Time, id uint64) {
+	timeBz := key[len(VestingQueueKey) : len(key)-8]
+	releaseTime, err := sdk.ParseTimeBytes(timeBz)
+	if err != nil {
+		panic(err)
+	}
+	return releaseTime, sdk.BigEndianToUint64(key[len(key)-8:])
+}
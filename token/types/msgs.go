@@ -2,6 +2,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/exchain/x/common"
 )
@@ -23,6 +26,10 @@ type MsgTokenIssue struct {
 	TotalSupply    string         `json:"total_supply"`
 	Owner          sdk.AccAddress `json:"owner"`
 	Mintable       bool           `json:"mintable"`
+	// the issuer controls, omitted from the sign bytes when disabled to keep the ones of the former clients
+	Pausable      bool `json:"pausable,omitempty"`
+	Freezable     bool `json:"freezable,omitempty"`
+	Blacklistable bool `json:"blacklistable,omitempty"`
 }
 
 func NewMsgTokenIssue(tokenDescription, symbol, originalSymbol, wholeName, totalSupply string, owner sdk.AccAddress, mintable bool) MsgTokenIssue {
@@ -398,3 +405,199 @@ func (msg MsgConfirmOwnership) GetSignBytes() []byte {
 func (msg MsgConfirmOwnership) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Address}
 }
+
+// MsgTokenPause pauses or resumes all the transfers of a pausable token
+type MsgTokenPause struct {
+	Symbol string         `json:"symbol"`
+	Owner  sdk.AccAddress `json:"owner"`
+	Paused bool           `json:"paused"`
+}
+
+func NewMsgTokenPause(symbol string, owner sdk.AccAddress, paused bool) MsgTokenPause {
+	return MsgTokenPause{
+		Symbol: symbol,
+		Owner:  owner,
+		Paused: paused,
+	}
+}
+
+func (msg MsgTokenPause) Route() string { return RouterKey }
+
+func (msg MsgTokenPause) Type() string { return "pause" }
+
+func (msg MsgTokenPause) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrMsgSymbolIsEmpty()
+	}
+	if sdk.ValidateDenom(msg.Symbol) != nil {
+		return ErrNotAllowedOriginalSymbol(msg.Symbol)
+	}
+	return nil
+}
+
+func (msg MsgTokenPause) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenPause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgTokenFreeze freezes or unfreezes the accounts for a freezable token, the frozen accounts being unable to send it
+type MsgTokenFreeze struct {
+	Symbol    string           `json:"symbol"`
+	Owner     sdk.AccAddress   `json:"owner"`
+	Addresses []sdk.AccAddress `json:"addresses"`
+	Frozen    bool             `json:"frozen"`
+}
+
+func NewMsgTokenFreeze(symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress, frozen bool) MsgTokenFreeze {
+	return MsgTokenFreeze{
+		Symbol:    symbol,
+		Owner:     owner,
+		Addresses: addresses,
+		Frozen:    frozen,
+	}
+}
+
+func (msg MsgTokenFreeze) Route() string { return RouterKey }
+
+func (msg MsgTokenFreeze) Type() string { return "freeze" }
+
+func (msg MsgTokenFreeze) ValidateBasic() sdk.Error {
+	return validateControlMsg(msg.Symbol, msg.Owner, msg.Addresses)
+}
+
+func (msg MsgTokenFreeze) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgTokenBlacklist adds the accounts to or removes them from the blacklist of a blacklistable token, the
+// blacklisted accounts being unable to send or receive it
+type MsgTokenBlacklist struct {
+	Symbol      string           `json:"symbol"`
+	Owner       sdk.AccAddress   `json:"owner"`
+	Addresses   []sdk.AccAddress `json:"addresses"`
+	Blacklisted bool             `json:"blacklisted"`
+}
+
+func NewMsgTokenBlacklist(symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress, blacklisted bool) MsgTokenBlacklist {
+	return MsgTokenBlacklist{
+		Symbol:      symbol,
+		Owner:       owner,
+		Addresses:   addresses,
+		Blacklisted: blacklisted,
+	}
+}
+
+func (msg MsgTokenBlacklist) Route() string { return RouterKey }
+
+func (msg MsgTokenBlacklist) Type() string { return "blacklist" }
+
+func (msg MsgTokenBlacklist) ValidateBasic() sdk.Error {
+	return validateControlMsg(msg.Symbol, msg.Owner, msg.Addresses)
+}
+
+func (msg MsgTokenBlacklist) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenBlacklist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func validateControlMsg(symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) sdk.Error {
+	if owner.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if len(symbol) == 0 {
+		return ErrMsgSymbolIsEmpty()
+	}
+	if sdk.ValidateDenom(symbol) != nil {
+		return ErrNotAllowedOriginalSymbol(symbol)
+	}
+	if len(addresses) == 0 {
+		return ErrAddressIsRequired()
+	}
+	if len(addresses) > MultiSendLimit {
+		return ErrMsgTransfersAmountBiggerThanSendLimit()
+	}
+	for _, addr := range addresses {
+		if addr.Empty() {
+			return ErrAddressIsRequired()
+		}
+	}
+	return nil
+}
+
+// MsgCreateVestingSchedule locks the coins of the creator for the beneficiary, to be released by the vesting schedule
+type MsgCreateVestingSchedule struct {
+	Creator     sdk.AccAddress `json:"creator"`
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Amount      sdk.SysCoins   `json:"amount"`
+	StartTime   time.Time      `json:"start_time"`
+	CliffTime   time.Time      `json:"cliff_time"`
+	EndTime     time.Time      `json:"end_time"`
+	Steps       int64          `json:"steps"`
+}
+
+func NewMsgCreateVestingSchedule(creator, beneficiary sdk.AccAddress, amount sdk.SysCoins,
+	startTime, cliffTime, endTime time.Time, steps int64) MsgCreateVestingSchedule {
+	return MsgCreateVestingSchedule{
+		Creator:     creator,
+		Beneficiary: beneficiary,
+		Amount:      amount,
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		EndTime:     endTime,
+		Steps:       steps,
+	}
+}
+
+func (msg MsgCreateVestingSchedule) Route() string { return RouterKey }
+
+func (msg MsgCreateVestingSchedule) Type() string { return "create-vesting" }
+
+func (msg MsgCreateVestingSchedule) ValidateBasic() sdk.Error {
+	if msg.Creator.Empty() || msg.Beneficiary.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return ErrInvalidCoins(msg.Amount.String())
+	}
+	for _, coin := range msg.Amount {
+		if coin.Amount.LT(MinVestingAmount) {
+			return ErrInvalidVestingSchedule(fmt.Sprintf("amount of %s less than the min vesting amount %s",
+				coin.Denom, MinVestingAmount))
+		}
+	}
+	if msg.EndTime.Sub(msg.StartTime) < time.Second {
+		return ErrInvalidVestingSchedule("end time must be at least one second after start time")
+	}
+	if msg.CliffTime.Before(msg.StartTime) || msg.CliffTime.After(msg.EndTime) {
+		return ErrInvalidVestingSchedule("cliff time must be between start time and end time")
+	}
+	if msg.Steps < 0 || msg.Steps > MaxVestingSteps {
+		return ErrInvalidVestingSchedule("steps out of range")
+	}
+	return nil
+}
+
+func (msg MsgCreateVestingSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgCreateVestingSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
@@ -15,6 +15,10 @@ type Token struct {
 	Type                int            `json:"type"`                                             //e.g. 1 common token, 2 interest token
 	Owner               sdk.AccAddress `json:"owner" v2:"owner"`                                 // e.g. ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
 	Mintable            bool           `json:"mintable" v2:"mintable"`                           // e.g. false
+	Pausable            bool           `json:"pausable" v2:"pausable"`                           // e.g. false
+	Freezable           bool           `json:"freezable" v2:"freezable"`                         // e.g. false
+	Blacklistable       bool           `json:"blacklistable" v2:"blacklistable"`                 // e.g. false
+	Paused              bool           `json:"paused" v2:"paused"`                               // e.g. false
 }
 
 func (token Token) String() string {
@@ -34,6 +38,10 @@ type TokenResp struct {
 	Type                int            `json:"type"`
 	Owner               sdk.AccAddress `json:"owner" v2:"owner"`
 	Mintable            bool           `json:"mintable" v2:"mintable"`
+	Pausable            bool           `json:"pausable" v2:"pausable"`
+	Freezable           bool           `json:"freezable" v2:"freezable"`
+	Blacklistable       bool           `json:"blacklistable" v2:"blacklistable"`
+	Paused              bool           `json:"paused" v2:"paused"`
 	TotalSupply         sdk.Dec        `json:"total_supply" v2:"total_supply"`
 }
 
@@ -125,3 +133,25 @@ type AccCoins struct {
 	Acc   sdk.AccAddress `json:"address"`
 	Coins sdk.SysCoins   `json:"coins"`
 }
+
+// TokenAccount is an account frozen or blacklisted for a token
+type TokenAccount struct {
+	Symbol  string         `json:"symbol"`
+	Address sdk.AccAddress `json:"address"`
+}
+
+// TokenControls is the state of the issuer controls of a token
+type TokenControls struct {
+	Symbol              string           `json:"symbol"`
+	Paused              bool             `json:"paused"`
+	FrozenAccounts      []sdk.AccAddress `json:"frozen_accounts"`
+	BlacklistedAccounts []sdk.AccAddress `json:"blacklisted_accounts"`
+}
+
+func (controls TokenControls) String() string {
+	b, err := json.Marshal(controls)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
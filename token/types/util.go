@@ -181,5 +181,9 @@ func GenTokenResp(token Token) TokenResp {
 		Owner:               token.Owner,
 		Type:                token.Type,
 		Mintable:            token.Mintable,
+		Pausable:            token.Pausable,
+		Freezable:           token.Freezable,
+		Blacklistable:       token.Blacklistable,
+		Paused:              token.Paused,
 	}
 }
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxVestingSteps is the max number of the step releases of a vesting schedule
+	MaxVestingSteps = 1000
+	// MaxVestingReleasesPerBlock is the max number of the vesting schedules released in a block. The rest matured are
+	// kept in the queue for the next blocks
+	MaxVestingReleasesPerBlock = 100
+	// VestingReleaseRetryInterval is the delay of the next try of a failed release, such as the one of a paused token
+	VestingReleaseRetryInterval = time.Hour
+)
+
+// MinVestingAmount is the min amount of each coin locked by a vesting schedule, which keeps the dust schedules from
+// piling up in the vesting queue
+var MinVestingAmount = sdk.OneDec()
+
+// VestingSchedule locks the coins for the beneficiary, releasing nothing before the cliff time and the whole amount at
+// the end time. Between them, the coins vested since the start time are released linearly, or in equal steps if the
+// number of steps is set.
+type VestingSchedule struct {
+	ID          uint64         `json:"id"`
+	Creator     sdk.AccAddress `json:"creator"`
+	Beneficiary sdk.AccAddress `json:"beneficiary"`
+	Amount      sdk.SysCoins   `json:"amount"`
+	Released    sdk.SysCoins   `json:"released"`
+	StartTime   time.Time      `json:"start_time"`
+	CliffTime   time.Time      `json:"cliff_time"`
+	EndTime     time.Time      `json:"end_time"`
+	Steps       int64          `json:"steps"`
+}
+
+// NewVestingSchedule creates a new vesting schedule
+func NewVestingSchedule(id uint64, creator, beneficiary sdk.AccAddress, amount sdk.SysCoins,
+	startTime, cliffTime, endTime time.Time, steps int64) VestingSchedule {
+	return VestingSchedule{
+		ID:          id,
+		Creator:     creator,
+		Beneficiary: beneficiary,
+		Amount:      amount,
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		EndTime:     endTime,
+		Steps:       steps,
+	}
+}
+
+// VestedCoins returns the coins vested at the time, both the released and the unreleased ones
+func (vs VestingSchedule) VestedCoins(blockTime time.Time) sdk.SysCoins {
+	switch {
+	case blockTime.Before(vs.CliffTime):
+		return sdk.SysCoins{}
+	case !blockTime.Before(vs.EndTime):
+		return vs.Amount
+	}
+
+	// the durations are counted in seconds so that the products below never overflow
+	elapsed := int64(blockTime.Sub(vs.StartTime) / time.Second)
+	total := int64(vs.EndTime.Sub(vs.StartTime) / time.Second)
+	if elapsed <= 0 || total <= 0 {
+		return sdk.SysCoins{}
+	}
+
+	fraction := sdk.NewDec(elapsed).QuoInt64(total)
+	if vs.Steps > 0 {
+		fraction = sdk.NewDec(elapsed * vs.Steps / total).QuoInt64(vs.Steps)
+	}
+	return vs.Amount.MulDecTruncate(fraction)
+}
+
+// NextReleaseTime returns the time of the next release after the block time, which is the cliff time before the cliff
+// and the time of the next step after it. The linear schedules are released at the granularity of MaxVestingSteps
+// steps, so that every schedule is queued for a bounded number of releases.
+func (vs VestingSchedule) NextReleaseTime(blockTime time.Time) time.Time {
+	if blockTime.Before(vs.CliffTime) {
+		return vs.CliffTime
+	}
+
+	total := int64(vs.EndTime.Sub(vs.StartTime) / time.Second)
+	elapsed := int64(blockTime.Sub(vs.StartTime) / time.Second)
+	if !blockTime.Before(vs.EndTime) || total <= 0 || elapsed < 0 {
+		return vs.EndTime
+	}
+
+	steps := vs.Steps
+	if steps == 0 {
+		steps = MaxVestingSteps
+	}
+	// the step n is vested once elapsed * steps >= n * total
+	next := elapsed*steps/total + 1
+	nextTime := vs.StartTime.Add(time.Duration((next*total+steps-1)/steps) * time.Second)
+	if nextTime.After(vs.EndTime) {
+		return vs.EndTime
+	}
+	return nextTime
+}
+
+// Unreleased returns the coins still locked by the vesting schedule
+func (vs VestingSchedule) Unreleased() sdk.SysCoins {
+	return vs.Amount.Sub(vs.Released)
+}
+
+func (vs VestingSchedule) String() string {
+	b, err := json.Marshal(vs)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+// VestingSchedules is a list of vesting schedules
+type VestingSchedules []VestingSchedule
+
+func (schedules VestingSchedules) String() string {
+	b, err := json.Marshal(schedules)
+	if err != nil {
+		return "[{}]"
+	}
+	return string(b)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestingScheduleNextReleaseTime(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int64) time.Time {
+		return start.Add(time.Duration(seconds) * time.Second)
+	}
+	step := NewVestingSchedule(1, nil, nil, nil, start, at(100), at(1000), 4)
+	linear := NewVestingSchedule(2, nil, nil, nil, start, at(100), at(1000), 0)
+
+	testCases := []struct {
+		schedule  VestingSchedule
+		blockTime time.Time
+		expected  time.Time
+	}{
+		// the cliff comes first
+		{step, at(50), at(100)},
+		{linear, at(50), at(100)},
+		// then the next step, which is the next second of the linear one
+		{step, at(100), at(250)},
+		{step, at(250), at(500)},
+		{step, at(999), at(1000)},
+		{linear, at(100), at(101)},
+		{linear, at(100).Add(time.Millisecond), at(101)},
+		// the end time at last
+		{step, at(1000), at(1000)},
+		{linear, at(2000), at(1000)},
+	}
+	for _, tc := range testCases {
+		next := tc.schedule.NextReleaseTime(tc.blockTime)
+		require.Equal(t, tc.expected, next, tc.blockTime)
+		// the amount vested changes at the next release time
+		if tc.blockTime.Before(tc.schedule.EndTime) {
+			require.True(t, next.After(tc.blockTime))
+		}
+	}
+}
+
+func TestMsgCreateVestingScheduleMinAmount(t *testing.T) {
+	creator, beneficiary := sdk.AccAddress([]byte("creator_____________")), sdk.AccAddress([]byte("beneficiary_________"))
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	newMsg := func(amount sdk.Dec) MsgCreateVestingSchedule {
+		return NewMsgCreateVestingSchedule(creator, beneficiary, sdk.SysCoins{sdk.NewDecCoinFromDec("usdk", amount)},
+			start, start, start.Add(time.Hour), 0)
+	}
+
+	require.NoError(t, newMsg(MinVestingAmount).ValidateBasic())
+	require.Error(t, newMsg(MinVestingAmount.QuoInt64(2)).ValidateBasic())
+}
@@ -0,0 +1,152 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/exchain/x/token/types"
+)
+
+// CreateVestingSchedule locks the coins of the creator for the beneficiary and stores the vesting schedule releasing
+// them
+func (k Keeper) CreateVestingSchedule(ctx sdk.Context, creator, beneficiary sdk.AccAddress, amount sdk.SysCoins,
+	startTime, cliffTime, endTime time.Time, steps int64) (types.VestingSchedule, error) {
+	if err := k.CheckTokenTransfer(ctx, creator, beneficiary, amount); err != nil {
+		return types.VestingSchedule{}, err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, amount); err != nil {
+		return types.VestingSchedule{}, types.ErrSendCoinsFromAccountToModuleFailed(err.Error())
+	}
+	// the coins are locked for the beneficiary, who is the one to receive them
+	if err := k.updateLockedCoins(ctx, beneficiary, amount, true, types.LockCoinsTypeVesting); err != nil {
+		return types.VestingSchedule{}, err
+	}
+
+	schedule := types.NewVestingSchedule(k.getNextVestingScheduleID(ctx), creator, beneficiary, amount,
+		startTime, cliffTime, endTime, steps)
+	k.SetVestingSchedule(ctx, schedule)
+	k.insertVestingQueue(ctx, schedule.ID, schedule.CliffTime)
+	k.setNextVestingScheduleID(ctx, schedule.ID+1)
+	return schedule, nil
+}
+
+// GetVestingSchedule gets the vesting schedule by id
+func (k Keeper) GetVestingSchedule(ctx sdk.Context, id uint64) (schedule types.VestingSchedule, found bool) {
+	bz := ctx.KVStore(k.tokenStoreKey).Get(types.GetVestingScheduleKey(id))
+	if bz == nil {
+		return schedule, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+	return schedule, true
+}
+
+// SetVestingSchedule stores the vesting schedule and indexes it by the beneficiary
+func (k Keeper) SetVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	store.Set(types.GetVestingScheduleKey(schedule.ID), k.cdc.MustMarshalBinaryBare(schedule))
+	store.Set(types.GetAccountVestingKey(schedule.Beneficiary, schedule.ID), []byte{})
+}
+
+func (k Keeper) deleteVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	store.Delete(types.GetVestingScheduleKey(schedule.ID))
+	store.Delete(types.GetAccountVestingKey(schedule.Beneficiary, schedule.ID))
+}
+
+// GetAccountVestingSchedules gets the unfinished vesting schedules of the beneficiary
+func (k Keeper) GetAccountVestingSchedules(ctx sdk.Context, beneficiary sdk.AccAddress) (schedules types.VestingSchedules) {
+	prefix := types.GetAccountVestingPrefix(beneficiary)
+	store := ctx.KVStore(k.tokenStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		id := sdk.BigEndianToUint64(iter.Key()[len(prefix):])
+		if schedule, found := k.GetVestingSchedule(ctx, id); found {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules
+}
+
+// IterateVestingSchedules iterates the unfinished vesting schedules in the order of their ids
+func (k Keeper) IterateVestingSchedules(ctx sdk.Context, cb func(schedule types.VestingSchedule) (stop bool)) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.VestingScheduleKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.VestingSchedule
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+func (k Keeper) insertVestingQueue(ctx sdk.Context, id uint64, releaseTime time.Time) {
+	ctx.KVStore(k.tokenStoreKey).Set(types.GetVestingQueueKey(releaseTime, id), []byte{})
+}
+
+func (k Keeper) deleteVestingQueue(ctx sdk.Context, id uint64, releaseTime time.Time) {
+	ctx.KVStore(k.tokenStoreKey).Delete(types.GetVestingQueueKey(releaseTime, id))
+}
+
+// vestingQueueIterator returns an iterator over the vesting schedules queued to be released at or before the time
+func (k Keeper) vestingQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	return ctx.KVStore(k.tokenStoreKey).Iterator(types.VestingQueueKey,
+		sdk.PrefixEndBytes(types.GetVestingQueueTimeKey(endTime)))
+}
+
+// GetLockedVestingCoins gets the coins locked by the vesting schedules of the beneficiary
+func (k Keeper) GetLockedVestingCoins(ctx sdk.Context, beneficiary sdk.AccAddress) (coins sdk.SysCoins) {
+	bz := ctx.KVStore(k.lockStoreKey).Get(types.GetLockVestingAddress(beneficiary))
+	if bz == nil {
+		return coins
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &coins)
+	return coins
+}
+
+// releaseVestedCoins unlocks the coins vested but not released yet to the beneficiary. The finished schedule is
+// deleted, while the unfinished one is queued for its next release
+func (k Keeper) releaseVestedCoins(ctx sdk.Context, schedule types.VestingSchedule) error {
+	release := schedule.VestedCoins(ctx.BlockTime()).Sub(schedule.Released)
+	if !release.IsZero() {
+		// the release is postponed while the token is paused or the beneficiary blacklisted
+		if err := k.CheckTokenTransfer(ctx, nil, schedule.Beneficiary, release); err != nil {
+			return err
+		}
+		if err := k.UnlockCoins(ctx, schedule.Beneficiary, release, types.LockCoinsTypeVesting); err != nil {
+			return err
+		}
+		schedule.Released = schedule.Released.Add2(release)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeReleaseVesting,
+			sdk.NewAttribute(types.AttributeKeyVestingID, fmt.Sprintf("%d", schedule.ID)),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, schedule.Beneficiary.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, release.String()),
+		))
+	}
+
+	if schedule.Unreleased().IsZero() {
+		k.deleteVestingSchedule(ctx, schedule)
+	} else {
+		k.SetVestingSchedule(ctx, schedule)
+		k.insertVestingQueue(ctx, schedule.ID, schedule.NextReleaseTime(ctx.BlockTime()))
+	}
+	return nil
+}
+
+func (k Keeper) getNextVestingScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.tokenStoreKey).Get(types.VestingScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextVestingScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.tokenStoreKey).Set(types.VestingScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}